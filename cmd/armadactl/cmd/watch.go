@@ -27,15 +27,24 @@ func watchCmd() *cobra.Command {
 				return fmt.Errorf("error reading raw: %s", err)
 			}
 
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return fmt.Errorf("error reading output: %s", err)
+			}
+			if raw {
+				output = armadactl.WatchOutputRaw
+			}
+
 			exit_on_inactive, err := cmd.Flags().GetBool("exit-if-inactive")
 			if err != nil {
 				return fmt.Errorf("error reading exit-if-inactive: %s", err)
 			}
 
-			return a.Watch(queue, jobSetId, raw, exit_on_inactive)
+			return a.Watch(queue, jobSetId, output, exit_on_inactive)
 		},
 	}
-	cmd.Flags().Bool("raw", false, "Output raw events")
+	cmd.Flags().Bool("raw", false, "Output raw events, same as --output raw")
+	cmd.Flags().StringP("output", "o", armadactl.WatchOutputSummary, "Output format, one of: summary, raw, cloudevents")
 	cmd.Flags().Bool("exit-if-inactive", false, "Exit if there are no more active jobs")
 	return cmd
 }
@@ -35,13 +35,22 @@ func main() {
 		panic(e)
 	}
 
+	var workload *context.WorkloadSpec
+	e = common.UnmarshalKey(v, "workload", &workload)
+	if e != nil {
+		panic(e)
+	}
+
 	shutdownChannel := make(chan os.Signal, 1)
 	signal.Notify(shutdownChannel, syscall.SIGINT, syscall.SIGTERM)
 
 	shutdownMetricServer := common.ServeMetrics(config.Metric.Port)
 	defer shutdownMetricServer()
 
-	shutdown, wg := fake.StartUp(config, nodes)
+	shutdown, wg, e := fake.StartUp(config, nodes, workload)
+	if e != nil {
+		panic(e)
+	}
 	go func() {
 		<-shutdownChannel
 		shutdown()
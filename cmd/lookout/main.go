@@ -2,15 +2,12 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
-	"github.com/G-Research/armada/internal/lookout/repository"
-
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
@@ -21,6 +18,8 @@ import (
 	"github.com/G-Research/armada/internal/lookout"
 	"github.com/G-Research/armada/internal/lookout/configuration"
 	"github.com/G-Research/armada/internal/lookout/postgres"
+	"github.com/G-Research/armada/internal/lookout/pruner"
+	"github.com/G-Research/armada/internal/lookout/rebuild"
 	"github.com/G-Research/armada/internal/lookout/repository/schema"
 	lookoutApi "github.com/G-Research/armada/pkg/api/lookout"
 )
@@ -28,11 +27,13 @@ import (
 const CustomConfigLocation string = "config"
 const MigrateDatabase string = "migrateDatabase"
 const PruneDatabase = "pruneDatabase"
+const RebuildDatabase = "rebuildDatabase"
 
 func init() {
 	pflag.StringSlice(CustomConfigLocation, []string{}, "Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)")
 	pflag.Bool(MigrateDatabase, false, "Migrate database instead of running server")
 	pflag.Bool(PruneDatabase, false, "Removes old jobs from the database instead of running server")
+	pflag.Bool(RebuildDatabase, false, "Rebuilds the database from the retained events instead of running server")
 	pflag.Parse()
 }
 
@@ -62,15 +63,15 @@ func main() {
 		if err != nil {
 			panic(err)
 		}
-		if config.PrunerConfig.DaysToKeep <= 0 {
-			panic(fmt.Errorf("invalid PrunerConfig.DaysToKeep [%v]: must be greater than 0", config.PrunerConfig.DaysToKeep))
-		}
-		cutoff := time.Now().AddDate(0, 0, -config.PrunerConfig.DaysToKeep)
-		batchSize := config.PrunerConfig.BatchSize
-		if batchSize <= 0 {
-			panic(fmt.Errorf("invalid PrunerConfig.BatchSize [%v]: must be greater than 0", batchSize))
+		err = pruner.Run(db, config.PrunerConfig, time.Now())
+		if err != nil {
+			panic(err)
 		}
-		err = repository.DeleteOldJobs(db, batchSize, cutoff)
+		os.Exit(0)
+	}
+
+	if viper.GetBool(RebuildDatabase) {
+		err := rebuild.Run(config, time.Now())
 		if err != nil {
 			panic(err)
 		}
@@ -107,7 +108,7 @@ func main() {
 
 	shutdownServer := common.ServeHttp(config.HttpPort, mux)
 
-	shutdown, wg := lookout.StartUp(config, healthChecks)
+	shutdown, wg := lookout.StartUp(config, healthChecks, mux)
 	go func() {
 		<-shutdownChannel
 		shutdown()
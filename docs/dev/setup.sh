@@ -2,7 +2,7 @@
 
 stream_backend="$1"
 
-if [ -z "$stream_backend" ] || (echo "stan jetstream" | grep -v -q "$stream_backend"); then
+if [ -z "$stream_backend" ] || (echo "stan jetstream kafka" | grep -v -q "$stream_backend"); then
   stream_backend="stan"
 fi
 echo "Using $stream_backend"
@@ -25,12 +25,14 @@ type ArmadaConfig struct {
 	Events              EventsConfig
 	EventsNats          NatsConfig
 	EventsJetstream     JetstreamConfig
+	EventsKafka         KafkaConfig
 	EventsRedis         redis.UniversalOptions
 
 	Scheduling        SchedulingConfig
 	QueueManagement   QueueManagementConfig
 	DatabaseRetention DatabaseRetentionPolicy
 	EventRetention    EventRetentionPolicy
+	EventWebhooks     WebhookConfig
 
 	Metrics MetricsConfig
 }
@@ -52,6 +54,7 @@ type SchedulingConfig struct {
 	PoolResourceScarcity                      map[string]map[string]float64
 	MaxPodSpecSizeBytes                       uint
 	MinJobResources                           v1.ResourceList
+	PreferCachedImages                        bool // Lease jobs to clusters and node types that have the images of the jobs cached first
 }
 
 type DatabaseRetentionPolicy struct {
@@ -61,6 +64,23 @@ type DatabaseRetentionPolicy struct {
 type EventRetentionPolicy struct {
 	ExpiryEnabled     bool
 	RetentionDuration time.Duration
+	// Only the latest utilisation event of each job is kept in the job set stream
+	CompactUtilisationEvents bool
+	// Maximum number of events kept in a job set stream, unbounded if 0
+	MaxStreamLength int64
+	// Directory trimmed events are archived to, trimmed events are discarded if empty
+	ArchiveDirectory string
+}
+
+type WebhookConfig struct {
+	Enabled        bool
+	QueueSize      int           // Number of notifications queued for each webhook URL, further notifications are dead-lettered
+	Timeout        time.Duration // Timeout for a single HTTP request
+	MaxAttempts    int           // Number of delivery attempts before an event is dead-lettered
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	DeadLetterFile string            // File undeliverable notifications are appended to, logged if empty
+	Secrets        map[string]string // HMAC signing keys, referenced by name from queue webhooks
 }
 
 type LeaseSettings struct {
@@ -71,6 +91,7 @@ type LeaseSettings struct {
 type EventsConfig struct {
 	StoreQueue     string // Queue group for event storage processors
 	JobStatusQueue string // Queue group for running job status processor
+	WebhookQueue   string // Queue group for the webhook event sink
 
 	ProcessorBatchSize             int           // Maximum event batch size
 	ProcessorMaxTimeBetweenBatches time.Duration // Maximum time between batches
@@ -94,6 +115,15 @@ type JetstreamConfig struct {
 	InMemory    bool // Whether stream should be stored in memory (as opposed to on disk)
 }
 
+type KafkaConfig struct {
+	Brokers           []string
+	Topic             string
+	NumPartitions     int // Number of partitions to create the topic with if it does not exist
+	ReplicationFactor int
+	ConnTimeout       time.Duration
+	BatchTimeout      time.Duration // Maximum time a message is buffered before being sent to the broker
+}
+
 type QueueManagementConfig struct {
 	AutoCreateQueues      bool
 	DefaultPriorityFactor queue.PriorityFactor
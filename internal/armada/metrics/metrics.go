@@ -205,6 +205,13 @@ var clusterAvailableCapacity = prometheus.NewDesc(
 	nil,
 )
 
+var clusterDrainingDesc = prometheus.NewDesc(
+	MetricPrefix+"cluster_draining",
+	"Whether the cluster is being drained and doesn't lease new jobs",
+	[]string{"cluster", "pool"},
+	nil,
+)
+
 func (c *QueueInfoCollector) Describe(desc chan<- *prometheus.Desc) {
 	desc <- queueSizeDesc
 	desc <- queuePriorityDesc
@@ -222,6 +229,7 @@ func (c *QueueInfoCollector) Describe(desc chan<- *prometheus.Desc) {
 	desc <- minQueueAllocatedDesc
 	desc <- maxQueueAllocatedDesc
 	desc <- medianQueueAllocatedDesc
+	desc <- clusterDrainingDesc
 }
 
 func (c *QueueInfoCollector) Collect(metrics chan<- prometheus.Metric) {
@@ -325,6 +333,7 @@ func (c *QueueInfoCollector) Collect(metrics chan<- prometheus.Metric) {
 
 	c.recordQueueUsageMetrics(metrics, activeClusterReports)
 	c.recordClusterCapacityMetrics(metrics, activeClusterReports)
+	c.recordClusterDrainingMetrics(metrics, activeClusterInfo)
 }
 
 func (c *QueueInfoCollector) recordQueueUsageMetrics(metrics chan<- prometheus.Metric, activeClusterUsageReports map[string]*api.ClusterUsageReport) {
@@ -447,6 +456,16 @@ func (c *QueueInfoCollector) recordClusterCapacityMetrics(metrics chan<- prometh
 	}
 }
 
+func (c *QueueInfoCollector) recordClusterDrainingMetrics(metrics chan<- prometheus.Metric, activeClusterInfo map[string]*api.ClusterSchedulingInfoReport) {
+	for cluster, report := range activeClusterInfo {
+		draining := 0.0
+		if report.Draining {
+			draining = 1
+		}
+		metrics <- prometheus.MustNewConstMetric(clusterDrainingDesc, prometheus.GaugeValue, draining, cluster, report.Pool)
+	}
+}
+
 func (c *QueueInfoCollector) calculateRunningJobStats(
 	queues []*api.Queue, activeClusterInfos map[string]*api.ClusterSchedulingInfoReport) (
 	map[string]map[string]*FloatMetrics, map[string]map[string]ResourceMetrics) {
@@ -19,5 +19,6 @@ const (
 	WatchEvents                               = "watch_events"
 	WatchAllEvents                            = "watch_all_events"
 
-	ExecuteJobs = "execute_jobs"
+	ExecuteJobs   = "execute_jobs"
+	DrainClusters = "drain_clusters"
 )
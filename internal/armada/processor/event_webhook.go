@@ -0,0 +1,509 @@
+package processor
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client/queue"
+)
+
+const (
+	WebhookSignatureHeader = "X-Armada-Signature"
+	WebhookEventHeader     = "X-Armada-Event"
+
+	JobSetCompletedCloudEventType = "io.armada.jobset.completed"
+
+	webhookQueueCacheTTL      = 30 * time.Second
+	completedJobSetsRetention = time.Hour
+	// Finished jobs of job sets which haven't completed are forgotten after this long without another job finishing.
+	finishedJobsRetention   = time.Hour
+	defaultWebhookQueueSize = 1000
+)
+
+// WebhookNotification is the JSON body posted to queue webhooks.
+type WebhookNotification struct {
+	Type     queue.WebhookEvent `json:"type"`
+	Queue    string             `json:"queue"`
+	JobSetId string             `json:"jobSetId"`
+	JobId    string             `json:"jobId,omitempty"`
+	Created  time.Time          `json:"created"`
+	Event    *api.EventMessage  `json:"event,omitempty"`
+}
+
+// WebhookEventProcessor posts job lifecycle events to the webhooks configured on their queue.
+// A synthetic jobSetCompleted notification is sent once the last active job of a job set has finished.
+//
+// Notifications are queued for each webhook URL and posted in order by one worker per URL, which also retries them.
+// A slow or unreachable webhook only delays its own notifications, those which don't fit in its queue are
+// dead-lettered.
+type WebhookEventProcessor struct {
+	queue           string
+	stream          eventstream.EventStream
+	queueRepository repository.QueueRepository
+	jobRepository   repository.JobRepository
+	sender          *WebhookSender
+	queueSize       int
+
+	endpointMutex sync.Mutex
+	endpoints     map[string]chan *webhookDelivery
+	wg            sync.WaitGroup
+
+	queueMutex  sync.Mutex
+	queueCache  map[string]cachedWebhooks
+	jobSetMutex sync.Mutex
+	finished    map[string]*finishedJobs
+	completed   map[string]time.Time
+}
+
+type cachedWebhooks struct {
+	webhooks []queue.Webhook
+	expiry   time.Time
+}
+
+type webhookDelivery struct {
+	webhook      queue.Webhook
+	notification *WebhookNotification
+}
+
+// finishedJobs holds the jobs of a job set which have finished before the job set completed.
+type finishedJobs struct {
+	jobIds  map[string]bool
+	updated time.Time
+}
+
+func NewWebhookEventProcessor(
+	queue string,
+	stream eventstream.EventStream,
+	queueRepository repository.QueueRepository,
+	jobRepository repository.JobRepository,
+	sender *WebhookSender,
+	queueSize int,
+) *WebhookEventProcessor {
+	if queueSize <= 0 {
+		queueSize = defaultWebhookQueueSize
+	}
+	return &WebhookEventProcessor{
+		queue:           queue,
+		stream:          stream,
+		queueRepository: queueRepository,
+		jobRepository:   jobRepository,
+		sender:          sender,
+		queueSize:       queueSize,
+		endpoints:       map[string]chan *webhookDelivery{},
+		queueCache:      map[string]cachedWebhooks{},
+		finished:        map[string]*finishedJobs{},
+		completed:       map[string]time.Time{},
+	}
+}
+
+func (p *WebhookEventProcessor) Start() {
+	err := p.stream.Subscribe(p.queue, p.handleMessage)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Stop waits for queued notifications to be delivered. It must only be called after the event stream is closed.
+func (p *WebhookEventProcessor) Stop() {
+	p.endpointMutex.Lock()
+	for url, deliveries := range p.endpoints {
+		close(deliveries)
+		delete(p.endpoints, url)
+	}
+	p.endpointMutex.Unlock()
+	p.wg.Wait()
+}
+
+// enqueue queues notification for the worker of the webhook URL, starting the worker if there is none, or
+// dead-letters it if the queue is full.
+func (p *WebhookEventProcessor) enqueue(webhook queue.Webhook, notification *WebhookNotification) {
+	p.endpointMutex.Lock()
+	defer p.endpointMutex.Unlock()
+
+	deliveries, ok := p.endpoints[webhook.URL]
+	if !ok {
+		deliveries = make(chan *webhookDelivery, p.queueSize)
+		p.endpoints[webhook.URL] = deliveries
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for delivery := range deliveries {
+				p.sender.Send(delivery.webhook, delivery.notification)
+			}
+		}()
+	}
+
+	select {
+	case deliveries <- &webhookDelivery{webhook: webhook, notification: notification}:
+	default:
+		p.sender.deadLetter.Record(webhook, notification, fmt.Errorf("%d notifications are already queued for the webhook", p.queueSize))
+	}
+}
+
+func (p *WebhookEventProcessor) handleMessage(message *eventstream.Message) error {
+	event, err := api.UnwrapEvent(message.EventMessage)
+	if err != nil {
+		log.Errorf("error while unwrapping eventmessage: %v", err)
+		return err
+	}
+
+	eventType, ok := webhookEventType(event)
+	if !ok {
+		return ackMessage(message)
+	}
+
+	webhooks, err := p.getWebhooks(event.GetQueue())
+	if err != nil {
+		log.Errorf("error when getting webhooks for queue %s: %v", event.GetQueue(), err)
+		return err
+	}
+	var subscribed []queue.Webhook
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(eventType) || webhook.Subscribes(queue.WebhookEventJobSetCompleted) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	if len(subscribed) == 0 {
+		return ackMessage(message)
+	}
+
+	notification := &WebhookNotification{
+		Type:     eventType,
+		Queue:    event.GetQueue(),
+		JobSetId: event.GetJobSetId(),
+		JobId:    event.GetJobId(),
+		Created:  event.GetCreated(),
+		Event:    message.EventMessage,
+	}
+	for _, webhook := range subscribed {
+		if webhook.Subscribes(notification.Type) {
+			p.enqueue(webhook, notification)
+		}
+	}
+
+	if p.subscribesToJobSetCompletion(subscribed) {
+		complete, err := p.markFinished(notification.Queue, notification.JobSetId, notification.JobId)
+		if err != nil {
+			log.Errorf("error when checking if job set %s in queue %s is complete: %v", notification.JobSetId, notification.Queue, err)
+		} else if complete {
+			jobSetCompleted := &WebhookNotification{
+				Type:     queue.WebhookEventJobSetCompleted,
+				Queue:    notification.Queue,
+				JobSetId: notification.JobSetId,
+				Created:  notification.Created,
+			}
+			for _, webhook := range subscribed {
+				if webhook.Subscribes(queue.WebhookEventJobSetCompleted) {
+					p.enqueue(webhook, jobSetCompleted)
+				}
+			}
+		}
+	}
+
+	// Queued notifications which can't be delivered are dead-lettered, so the message is acknowledged once they are
+	// queued
+	return ackMessage(message)
+}
+
+func (p *WebhookEventProcessor) subscribesToJobSetCompletion(webhooks []queue.Webhook) bool {
+	for _, webhook := range webhooks {
+		if webhook.Subscribes(queue.WebhookEventJobSetCompleted) {
+			return true
+		}
+	}
+	return false
+}
+
+// markFinished records that jobId has finished and returns true exactly once per job set,
+// when no job of the job set other than the finished ones is still active.
+func (p *WebhookEventProcessor) markFinished(queueName, jobSetId, jobId string) (bool, error) {
+	activeIds, err := p.jobRepository.GetActiveJobIds(queueName, jobSetId)
+	if err != nil {
+		return false, err
+	}
+
+	p.jobSetMutex.Lock()
+	defer p.jobSetMutex.Unlock()
+
+	now := time.Now()
+	for key, completedAt := range p.completed {
+		if now.Sub(completedAt) > completedJobSetsRetention {
+			delete(p.completed, key)
+		}
+	}
+	for key, finished := range p.finished {
+		if now.Sub(finished.updated) > finishedJobsRetention {
+			delete(p.finished, key)
+		}
+	}
+
+	key := queueName + "/" + jobSetId
+	if _, done := p.completed[key]; done {
+		return false, nil
+	}
+	finished, ok := p.finished[key]
+	if !ok {
+		finished = &finishedJobs{jobIds: map[string]bool{}}
+		p.finished[key] = finished
+	}
+	finished.jobIds[jobId] = true
+	finished.updated = now
+
+	for _, id := range activeIds {
+		if !finished.jobIds[id] {
+			return false, nil
+		}
+	}
+	delete(p.finished, key)
+	p.completed[key] = now
+	return true, nil
+}
+
+func (p *WebhookEventProcessor) getWebhooks(queueName string) ([]queue.Webhook, error) {
+	p.queueMutex.Lock()
+	defer p.queueMutex.Unlock()
+
+	cached, ok := p.queueCache[queueName]
+	if ok && time.Now().Before(cached.expiry) {
+		return cached.webhooks, nil
+	}
+
+	q, err := p.queueRepository.GetQueue(queueName)
+	var notFound *repository.ErrQueueNotFound
+	if errors.As(err, &notFound) {
+		q = queue.Queue{}
+	} else if err != nil {
+		return nil, err
+	}
+	p.queueCache[queueName] = cachedWebhooks{webhooks: q.Webhooks, expiry: time.Now().Add(webhookQueueCacheTTL)}
+	return q.Webhooks, nil
+}
+
+func webhookEventType(event api.Event) (queue.WebhookEvent, bool) {
+	switch event.(type) {
+	case *api.JobFailedEvent:
+		return queue.WebhookEventFailed, true
+	case *api.JobSucceededEvent:
+		return queue.WebhookEventSucceeded, true
+	case *api.JobCancelledEvent:
+		return queue.WebhookEventCancelled, true
+	}
+	return "", false
+}
+
+func ackMessage(message *eventstream.Message) error {
+	err := message.Ack()
+	if err != nil {
+		log.Errorf("error when acknowledging message: %v", err)
+	}
+	return nil
+}
+
+// WebhookSender posts notifications to webhooks, retrying with exponential backoff.
+// Notifications that cannot be delivered are written to the dead-letter log.
+type WebhookSender struct {
+	client         *http.Client
+	secrets        map[string]string
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	deadLetter     DeadLetterLog
+}
+
+func NewWebhookSender(config *configuration.WebhookConfig, deadLetter DeadLetterLog) *WebhookSender {
+	maxAttempts := config.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	return &WebhookSender{
+		client:         &http.Client{Timeout: config.Timeout},
+		secrets:        config.Secrets,
+		maxAttempts:    maxAttempts,
+		initialBackoff: config.InitialBackoff,
+		maxBackoff:     config.MaxBackoff,
+		deadLetter:     deadLetter,
+	}
+}
+
+func (s *WebhookSender) Send(webhook queue.Webhook, notification *WebhookNotification) {
+	body, contentType, err := webhookBody(webhook, notification)
+	if err != nil {
+		s.deadLetter.Record(webhook, notification, fmt.Errorf("error when marshalling notification: %v", err))
+		return
+	}
+
+	var secret []byte
+	if webhook.SecretName != "" {
+		value, ok := s.secrets[webhook.SecretName]
+		if !ok {
+			s.deadLetter.Record(webhook, notification, fmt.Errorf("webhook secret %q is not configured", webhook.SecretName))
+			return
+		}
+		secret = []byte(value)
+	}
+
+	backoff := s.initialBackoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(webhook.URL, notification.Type, contentType, body, secret)
+		if err == nil {
+			return
+		}
+		if !retryable || attempt >= s.maxAttempts {
+			s.deadLetter.Record(webhook, notification, fmt.Errorf("giving up after %d attempts: %v", attempt, err))
+			return
+		}
+		log.Warnf("error when posting %s notification to webhook %s, retrying in %s: %v", notification.Type, webhook.URL, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if s.maxBackoff > 0 && backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+func (s *WebhookSender) post(url string, eventType queue.WebhookEvent, contentType string, body []byte, secret []byte) (bool, error) {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set(WebhookEventHeader, string(eventType))
+	if secret != nil {
+		request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(secret, body))
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return true, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return true, nil
+	}
+	retryable := response.StatusCode >= 500 ||
+		response.StatusCode == http.StatusRequestTimeout ||
+		response.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("webhook responded with status %s", response.Status)
+}
+
+// webhookBody returns the request body for notification in the format of webhook and its content type.
+func webhookBody(webhook queue.Webhook, notification *WebhookNotification) ([]byte, string, error) {
+	if webhook.Format != queue.WebhookFormatCloudEvents {
+		body, err := json.Marshal(notification)
+		return body, "application/json", err
+	}
+
+	var cloudEvent *api.CloudEvent
+	if notification.Event != nil {
+		var err error
+		cloudEvent, err = api.NewCloudEvent(notification.Event)
+		if err != nil {
+			return nil, "", err
+		}
+	} else {
+		cloudEvent = jobSetCompletedCloudEvent(notification)
+	}
+	body, err := json.Marshal(cloudEvent)
+	return body, api.CloudEventsContentType, err
+}
+
+// jobSetCompletedCloudEvent maps a synthetic jobSetCompleted notification, which has no underlying event, to a CloudEvent.
+func jobSetCompletedCloudEvent(notification *WebhookNotification) *api.CloudEvent {
+	digest := sha256.Sum256([]byte(JobSetCompletedCloudEventType + "/" + notification.Queue + "/" + notification.JobSetId))
+	return &api.CloudEvent{
+		SpecVersion:     api.CloudEventsSpecVersion,
+		Id:              hex.EncodeToString(digest[:16]),
+		Type:            JobSetCompletedCloudEventType,
+		Source:          fmt.Sprintf("/queues/%s", notification.Queue),
+		Subject:         notification.JobSetId,
+		Time:            notification.Created,
+		DataContentType: "application/json",
+		Queue:           notification.Queue,
+		JobSetId:        notification.JobSetId,
+		Data:            notification,
+	}
+}
+
+// SignWebhookPayload returns the value of the signature header for body,
+// the hex encoded HMAC-SHA256 of the body prefixed with "sha256=".
+func SignWebhookPayload(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type DeadLetterLog interface {
+	Record(webhook queue.Webhook, notification *WebhookNotification, err error)
+}
+
+type deadLetterEntry struct {
+	Url          string               `json:"url"`
+	Error        string               `json:"error"`
+	Time         time.Time            `json:"time"`
+	Notification *WebhookNotification `json:"notification"`
+}
+
+// FileDeadLetterLog appends undeliverable notifications as JSON lines to a file.
+type FileDeadLetterLog struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func NewFileDeadLetterLog(path string) (*FileDeadLetterLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileDeadLetterLog{file: file}, nil
+}
+
+func (l *FileDeadLetterLog) Record(webhook queue.Webhook, notification *WebhookNotification, err error) {
+	log.Errorf("dead-lettering %s notification for job set %s in queue %s to webhook %s: %v",
+		notification.Type, notification.JobSetId, notification.Queue, webhook.URL, err)
+
+	data, marshalErr := json.Marshal(deadLetterEntry{
+		Url:          webhook.URL,
+		Error:        err.Error(),
+		Time:         time.Now(),
+		Notification: notification,
+	})
+	if marshalErr != nil {
+		log.Errorf("error when marshalling dead letter entry: %v", marshalErr)
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	_, writeErr := l.file.Write(append(data, '\n'))
+	if writeErr != nil {
+		log.Errorf("error when writing dead letter entry: %v", writeErr)
+	}
+}
+
+func (l *FileDeadLetterLog) Close() error {
+	return l.file.Close()
+}
+
+// LogDeadLetterLog only logs undeliverable notifications.
+type LogDeadLetterLog struct{}
+
+func (LogDeadLetterLog) Record(webhook queue.Webhook, notification *WebhookNotification, err error) {
+	log.Errorf("dead-lettering %s notification for job set %s in queue %s to webhook %s: %v",
+		notification.Type, notification.JobSetId, notification.Queue, webhook.URL, err)
+}
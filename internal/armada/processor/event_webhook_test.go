@@ -0,0 +1,355 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client/queue"
+)
+
+func TestWebhookProcessor_PostsSignedNotification(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventFailed}, SecretName: "slack"}
+	processor, _ := newTestWebhookProcessor(webhook, newActiveJobsRepository())
+
+	acked := make(chan bool, 1)
+	err := processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "job-set", func() error {
+		acked <- true
+		return nil
+	}))
+	assert.NoError(t, err)
+	processor.Stop()
+
+	assert.True(t, <-acked)
+	requests := receiver.Requests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, SignWebhookPayload([]byte("secret"), requests[0].body), requests[0].signature)
+
+	notification := &webhookNotificationEnvelope{}
+	assert.NoError(t, json.Unmarshal(requests[0].body, notification))
+	assert.Equal(t, queue.WebhookEventFailed, notification.Type)
+	assert.Equal(t, "job-1", notification.JobId)
+	assert.Equal(t, "job-set", notification.JobSetId)
+}
+
+func TestWebhookProcessor_PostsCloudEvent(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventFailed}, Format: queue.WebhookFormatCloudEvents}
+	processor, _ := newTestWebhookProcessor(webhook, newActiveJobsRepository())
+
+	err := processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "job-set", func() error { return nil }))
+	assert.NoError(t, err)
+	processor.Stop()
+
+	requests := receiver.Requests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, api.CloudEventsContentType, requests[0].contentType)
+
+	cloudEvent := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(requests[0].body, &cloudEvent))
+	assert.Equal(t, "1.0", cloudEvent["specversion"])
+	assert.Equal(t, "io.armada.job.failed", cloudEvent["type"])
+	assert.Equal(t, "/queues/queue", cloudEvent["source"])
+	assert.Equal(t, "job-1", cloudEvent["subject"])
+}
+
+func TestWebhookProcessor_IgnoresUnsubscribedEvents(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventSucceeded}}
+	processor, _ := newTestWebhookProcessor(webhook, newActiveJobsRepository())
+
+	acked := false
+	err := processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "job-set", func() error {
+		acked = true
+		return nil
+	}))
+	assert.NoError(t, err)
+	err = processor.handleMessage(createJobLeasedEventStreamMessage(func() error { return nil }))
+	assert.NoError(t, err)
+	processor.Stop()
+
+	assert.True(t, acked)
+	assert.Len(t, receiver.Requests(), 0)
+}
+
+func TestWebhookProcessor_DeadLettersAfterRetries(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusServiceUnavailable)
+	defer receiver.Close()
+
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventFailed}}
+	processor, deadLetter := newTestWebhookProcessor(webhook, newActiveJobsRepository())
+
+	acked := make(chan bool, 1)
+	err := processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "job-set", func() error {
+		acked <- true
+		return nil
+	}))
+	assert.NoError(t, err)
+	processor.Stop()
+
+	assert.True(t, <-acked)
+	assert.Len(t, receiver.Requests(), 3)
+	assert.Len(t, deadLetter.Notifications(), 1)
+}
+
+func TestWebhookProcessor_NotifiesJobSetCompletedOnce(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	jobRepository := newActiveJobsRepository("job-1", "job-2")
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventJobSetCompleted}}
+	processor, _ := newTestWebhookProcessor(webhook, jobRepository)
+
+	noAck := func() error { return nil }
+	assert.NoError(t, processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "job-set", noAck)))
+	assert.NoError(t, processor.handleMessage(createJobFailedEventStreamMessage("job-2", "queue", "job-set", noAck)))
+	// Duplicate terminal event after the job set is complete
+	assert.NoError(t, processor.handleMessage(createJobFailedEventStreamMessage("job-2", "queue", "job-set", noAck)))
+	processor.Stop()
+
+	requests := receiver.Requests()
+	assert.Len(t, requests, 1)
+	notification := &webhookNotificationEnvelope{}
+	assert.NoError(t, json.Unmarshal(requests[0].body, notification))
+	assert.Equal(t, queue.WebhookEventJobSetCompleted, notification.Type)
+	assert.Equal(t, "job-set", notification.JobSetId)
+}
+
+func TestWebhookProcessor_PostsJobSetNotificationsInOrder(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventFailed}}
+	processor, _ := newTestWebhookProcessor(webhook, newActiveJobsRepository())
+
+	noAck := func() error { return nil }
+	expected := []string{}
+	for i := 0; i < 20; i++ {
+		for _, jobSetId := range []string{"job-set-1", "job-set-2", "job-set-3"} {
+			jobId := fmt.Sprintf("%s-job-%d", jobSetId, i)
+			assert.NoError(t, processor.handleMessage(createJobFailedEventStreamMessage(jobId, "queue", jobSetId, noAck)))
+			if jobSetId == "job-set-1" {
+				expected = append(expected, jobId)
+			}
+		}
+	}
+	processor.Stop()
+
+	received := []string{}
+	for _, request := range receiver.Requests() {
+		notification := &webhookNotificationEnvelope{}
+		assert.NoError(t, json.Unmarshal(request.body, notification))
+		if notification.JobSetId == "job-set-1" {
+			received = append(received, notification.JobId)
+		}
+	}
+	assert.Equal(t, expected, received)
+}
+
+func TestWebhookProcessor_SlowWebhookDoesNotDelayOthers(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	fast := newWebhookReceiver(http.StatusOK)
+	defer fast.Close()
+
+	events := []queue.WebhookEvent{queue.WebhookEventFailed}
+	processor, deadLetter := newTestWebhookProcessorWithQueueSize(
+		[]queue.Webhook{{URL: slow.URL, Events: events}, {URL: fast.URL, Events: events}}, newActiveJobsRepository(), 2)
+
+	acked := 0
+	for i := 0; i < 5; i++ {
+		err := processor.handleMessage(createJobFailedEventStreamMessage(fmt.Sprintf("job-%d", i), "queue", "job-set", func() error {
+			acked++
+			return nil
+		}))
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			return len(fast.Requests()) == i+1
+		}, time.Second, time.Millisecond)
+	}
+	assert.Equal(t, 5, acked)
+
+	close(release)
+	processor.Stop()
+	// One notification is being posted to the slow webhook, two are queued and the others don't fit in its queue
+	assert.Len(t, deadLetter.Notifications(), 2)
+}
+
+func TestWebhookProcessor_ForgetsFinishedJobsOfIncompleteJobSets(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	webhook := queue.Webhook{URL: receiver.URL, Events: []queue.WebhookEvent{queue.WebhookEventJobSetCompleted}}
+	processor, _ := newTestWebhookProcessor(webhook, newActiveJobsRepository("job-1", "job-2"))
+
+	noAck := func() error { return nil }
+	assert.NoError(t, processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "job-set", noAck)))
+	assert.Len(t, processor.finished, 1)
+
+	processor.finished["queue/job-set"].updated = time.Now().Add(-finishedJobsRetention - time.Minute)
+	assert.NoError(t, processor.handleMessage(createJobFailedEventStreamMessage("job-1", "queue", "other-job-set", noAck)))
+	processor.Stop()
+
+	assert.Len(t, processor.finished, 1)
+	assert.NotContains(t, processor.finished, "queue/job-set")
+	assert.Empty(t, receiver.Requests())
+}
+
+func newTestWebhookProcessor(webhook queue.Webhook, jobRepository repository.JobRepository) (*WebhookEventProcessor, *recordingDeadLetterLog) {
+	return newTestWebhookProcessorWithQueueSize([]queue.Webhook{webhook}, jobRepository, 100)
+}
+
+func newTestWebhookProcessorWithQueueSize(
+	webhooks []queue.Webhook,
+	jobRepository repository.JobRepository,
+	queueSize int) (*WebhookEventProcessor, *recordingDeadLetterLog) {
+
+	deadLetter := &recordingDeadLetterLog{}
+	sender := NewWebhookSender(&configuration.WebhookConfig{
+		Timeout:        time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		Secrets:        map[string]string{"slack": "secret"},
+	}, deadLetter)
+	queueRepository := &webhookQueueRepository{queue: queue.Queue{Name: "queue", Webhooks: webhooks}}
+
+	processor := NewWebhookEventProcessor("test", &eventstream.JetstreamEventStream{}, queueRepository, jobRepository, sender, queueSize)
+	return processor, deadLetter
+}
+
+func createJobFailedEventStreamMessage(jobId string, queue string, jobSetId string, ackFunction eventstream.AckFn) *eventstream.Message {
+	return &eventstream.Message{
+		EventMessage: &api.EventMessage{
+			Events: &api.EventMessage_Failed{
+				Failed: &api.JobFailedEvent{
+					JobId:    jobId,
+					JobSetId: jobSetId,
+					Queue:    queue,
+					Created:  time.Now(),
+					Reason:   "OOMKilled",
+				},
+			},
+		},
+		Ack: ackFunction,
+	}
+}
+
+// EventMessage only unmarshals into a pre-populated oneof, so tests only decode the envelope
+type webhookNotificationEnvelope struct {
+	Type     queue.WebhookEvent `json:"type"`
+	JobSetId string             `json:"jobSetId"`
+	JobId    string             `json:"jobId"`
+}
+
+type receivedWebhook struct {
+	body        []byte
+	signature   string
+	contentType string
+}
+
+type webhookReceiver struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	requests []receivedWebhook
+}
+
+func newWebhookReceiver(status int) *webhookReceiver {
+	receiver := &webhookReceiver{}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		receiver.mutex.Lock()
+		receiver.requests = append(receiver.requests, receivedWebhook{
+			body:        body,
+			signature:   r.Header.Get(WebhookSignatureHeader),
+			contentType: r.Header.Get("Content-Type"),
+		})
+		receiver.mutex.Unlock()
+		w.WriteHeader(status)
+	}))
+	return receiver
+}
+
+func (r *webhookReceiver) Requests() []receivedWebhook {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.requests
+}
+
+type recordingDeadLetterLog struct {
+	mutex         sync.Mutex
+	notifications []*WebhookNotification
+}
+
+func (l *recordingDeadLetterLog) Record(webhook queue.Webhook, notification *WebhookNotification, err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.notifications = append(l.notifications, notification)
+}
+
+func (l *recordingDeadLetterLog) Notifications() []*WebhookNotification {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.notifications
+}
+
+type activeJobsRepository struct {
+	*mockJobRepository
+	activeIds []string
+}
+
+func newActiveJobsRepository(activeIds ...string) *activeJobsRepository {
+	return &activeJobsRepository{mockJobRepository: newMockJobRepository(), activeIds: activeIds}
+}
+
+func (repo *activeJobsRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
+	return repo.activeIds, nil
+}
+
+type webhookQueueRepository struct {
+	queue queue.Queue
+}
+
+func (repo *webhookQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	return []queue.Queue{repo.queue}, nil
+}
+
+func (repo *webhookQueueRepository) GetQueue(name string) (queue.Queue, error) {
+	if name != repo.queue.Name {
+		return queue.Queue{}, &repository.ErrQueueNotFound{QueueName: name}
+	}
+	return repo.queue, nil
+}
+
+func (repo *webhookQueueRepository) CreateQueue(queue.Queue) error {
+	return nil
+}
+
+func (repo *webhookQueueRepository) UpdateQueue(queue.Queue) error {
+	return nil
+}
+
+func (repo *webhookQueueRepository) DeleteQueue(name string) error {
+	return nil
+}
@@ -6,14 +6,19 @@ import (
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
 	"github.com/G-Research/armada/pkg/api"
 )
 
 const eventStreamPrefix = "Events:"
+const eventUtilisationPrefix = "EventsUtilisation:"
+const eventTrimLockPrefix = "EventsTrimLock:"
 const dataKey = "message"
 
+const eventTrimLockDuration = 30 * time.Second
+
 type EventStore interface {
 	ReportEvents(message []*api.EventMessage) error
 }
@@ -27,10 +32,17 @@ type EventRepository interface {
 type RedisEventRepository struct {
 	db             redis.UniversalClient
 	eventRetention configuration.EventRetentionPolicy
+	archive        EventArchive
 }
 
 func NewRedisEventRepository(db redis.UniversalClient, eventRetention configuration.EventRetentionPolicy) *RedisEventRepository {
-	return &RedisEventRepository{db: db, eventRetention: eventRetention}
+	return NewRedisEventRepositoryWithArchive(db, eventRetention, nil)
+}
+
+// NewRedisEventRepositoryWithArchive returns a repository which archives events trimmed from streams
+// longer than eventRetention.MaxStreamLength and reads through to the archive, archive may be nil.
+func NewRedisEventRepositoryWithArchive(db redis.UniversalClient, eventRetention configuration.EventRetentionPolicy, archive EventArchive) *RedisEventRepository {
+	return &RedisEventRepository{db: db, eventRetention: eventRetention, archive: archive}
 }
 
 func (repo *RedisEventRepository) ReportEvent(message *api.EventMessage) error {
@@ -43,8 +55,9 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 	}
 
 	type eventData struct {
-		key  string
-		data []byte
+		key         string
+		data        []byte
+		utilisation *api.JobUtilisationEvent
 	}
 	data := []eventData{}
 	uniqueJobSets := make(map[string]bool)
@@ -59,12 +72,20 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 			return e
 		}
 		key := getJobSetEventsKey(event.GetQueue(), event.GetJobSetId())
-		data = append(data, eventData{key: key, data: messageData})
+		utilisation, _ := event.(*api.JobUtilisationEvent)
+		data = append(data, eventData{key: key, data: messageData, utilisation: utilisation})
 		uniqueJobSets[key] = true
 	}
 
 	pipe := repo.db.Pipeline()
+	if repo.eventRetention.CompactUtilisationEvents {
+		addUtilisationEventScript.Load(pipe)
+	}
 	for _, e := range data {
+		if repo.eventRetention.CompactUtilisationEvents && e.utilisation != nil {
+			addUtilisationEventScript.Run(pipe, []string{e.key, getJobSetUtilisationKey(e.key)}, e.utilisation.JobId, e.data)
+			continue
+		}
 		pipe.XAdd(&redis.XAddArgs{
 			Stream: e.key,
 			Values: map[string]interface{}{
@@ -76,11 +97,101 @@ func (repo *RedisEventRepository) ReportEvents(messages []*api.EventMessage) err
 	if repo.eventRetention.ExpiryEnabled {
 		for key := range uniqueJobSets {
 			pipe.Expire(key, repo.eventRetention.RetentionDuration)
+			if repo.eventRetention.CompactUtilisationEvents {
+				pipe.Expire(getJobSetUtilisationKey(key), repo.eventRetention.RetentionDuration)
+			}
 		}
 	}
 
 	_, e := pipe.Exec()
-	return e
+	if e != nil {
+		return e
+	}
+
+	// The events are already stored, so failing to trim is not reported to the caller to avoid duplicates on retry
+	if repo.eventRetention.MaxStreamLength > 0 {
+		for key := range uniqueJobSets {
+			e = repo.trimStream(key)
+			if e != nil {
+				log.Errorf("error when trimming event stream %s: %v", key, e)
+			}
+		}
+	}
+	return nil
+}
+
+// Adds a utilisation event to the job set stream and deletes the previous utilisation event of the same job.
+// The key holding the id of the latest utilisation event per job uses the stream key as hash tag,
+// so both keys are stored in the same slot when running on a redis cluster.
+var addUtilisationEventScript = redis.NewScript(`
+local stream = KEYS[1]
+local latestUtilisation = KEYS[2]
+
+local jobId = ARGV[1]
+local eventData = ARGV[2]
+
+local id = redis.call('XADD', stream, '*', '` + dataKey + `', eventData)
+local previousId = redis.call('HGET', latestUtilisation, jobId)
+if previousId then
+	redis.call('XDEL', stream, previousId)
+end
+redis.call('HSET', latestUtilisation, jobId, id)
+return id
+`)
+
+// trimStream removes the oldest events of the stream above the configured maximum length, archiving them first if an
+// archive is configured. Trimming is skipped while another server is trimming the same stream.
+func (repo *RedisEventRepository) trimStream(key string) error {
+	length, err := repo.db.XLen(key).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisEventRepository.trimStream] error reading stream length: %s", err)
+	}
+	excess := length - repo.eventRetention.MaxStreamLength
+	if excess <= 0 {
+		return nil
+	}
+
+	locked, err := repo.db.SetNX(eventTrimLockPrefix+key, "", eventTrimLockDuration).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisEventRepository.trimStream] error acquiring lock: %s", err)
+	}
+	if !locked {
+		return nil
+	}
+	defer repo.db.Del(eventTrimLockPrefix + key)
+
+	trimmed, err := repo.db.XRangeN(key, "-", "+", excess).Result()
+	if err != nil {
+		return fmt.Errorf("[RedisEventRepository.trimStream] error reading from database: %s", err)
+	}
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	if repo.archive != nil {
+		messages, err := toEventStreamMessages(trimmed)
+		if err != nil {
+			return err
+		}
+		event, err := api.UnwrapEvent(messages[0].Message)
+		if err != nil {
+			return err
+		}
+		err = repo.archive.Archive(event.GetQueue(), event.GetJobSetId(), messages)
+		if err != nil {
+			return fmt.Errorf("[RedisEventRepository.trimStream] error archiving events: %s", err)
+		}
+	}
+
+	ids := make([]string, 0, len(trimmed))
+	for _, m := range trimmed {
+		ids = append(ids, m.ID)
+	}
+	err = repo.db.XDel(key, ids...).Err()
+	if err != nil {
+		return fmt.Errorf("[RedisEventRepository.trimStream] error deleting events: %s", err)
+	}
+	return nil
 }
 
 func (repo *RedisEventRepository) CheckStreamExists(queue string, jobSetId string) (bool, error) {
@@ -98,6 +209,17 @@ func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId stri
 		lastId = "0"
 	}
 
+	// Events trimmed from the stream are older than any event left in it, so they are read first
+	if repo.archive != nil {
+		archived, err := repo.archive.ReadEvents(queue, jobSetId, lastId, limit)
+		if err != nil {
+			return nil, fmt.Errorf("[RedisEventRepository.ReadEvents] error reading from archive: %s", err)
+		}
+		if len(archived) > 0 {
+			return archived, nil
+		}
+	}
+
 	cmd, err := repo.db.XRead(&redis.XReadArgs{
 		Streams: []string{getJobSetEventsKey(queue, jobSetId), lastId},
 		Count:   limit,
@@ -111,12 +233,16 @@ func (repo *RedisEventRepository) ReadEvents(queue, jobSetId string, lastId stri
 		return nil, fmt.Errorf("[RedisEventRepository.ReadEvents] error reading from database: %s", err)
 	}
 
+	return toEventStreamMessages(cmd[0].Messages)
+}
+
+func toEventStreamMessages(streamMessages []redis.XMessage) ([]*api.EventStreamMessage, error) {
 	messages := make([]*api.EventStreamMessage, 0)
-	for _, m := range cmd[0].Messages {
+	for _, m := range streamMessages {
 		data := m.Values[dataKey]
 		msg := &api.EventMessage{}
 		bytes := []byte(data.(string))
-		err = proto.Unmarshal(bytes, msg)
+		err := proto.Unmarshal(bytes, msg)
 		if err != nil {
 			return nil, fmt.Errorf("[RedisEventRepository.ReadEvents] error unmarshalling: %s", err)
 		}
@@ -139,3 +265,7 @@ func (repo *RedisEventRepository) GetLastMessageId(queue, jobSetId string) (stri
 func getJobSetEventsKey(queue, jobSetId string) string {
 	return eventStreamPrefix + queue + ":" + jobSetId
 }
+
+func getJobSetUtilisationKey(jobSetEventsKey string) string {
+	return eventUtilisationPrefix + "{" + jobSetEventsKey + "}"
+}
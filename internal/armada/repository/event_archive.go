@@ -0,0 +1,403 @@
+package repository
+
+import (
+	"bufio"
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// EventArchive stores events trimmed from job set streams, so they can still be read after they are removed from redis.
+// Events of a job set are archived in stream order.
+type EventArchive interface {
+	// Archive appends messages to the archive of the job set, skipping messages which are already archived
+	Archive(queue, jobSetId string, messages []*api.EventStreamMessage) error
+	// ReadEvents returns up to limit archived events of the job set with an id after lastId
+	ReadEvents(queue, jobSetId string, lastId string, limit int64) ([]*api.EventStreamMessage, error)
+}
+
+// maxCachedArchiveFiles bounds the number of job set files whose state is kept between calls.
+const maxCachedArchiveFiles = 10000
+
+// FileEventArchive archives events to one file per job set on the local filesystem.
+// When running several servers, the directory has to be shared between them.
+type FileEventArchive struct {
+	directory string
+	// mutex guards files and recent, each file is locked separately while it is read or written
+	mutex sync.Mutex
+	files map[string]*archiveFile
+	// recent orders the files from most to least recently used, the least recently used unused files are evicted
+	recent   *list.List
+	maxFiles int
+}
+
+// archiveFile holds the state of the archive of one job set, so polling readers don't rescan the file.
+type archiveFile struct {
+	mutex   sync.Mutex
+	users   int
+	element *list.Element
+	// lastArchived is the id of the last event in the first size bytes of the file, events up to it are not archived
+	// again. The file is only appended to, so it is scanned again from size if it grew.
+	lastArchived string
+	size         int64
+	// readOffset holds the offset after the last event read from the file
+	readOffset archiveOffset
+}
+
+type archiveOffset struct {
+	id     string
+	offset int64
+}
+
+// ArchivedJobSet identifies a job set with archived events.
+type ArchivedJobSet struct {
+	Queue    string
+	JobSetId string
+}
+
+type archivedEvent struct {
+	Id      string `json:"id"`
+	Message []byte `json:"message"`
+}
+
+func NewFileEventArchive(directory string) (*FileEventArchive, error) {
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("[NewFileEventArchive] error creating archive directory %s: %s", directory, err)
+	}
+	return &FileEventArchive{
+		directory: directory,
+		files:     map[string]*archiveFile{},
+		recent:    list.New(),
+		maxFiles:  maxCachedArchiveFiles,
+	}, nil
+}
+
+// acquire returns the locked state of the archive file at path, it has to be released again.
+func (a *FileEventArchive) acquire(path string) *archiveFile {
+	a.mutex.Lock()
+	file, ok := a.files[path]
+	if ok {
+		a.recent.MoveToFront(file.element)
+	} else {
+		file = &archiveFile{}
+		file.element = a.recent.PushFront(path)
+		a.files[path] = file
+	}
+	file.users++
+	a.evict()
+	a.mutex.Unlock()
+
+	file.mutex.Lock()
+	return file
+}
+
+func (a *FileEventArchive) release(file *archiveFile) {
+	file.mutex.Unlock()
+
+	a.mutex.Lock()
+	file.users--
+	a.evict()
+	a.mutex.Unlock()
+}
+
+// evict drops the state of the least recently used files no one is using until at most maxFiles are left.
+func (a *FileEventArchive) evict() {
+	element := a.recent.Back()
+	for len(a.files) > a.maxFiles && element != nil {
+		previous := element.Prev()
+		path := element.Value.(string)
+		if a.files[path].users == 0 {
+			a.recent.Remove(element)
+			delete(a.files, path)
+		}
+		element = previous
+	}
+}
+
+func (a *FileEventArchive) Archive(queue, jobSetId string, messages []*api.EventStreamMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	path := a.path(queue, jobSetId)
+	state := a.acquire(path)
+	defer a.release(state)
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("[FileEventArchive.Archive] error creating archive directory: %s", err)
+	}
+	// Events are archived again if deleting them from the stream failed after they were archived
+	lastId, err := lastArchivedId(state, path)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("[FileEventArchive.Archive] error opening archive: %s", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	for _, m := range messages {
+		if compareStreamIds(m.Id, lastId) <= 0 {
+			continue
+		}
+		lastId = m.Id
+		data, err := proto.Marshal(m.Message)
+		if err != nil {
+			return fmt.Errorf("[FileEventArchive.Archive] error marshalling: %s", err)
+		}
+		line, err := json.Marshal(archivedEvent{Id: m.Id, Message: data})
+		if err != nil {
+			return fmt.Errorf("[FileEventArchive.Archive] error marshalling: %s", err)
+		}
+		_, err = writer.Write(append(line, '\n'))
+		if err != nil {
+			return fmt.Errorf("[FileEventArchive.Archive] error writing archive: %s", err)
+		}
+	}
+	err = writer.Flush()
+	if err != nil {
+		// A partially written event can't be scanned, so the archive is scanned again from the start
+		state.lastArchived = ""
+		state.size = 0
+		state.readOffset = archiveOffset{}
+		return fmt.Errorf("[FileEventArchive.Archive] error writing archive: %s", err)
+	}
+	// The events just written are scanned again from the previous size by the next call, as other servers may have
+	// appended to the file at the same time.
+	return nil
+}
+
+// lastArchivedId returns the id of the last event archived to the file at path, only scanning what was appended to
+// the file since it was last called.
+func lastArchivedId(state *archiveFile, path string) (string, error) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("[FileEventArchive] error opening archive: %s", err)
+	}
+	if info.Size() == state.size {
+		return state.lastArchived, nil
+	}
+	if info.Size() < state.size {
+		state.lastArchived = ""
+		state.size = 0
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("[FileEventArchive] error opening archive: %s", err)
+	}
+	defer file.Close()
+
+	err = scanArchive(file, state.size, func(archived *archivedEvent, offset int64) (bool, error) {
+		if compareStreamIds(archived.Id, state.lastArchived) > 0 {
+			state.lastArchived = archived.Id
+		}
+		state.size = offset
+		return true, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return state.lastArchived, nil
+}
+
+func (a *FileEventArchive) ReadEvents(queue, jobSetId string, lastId string, limit int64) ([]*api.EventStreamMessage, error) {
+	messages := make([]*api.EventStreamMessage, 0)
+
+	path := a.path(queue, jobSetId)
+	state := a.acquire(path)
+	defer a.release(state)
+
+	// Readers which have read past the archive poll it without it being opened until events are archived
+	lastArchived, err := lastArchivedId(state, path)
+	if err != nil {
+		return nil, err
+	}
+	if compareStreamIds(lastId, lastArchived) >= 0 {
+		return messages, nil
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return messages, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("[FileEventArchive.ReadEvents] error opening archive: %s", err)
+	}
+	defer file.Close()
+
+	var start int64
+	if state.readOffset.id == lastId && state.readOffset.offset > 0 {
+		start = state.readOffset.offset
+	}
+	var end int64
+	err = scanArchive(file, start, func(archived *archivedEvent, offset int64) (bool, error) {
+		if limit > 0 && int64(len(messages)) >= limit {
+			return false, nil
+		}
+		// Skipping ids up to the last returned one also skips events archived twice
+		if compareStreamIds(archived.Id, lastId) <= 0 {
+			return true, nil
+		}
+		msg := &api.EventMessage{}
+		err := proto.Unmarshal(archived.Message, msg)
+		if err != nil {
+			return false, fmt.Errorf("[FileEventArchive.ReadEvents] error unmarshalling: %s", err)
+		}
+		messages = append(messages, &api.EventStreamMessage{Id: archived.Id, Message: msg})
+		lastId = archived.Id
+		end = offset
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) > 0 {
+		state.readOffset = archiveOffset{id: lastId, offset: end}
+	}
+	return messages, nil
+}
+
+// ScanEvents calls handle with the archived events of the job set with an id after lastId, reading the archive once,
+// until handle returns an error.
+func (a *FileEventArchive) ScanEvents(queue, jobSetId string, lastId string, handle func(message *api.EventStreamMessage) error) error {
+	file, err := os.Open(a.path(queue, jobSetId))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("[FileEventArchive.ScanEvents] error opening archive: %s", err)
+	}
+	defer file.Close()
+
+	return scanArchive(file, 0, func(archived *archivedEvent, offset int64) (bool, error) {
+		if compareStreamIds(archived.Id, lastId) <= 0 {
+			return true, nil
+		}
+		msg := &api.EventMessage{}
+		err := proto.Unmarshal(archived.Message, msg)
+		if err != nil {
+			return false, fmt.Errorf("[FileEventArchive.ScanEvents] error unmarshalling: %s", err)
+		}
+		lastId = archived.Id
+		return true, handle(&api.EventStreamMessage{Id: archived.Id, Message: msg})
+	})
+}
+
+// scanArchive calls handle with the events of file from offset on and the offset after each event,
+// until handle returns false or an error.
+func scanArchive(file *os.File, offset int64, handle func(archived *archivedEvent, offset int64) (bool, error)) error {
+	_, err := file.Seek(offset, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("[FileEventArchive] error reading archive: %s", err)
+	}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		offset += int64(len(line)) + 1
+		archived := &archivedEvent{}
+		err = json.Unmarshal(line, archived)
+		if err != nil {
+			return fmt.Errorf("[FileEventArchive] error unmarshalling: %s", err)
+		}
+		next, err := handle(archived, offset)
+		if err != nil {
+			return err
+		}
+		if !next {
+			return nil
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("[FileEventArchive] error reading archive: %s", err)
+	}
+	return nil
+}
+
+// ListJobSets returns the job sets with archived events, ordered by queue and job set id.
+func (a *FileEventArchive) ListJobSets() ([]ArchivedJobSet, error) {
+	queueDirs, err := os.ReadDir(a.directory)
+	if err != nil {
+		return nil, fmt.Errorf("[FileEventArchive.ListJobSets] error listing archives: %s", err)
+	}
+	jobSets := []ArchivedJobSet{}
+	for _, queueDir := range queueDirs {
+		if !queueDir.IsDir() {
+			continue
+		}
+		queue, err := url.PathUnescape(queueDir.Name())
+		if err != nil {
+			return nil, fmt.Errorf("[FileEventArchive.ListJobSets] invalid archive directory %s: %s", queueDir.Name(), err)
+		}
+		files, err := os.ReadDir(filepath.Join(a.directory, queueDir.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("[FileEventArchive.ListJobSets] error listing archives: %s", err)
+		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".events") {
+				continue
+			}
+			jobSetId, err := url.PathUnescape(strings.TrimSuffix(file.Name(), ".events"))
+			if err != nil {
+				return nil, fmt.Errorf("[FileEventArchive.ListJobSets] invalid archive %s: %s", file.Name(), err)
+			}
+			jobSets = append(jobSets, ArchivedJobSet{Queue: queue, JobSetId: jobSetId})
+		}
+	}
+	sort.Slice(jobSets, func(i, j int) bool {
+		if jobSets[i].Queue != jobSets[j].Queue {
+			return jobSets[i].Queue < jobSets[j].Queue
+		}
+		return jobSets[i].JobSetId < jobSets[j].JobSetId
+	})
+	return jobSets, nil
+}
+
+func (a *FileEventArchive) path(queue, jobSetId string) string {
+	return filepath.Join(a.directory, url.PathEscape(queue), url.PathEscape(jobSetId)+".events")
+}
+
+// compareStreamIds compares redis stream ids of the form <milliseconds>-<sequence>,
+// an empty id or "0" is before any other id.
+func compareStreamIds(a, b string) int {
+	aMillis, aSequence := parseStreamId(a)
+	bMillis, bSequence := parseStreamId(b)
+	switch {
+	case aMillis < bMillis:
+		return -1
+	case aMillis > bMillis:
+		return 1
+	case aSequence < bSequence:
+		return -1
+	case aSequence > bSequence:
+		return 1
+	}
+	return 0
+}
+
+func parseStreamId(id string) (uint64, uint64) {
+	parts := strings.SplitN(id, "-", 2)
+	millis, _ := strconv.ParseUint(parts[0], 10, 64)
+	if len(parts) < 2 {
+		return millis, 0
+	}
+	sequence, _ := strconv.ParseUint(parts[1], 10, 64)
+	return millis, sequence
+}
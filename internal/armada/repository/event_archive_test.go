@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestFileEventArchive_ReadEventsAfterLastId(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		err := archive.Archive("queue", "job/set", []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "job/set")},
+			{Id: "1-1", Message: createEvent("queue", "job/set")},
+		})
+		assert.NoError(t, err)
+		err = archive.Archive("queue", "job/set", []*api.EventStreamMessage{
+			{Id: "20-0", Message: createEvent("queue", "job/set")},
+		})
+		assert.NoError(t, err)
+
+		all, err := archive.ReadEvents("queue", "job/set", "0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-0", "1-1", "20-0"}, streamMessageIds(all))
+		assert.Equal(t, "job/set", all[0].Message.GetRunning().JobSetId)
+
+		afterFirst, err := archive.ReadEvents("queue", "job/set", "1-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-1", "20-0"}, streamMessageIds(afterFirst))
+
+		limited, err := archive.ReadEvents("queue", "job/set", "", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-0"}, streamMessageIds(limited))
+
+		none, err := archive.ReadEvents("queue", "job/set", "20-0", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, none)
+	})
+}
+
+func TestFileEventArchive_ArchivesEventsOnlyOnce(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		events := []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "job-set")},
+			{Id: "2-0", Message: createEvent("queue", "job-set")},
+		}
+		assert.NoError(t, archive.Archive("queue", "job-set", events))
+		// Trimming again after deleting the events from the stream failed
+		assert.NoError(t, archive.Archive("queue", "job-set", append(events, &api.EventStreamMessage{Id: "3-0", Message: createEvent("queue", "job-set")})))
+
+		// Another server sharing the archive directory
+		other, err := NewFileEventArchive(archive.directory)
+		assert.NoError(t, err)
+		assert.NoError(t, other.Archive("queue", "job-set", events))
+
+		all, err := other.ReadEvents("queue", "job-set", "0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-0", "2-0", "3-0"}, streamMessageIds(all))
+	})
+}
+
+func TestFileEventArchive_ContinuesReadingAfterAppend(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		err := archive.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "job-set")},
+			{Id: "2-0", Message: createEvent("queue", "job-set")},
+			{Id: "3-0", Message: createEvent("queue", "job-set")},
+		})
+		assert.NoError(t, err)
+
+		first, err := archive.ReadEvents("queue", "job-set", "0", 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-0", "2-0"}, streamMessageIds(first))
+
+		err = archive.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "4-0", Message: createEvent("queue", "job-set")},
+		})
+		assert.NoError(t, err)
+
+		second, err := archive.ReadEvents("queue", "job-set", "2-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"3-0", "4-0"}, streamMessageIds(second))
+
+		// Readers which aren't continuing from the last read start from the beginning of the archive
+		fromStart, err := archive.ReadEvents("queue", "job-set", "1-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2-0", "3-0", "4-0"}, streamMessageIds(fromStart))
+	})
+}
+
+func TestFileEventArchive_DoesNotScanArchiveForReadersPastIt(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		err := archive.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "job-set")},
+			{Id: "2-0", Message: createEvent("queue", "job-set")},
+		})
+		assert.NoError(t, err)
+		_, err = archive.ReadEvents("queue", "job-set", "0", 10)
+		assert.NoError(t, err)
+
+		// Corrupt the archive without changing its size, so reading it fails
+		path := archive.path("queue", "job-set")
+		info, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(path, bytes.Repeat([]byte("x"), int(info.Size())), 0644))
+
+		messages, err := archive.ReadEvents("queue", "job-set", "2-0", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+		messages, err = archive.ReadEvents("queue", "job-set", "5-0", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+
+		_, err = archive.ReadEvents("queue", "job-set", "1-0", 10)
+		assert.Error(t, err)
+	})
+}
+
+func TestFileEventArchive_ReadsEventsArchivedByOtherServers(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		err := archive.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "job-set")},
+		})
+		assert.NoError(t, err)
+		all, err := archive.ReadEvents("queue", "job-set", "0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-0"}, streamMessageIds(all))
+
+		other, err := NewFileEventArchive(archive.directory)
+		assert.NoError(t, err)
+		err = other.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "2-0", Message: createEvent("queue", "job-set")},
+		})
+		assert.NoError(t, err)
+
+		next, err := archive.ReadEvents("queue", "job-set", "1-0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2-0"}, streamMessageIds(next))
+	})
+}
+
+func TestFileEventArchive_BoundsCachedFiles(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		archive.maxFiles = 2
+		for _, jobSetId := range []string{"a", "b", "c"} {
+			err := archive.Archive("queue", jobSetId, []*api.EventStreamMessage{
+				{Id: "1-0", Message: createEvent("queue", jobSetId)},
+			})
+			assert.NoError(t, err)
+		}
+		assert.Len(t, archive.files, 2)
+		assert.Equal(t, 2, archive.recent.Len())
+
+		// Evicted files are scanned again, so events aren't archived twice
+		err := archive.Archive("queue", "a", []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "a")},
+		})
+		assert.NoError(t, err)
+		all, err := archive.ReadEvents("queue", "a", "0", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1-0"}, streamMessageIds(all))
+		assert.Len(t, archive.files, 2)
+	})
+}
+
+func TestFileEventArchive_ReadEventsOfUnknownJobSet(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		messages, err := archive.ReadEvents("queue", "missing", "0", 10)
+		assert.NoError(t, err)
+		assert.Empty(t, messages)
+	})
+}
+
+func TestFileEventArchive_ScanEvents(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		err := archive.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "1-0", Message: createEvent("queue", "job-set")},
+			{Id: "2-0", Message: createEvent("queue", "job-set")},
+			{Id: "3-0", Message: createEvent("queue", "job-set")},
+		})
+		assert.NoError(t, err)
+
+		scanned := []*api.EventStreamMessage{}
+		err = archive.ScanEvents("queue", "job-set", "1-0", func(message *api.EventStreamMessage) error {
+			scanned = append(scanned, message)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2-0", "3-0"}, streamMessageIds(scanned))
+
+		err = archive.ScanEvents("queue", "job-set", "", func(message *api.EventStreamMessage) error {
+			return assert.AnError
+		})
+		assert.Equal(t, assert.AnError, err)
+
+		err = archive.ScanEvents("queue", "unknown", "", func(message *api.EventStreamMessage) error {
+			return assert.AnError
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestFileEventArchive_ListJobSets(t *testing.T) {
+	withFileEventArchive(t, func(archive *FileEventArchive) {
+		for _, jobSet := range []ArchivedJobSet{{"queue-b", "a"}, {"queue-a", "job/set"}, {"queue-a", "b"}} {
+			err := archive.Archive(jobSet.Queue, jobSet.JobSetId, []*api.EventStreamMessage{
+				{Id: "1-0", Message: createEvent(jobSet.Queue, jobSet.JobSetId)},
+			})
+			assert.NoError(t, err)
+		}
+
+		jobSets, err := archive.ListJobSets()
+		assert.NoError(t, err)
+		assert.Equal(t, []ArchivedJobSet{{"queue-a", "b"}, {"queue-a", "job/set"}, {"queue-b", "a"}}, jobSets)
+	})
+}
+
+func TestCompareStreamIds(t *testing.T) {
+	assert.Equal(t, -1, compareStreamIds("0", "1-0"))
+	assert.Equal(t, -1, compareStreamIds("", "0-1"))
+	assert.Equal(t, -1, compareStreamIds("9-5", "10-0"))
+	assert.Equal(t, 1, compareStreamIds("10-2", "10-1"))
+	assert.Equal(t, 0, compareStreamIds("10-1", "10-1"))
+}
+
+func streamMessageIds(messages []*api.EventStreamMessage) []string {
+	ids := []string{}
+	for _, m := range messages {
+		ids = append(ids, m.Id)
+	}
+	return ids
+}
+
+func withFileEventArchive(t *testing.T, action func(archive *FileEventArchive)) {
+	directory, err := ioutil.TempDir("", "event-archive")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+
+	archive, err := NewFileEventArchive(directory)
+	assert.NoError(t, err)
+	action(archive)
+}
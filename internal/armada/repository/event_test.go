@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -27,6 +29,78 @@ func TestCheckStreamExists(t *testing.T) {
 	})
 }
 
+func TestReportEvents_CompactsUtilisationEvents(t *testing.T) {
+	eventRetention := configuration.EventRetentionPolicy{CompactUtilisationEvents: true}
+	withRedisEventRepositoryConfig(eventRetention, nil, func(r *RedisEventRepository) {
+		err := r.ReportEvents([]*api.EventMessage{
+			createEvent("test", "jobset"),
+			createUtilisationEvent("test", "jobset", "job-1"),
+			createUtilisationEvent("test", "jobset", "job-2"),
+		})
+		assert.NoError(t, err)
+		err = r.ReportEvents([]*api.EventMessage{createUtilisationEvent("test", "jobset", "job-1")})
+		assert.NoError(t, err)
+
+		messages, err := r.ReadEvents("test", "jobset", "", 100, 0)
+		assert.NoError(t, err)
+		if assert.Len(t, messages, 3) {
+			assert.NotNil(t, messages[0].Message.GetRunning())
+			assert.Equal(t, "job-2", messages[1].Message.GetUtilisation().JobId)
+			assert.Equal(t, "job-1", messages[2].Message.GetUtilisation().JobId)
+		}
+	})
+}
+
+func TestReportEvents_TrimsAndArchivesEvents(t *testing.T) {
+	directory, err := ioutil.TempDir("", "event-archive")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+	archive, err := NewFileEventArchive(directory)
+	assert.NoError(t, err)
+
+	eventRetention := configuration.EventRetentionPolicy{MaxStreamLength: 2}
+	withRedisEventRepositoryConfig(eventRetention, archive, func(r *RedisEventRepository) {
+		for i := 0; i < 5; i++ {
+			err := r.ReportEvents([]*api.EventMessage{createEvent("test", "jobset")})
+			assert.NoError(t, err)
+		}
+
+		length, err := r.db.XLen(getJobSetEventsKey("test", "jobset")).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), length)
+
+		archived, err := archive.ReadEvents("test", "jobset", "0", 100)
+		assert.NoError(t, err)
+		assert.Len(t, archived, 3)
+
+		lastId := ""
+		read := []*api.EventStreamMessage{}
+		for {
+			messages, err := r.ReadEvents("test", "jobset", lastId, 2, 0)
+			assert.NoError(t, err)
+			if len(messages) == 0 {
+				break
+			}
+			read = append(read, messages...)
+			lastId = messages[len(messages)-1].Id
+		}
+		assert.Len(t, read, 5)
+	})
+}
+
+func createUtilisationEvent(queue string, jobSetId string, jobId string) *api.EventMessage {
+	return &api.EventMessage{
+		Events: &api.EventMessage_Utilisation{
+			Utilisation: &api.JobUtilisationEvent{
+				JobId:    jobId,
+				JobSetId: jobSetId,
+				Queue:    queue,
+				Created:  time.Now(),
+			},
+		},
+	}
+}
+
 func createEvent(queue string, jobSetId string) *api.EventMessage {
 	return &api.EventMessage{
 		Events: &api.EventMessage_Running{
@@ -50,3 +124,14 @@ func withRedisEventRepository(action func(r *RedisEventRepository)) {
 	repo := NewRedisEventRepository(client, configuration.EventRetentionPolicy{ExpiryEnabled: true, RetentionDuration: time.Hour})
 	action(repo)
 }
+
+func withRedisEventRepositoryConfig(eventRetention configuration.EventRetentionPolicy, archive EventArchive, action func(r *RedisEventRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+
+	client.FlushDB()
+
+	repo := NewRedisEventRepositoryWithArchive(client, eventRetention, archive)
+	action(repo)
+}
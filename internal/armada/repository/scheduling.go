@@ -10,10 +10,13 @@ import (
 )
 
 const clusterSchedulingInfoReportKey = "Cluster:SchedulingInfo"
+const clusterDrainedKey = "Cluster:Drained"
 
 type SchedulingInfoRepository interface {
 	GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error)
 	UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error
+	IsClusterDrained(clusterId string) (bool, error)
+	SetClusterDrained(clusterId string, drained bool) error
 }
 
 type RedisSchedulingInfoRepository struct {
@@ -55,3 +58,24 @@ func (r *RedisSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.
 
 	return nil
 }
+
+func (r *RedisSchedulingInfoRepository) IsClusterDrained(clusterId string) (bool, error) {
+	drained, err := r.db.HExists(clusterDrainedKey, clusterId).Result()
+	if err != nil {
+		return false, fmt.Errorf("[RedisSchedulingInfoRepository.IsClusterDrained] error reading from database: %s", err)
+	}
+	return drained, nil
+}
+
+func (r *RedisSchedulingInfoRepository) SetClusterDrained(clusterId string, drained bool) error {
+	var err error
+	if drained {
+		err = r.db.HSet(clusterDrainedKey, clusterId, "").Err()
+	} else {
+		err = r.db.HDel(clusterDrainedKey, clusterId).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("[RedisSchedulingInfoRepository.SetClusterDrained] error writing to database: %s", err)
+	}
+	return nil
+}
@@ -263,6 +263,10 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 			topJobs = c.queueCache[queue.Name]
 		}
 
+		if c.schedulingConfig.PreferCachedImages {
+			topJobs = orderJobsByCachedImages(topJobs, c.nodeResources)
+		}
+
 		candidates := make([]*api.Job, 0)
 		candidatesLimit := NewLeasePayloadLimit(limit.remainingJobCount, limit.remainingPayloadSizeLimitBytes, limit.maxExpectedJobSizeBytes)
 		candidateNodes := map[*api.Job]nodeTypeUsedResources{}
@@ -273,7 +277,7 @@ func (c *leaseContext) leaseJobs(queue *api.Queue, slice common.ComputeResources
 			remainder = slice.DeepCopy()
 			remainder.Sub(requirement)
 			if isLargeEnough(job, c.minimumJobSize) && remainder.IsValid() && candidatesLimit.IsWithinLimit(job) {
-				newlyConsumed, ok := matchAnyNodeTypeAllocation(job, c.nodeResources, consumedNodeResources)
+				newlyConsumed, ok := matchAnyNodeTypeAllocation(job, c.nodeResources, consumedNodeResources, c.schedulingConfig.PreferCachedImages)
 				if ok {
 					slice = remainder
 					candidates = append(candidates, job)
@@ -321,6 +325,25 @@ func (c *leaseContext) decreaseNodeResources(leased []*api.Job, nodeTypeUsage ma
 	}
 }
 
+// orderJobsByCachedImages moves the jobs whose images are all cached on the cluster in front of the jobs of the same priority,
+// keeping the order otherwise. The jobs are expected in queue order, so jobs of the same priority are next to each other.
+func orderJobsByCachedImages(jobs []*api.Job, nodeResources []*nodeTypeAllocation) []*api.Job {
+	ordered := make([]*api.Job, 0, len(jobs))
+	others := make([]*api.Job, 0, len(jobs))
+	for i, job := range jobs {
+		if holdsAllJobImages(job, nodeResources) {
+			ordered = append(ordered, job)
+		} else {
+			others = append(others, job)
+		}
+		if i == len(jobs)-1 || jobs[i+1].Priority != job.Priority {
+			ordered = append(ordered, others...)
+			others = others[:0]
+		}
+	}
+	return ordered
+}
+
 func removeJobs(jobs []*api.Job, jobsToRemove []*api.Job) []*api.Job {
 	jobsToRemoveIds := make(map[string]bool, len(jobsToRemove))
 	for _, job := range jobsToRemove {
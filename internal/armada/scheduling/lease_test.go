@@ -247,6 +247,39 @@ func Test_leaseJobs_DoesNotExceededLeasePayloadSizeLimit(t *testing.T) {
 	assert.Equal(t, remaining, expectedRemaining.AsFloat())
 }
 
+func Test_orderJobsByCachedImages(t *testing.T) {
+	resources := common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+	nodes := []api.NodeInfo{{Name: "n1", AllocatableResources: resources, AvailableResources: resources, CachedImages: []string{"docker.io/library/ubuntu:latest"}}}
+
+	otherPodSpec := classicPodSpec.DeepCopy()
+	otherPodSpec.Containers[0].Image = "registry.example.com/model:1.0"
+
+	job1 := &api.Job{Id: "1", PodSpec: otherPodSpec}
+	job2 := &api.Job{Id: "2", PodSpec: classicPodSpec}
+	job3 := &api.Job{Id: "3", PodSpec: otherPodSpec}
+	job4 := &api.Job{Id: "4", PodSpec: classicPodSpec}
+
+	ordered := orderJobsByCachedImages([]*api.Job{job1, job2, job3, job4}, AggregateNodeTypeAllocations(nodes))
+	assert.Equal(t, []*api.Job{job2, job4, job1, job3}, ordered)
+}
+
+func Test_orderJobsByCachedImages_KeepsPriorityOrder(t *testing.T) {
+	resources := common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+	nodes := []api.NodeInfo{{Name: "n1", AllocatableResources: resources, AvailableResources: resources, CachedImages: []string{"docker.io/library/ubuntu:latest"}}}
+
+	otherPodSpec := classicPodSpec.DeepCopy()
+	otherPodSpec.Containers[0].Image = "registry.example.com/model:1.0"
+
+	job1 := &api.Job{Id: "1", Priority: 1, PodSpec: otherPodSpec}
+	job2 := &api.Job{Id: "2", Priority: 1, PodSpec: classicPodSpec}
+	job3 := &api.Job{Id: "3", Priority: 2, PodSpec: otherPodSpec}
+	job4 := &api.Job{Id: "4", Priority: 2, PodSpec: otherPodSpec}
+	job5 := &api.Job{Id: "5", Priority: 2, PodSpec: classicPodSpec}
+
+	ordered := orderJobsByCachedImages([]*api.Job{job1, job2, job3, job4, job5}, AggregateNodeTypeAllocations(nodes))
+	assert.Equal(t, []*api.Job{job2, job1, job5, job3, job4}, ordered)
+}
+
 func Test_calculateQueueSchedulingLimits(t *testing.T) {
 	queue1 := &api.Queue{Name: "queue1", PriorityFactor: 1}
 	activeQueues := []*api.Queue{queue1}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/pkg/api"
 )
 
@@ -19,6 +20,7 @@ func CreateClusterSchedulingInfoReport(leaseRequest *api.LeaseRequest, nodeAlloc
 		ReportTime:     time.Now(),
 		NodeTypes:      extractNodeTypes(nodeAllocations),
 		MinimumJobSize: leaseRequest.MinimumJobSize,
+		Draining:       leaseRequest.Draining,
 	}
 }
 
@@ -74,13 +76,18 @@ func matchAnyNodeType(podSpec *v1.PodSpec, nodeTypes []*api.NodeType) bool {
 
 func matchAnyNodeTypeAllocation(job *api.Job,
 	nodeAllocations []*nodeTypeAllocation,
-	alreadyConsumed nodeTypeUsedResources) (nodeTypeUsedResources, bool) {
+	alreadyConsumed nodeTypeUsedResources,
+	preferCachedImages bool) (nodeTypeUsedResources, bool) {
 
 	newlyConsumed := nodeTypeUsedResources{}
 
 	for _, podSpec := range job.GetAllPodSpecs() {
 
-		nodeType, ok := matchAnyNodeTypePodAllocation(podSpec, nodeAllocations, alreadyConsumed, newlyConsumed)
+		podNodeAllocations := nodeAllocations
+		if preferCachedImages {
+			podNodeAllocations = orderByCachedImages(podSpec, nodeAllocations)
+		}
+		nodeType, ok := matchAnyNodeTypePodAllocation(podSpec, podNodeAllocations, alreadyConsumed, newlyConsumed)
 
 		if !ok {
 			return nodeTypeUsedResources{}, false
@@ -113,6 +120,54 @@ func matchAnyNodeTypePodAllocation(
 	return nil, false
 }
 
+// orderByCachedImages moves the node types holding all images of the pod to the front, keeping the order otherwise.
+func orderByCachedImages(podSpec *v1.PodSpec, nodeAllocations []*nodeTypeAllocation) []*nodeTypeAllocation {
+	images := podImages(podSpec)
+	holding := []*nodeTypeAllocation{}
+	others := []*nodeTypeAllocation{}
+	for _, node := range nodeAllocations {
+		if node.holdsImages(images) {
+			holding = append(holding, node)
+		} else {
+			others = append(others, node)
+		}
+	}
+	return append(holding, others...)
+}
+
+// holdsAllJobImages returns whether all images of the job are cached on a node of any of the node types.
+func holdsAllJobImages(job *api.Job, nodeAllocations []*nodeTypeAllocation) bool {
+	images := []string{}
+	for _, podSpec := range job.GetAllPodSpecs() {
+		images = append(images, podImages(podSpec)...)
+	}
+	for _, image := range images {
+		cached := false
+		for _, node := range nodeAllocations {
+			if node.cachedImages[image] {
+				cached = true
+				break
+			}
+		}
+		if !cached {
+			return false
+		}
+	}
+	return true
+}
+
+func podImages(podSpec *v1.PodSpec) []string {
+	containers := make([]v1.Container, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+
+	images := []string{}
+	for _, container := range containers {
+		images = append(images, util.NormalizeImageName(container.Image))
+	}
+	return images
+}
+
 func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 	nodeTypesIndex := map[string]*nodeTypeAllocation{}
 
@@ -135,6 +190,12 @@ func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 		} else {
 			typeDescription.availableResources.Add(nodeAvailableResources)
 		}
+		for _, image := range n.CachedImages {
+			if typeDescription.cachedImages == nil {
+				typeDescription.cachedImages = map[string]bool{}
+			}
+			typeDescription.cachedImages[image] = true
+		}
 	}
 
 	result := []*nodeTypeAllocation{}
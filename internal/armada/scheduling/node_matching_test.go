@@ -128,3 +128,46 @@ func Test_AggregateNodeTypesAllocations_NodesWithMoreTaintsGoFirst(t *testing.T)
 		},
 	}, aggregated)
 }
+
+func Test_AggregateNodeTypesAllocations_CollectsCachedImages(t *testing.T) {
+	resources := common.ComputeResources{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")}
+	nodes := []api.NodeInfo{
+		{Name: "n1", AllocatableResources: resources, AvailableResources: resources, CachedImages: []string{"docker.io/library/a:1"}},
+		{Name: "n2", AllocatableResources: resources, AvailableResources: resources, CachedImages: []string{"docker.io/library/b:1"}},
+	}
+
+	aggregated := AggregateNodeTypeAllocations(nodes)
+	if assert.Len(t, aggregated, 1) {
+		assert.Equal(t, map[string]bool{"docker.io/library/a:1": true, "docker.io/library/b:1": true}, aggregated[0].cachedImages)
+	}
+}
+
+func Test_matchAnyNodeTypeAllocation_PrefersNodeTypesWithCachedImages(t *testing.T) {
+	small := common.ComputeResources{"cpu": resource.MustParse("2"), "memory": resource.MustParse("2Gi")}
+	large := common.ComputeResources{"cpu": resource.MustParse("8"), "memory": resource.MustParse("8Gi")}
+	nodes := []api.NodeInfo{
+		{Name: "small", AllocatableResources: small, AvailableResources: small},
+		{Name: "large", AllocatableResources: large, AvailableResources: large, CachedImages: []string{"docker.io/library/ubuntu:latest"}},
+	}
+	nodeAllocations := AggregateNodeTypeAllocations(nodes)
+	job := &api.Job{PodSpec: classicPodSpec}
+
+	consumed, ok := matchAnyNodeTypeAllocation(job, nodeAllocations, nodeTypeUsedResources{}, false)
+	assert.True(t, ok)
+	assert.Contains(t, consumed, nodeAllocations[0])
+
+	consumed, ok = matchAnyNodeTypeAllocation(job, nodeAllocations, nodeTypeUsedResources{}, true)
+	assert.True(t, ok)
+	assert.Contains(t, consumed, nodeAllocations[1])
+}
+
+func Test_podImages_DoesNotModifyPodSpec(t *testing.T) {
+	initContainers := make([]v1.Container, 1, 2)
+	initContainers[0] = v1.Container{Image: "init:1"}
+	podSpec := &v1.PodSpec{InitContainers: initContainers, Containers: []v1.Container{{Image: "main:1"}}}
+
+	images := podImages(podSpec)
+
+	assert.Equal(t, []string{"docker.io/library/init:1", "docker.io/library/main:1"}, images)
+	assert.Equal(t, "", initContainers[:2][1].Image)
+}
@@ -8,6 +8,7 @@ import (
 type nodeTypeAllocation struct {
 	nodeType           api.NodeType
 	availableResources common.ComputeResourcesFloat
+	cachedImages       map[string]bool // Images cached on any of the nodes of the type
 }
 
 type nodeTypeUsedResources map[*nodeTypeAllocation]common.ComputeResourcesFloat
@@ -27,3 +28,12 @@ func (r nodeTypeUsedResources) Add(consumed nodeTypeUsedResources) {
 		r[nodeType] = newResources
 	}
 }
+
+func (n *nodeTypeAllocation) holdsImages(images []string) bool {
+	for _, image := range images {
+		if !n.cachedImages[image] {
+			return false
+		}
+	}
+	return true
+}
@@ -63,7 +63,15 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 	queueCache := cache.NewQueueCache(queueRepository, jobRepository, schedulingInfoRepository)
 	taskManager.Register(queueCache.Refresh, config.Metrics.RefreshInterval, "refresh_queue_cache")
 
-	redisEventRepository := repository.NewRedisEventRepository(eventsDb, config.EventRetention)
+	var eventArchive repository.EventArchive
+	if config.EventRetention.ArchiveDirectory != "" {
+		fileEventArchive, err := repository.NewFileEventArchive(config.EventRetention.ArchiveDirectory)
+		if err != nil {
+			panic(err)
+		}
+		eventArchive = fileEventArchive
+	}
+	redisEventRepository := repository.NewRedisEventRepositoryWithArchive(eventsDb, config.EventRetention, eventArchive)
 	var eventStore repository.EventStore
 	var eventStream eventstream.EventStream
 
@@ -96,6 +104,14 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 		}
 		eventStream = stream
 
+		healthChecks.Add(stream)
+	} else if len(config.EventsKafka.Brokers) > 0 {
+		stream, err := eventstream.NewKafkaEventStream(&config.EventsKafka)
+		if err != nil {
+			panic(err)
+		}
+		eventStream = stream
+
 		healthChecks.Add(stream)
 	}
 
@@ -112,6 +128,20 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 		jobStatusProcessor := processor.NewEventJobStatusProcessor(config.Events.JobStatusQueue, jobRepository, eventStream, jobStatusBatcher)
 		jobStatusProcessor.Start()
 
+		var webhookProcessor *processor.WebhookEventProcessor
+		if config.EventWebhooks.Enabled {
+			var deadLetter processor.DeadLetterLog = processor.LogDeadLetterLog{}
+			if config.EventWebhooks.DeadLetterFile != "" {
+				deadLetter, err = processor.NewFileDeadLetterLog(config.EventWebhooks.DeadLetterFile)
+				if err != nil {
+					panic(err)
+				}
+			}
+			sender := processor.NewWebhookSender(&config.EventWebhooks, deadLetter)
+			webhookProcessor = processor.NewWebhookEventProcessor(config.Events.WebhookQueue, eventStream, queueRepository, jobRepository, sender, config.EventWebhooks.QueueSize)
+			webhookProcessor.Start()
+		}
+
 		// TODO Teardown functions should return an error that can be logged/whatever by the caller.
 		// Not use log internally.
 		eventstreamTeardown = func() {
@@ -127,6 +157,9 @@ func Serve(config *configuration.ArmadaConfig, healthChecks *health.MultiChecker
 			if err != nil {
 				log.Errorf("failed to close event stream connection: %v", err)
 			}
+			if webhookProcessor != nil {
+				webhookProcessor.Stop()
+			}
 		}
 	} else {
 		eventStore = redisEventRepository
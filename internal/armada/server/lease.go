@@ -56,6 +56,14 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 		return nil, status.Errorf(codes.PermissionDenied, "[LeaseJobs] error: %s", err)
 	}
 
+	drained, err := q.schedulingInfoRepository.IsClusterDrained(request.ClusterId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[LeaseJobs] error getting cluster drain state: %s", err)
+	}
+	if request.Draining || drained {
+		return q.reportDraining(request, drained)
+	}
+
 	var res common.ComputeResources = request.Resources
 	if res.AsFloat().IsLessThan(q.schedulingConfig.MinimumResourceToSchedule) {
 		return &api.JobLease{}, nil
@@ -128,6 +136,46 @@ func (q AggregatedQueueServer) LeaseJobs(ctx context.Context, request *api.Lease
 	return jobLease, nil
 }
 
+// reportDraining records the state of a draining cluster without leasing any jobs to it.
+// The cluster is draining because the executor requested it, or because it was drained on the server.
+func (q AggregatedQueueServer) reportDraining(request *api.LeaseRequest, drained bool) (*api.JobLease, error) {
+	err := q.usageRepository.UpdateClusterLeased(&request.ClusterLeasedReport)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[LeaseJobs] error updating cluster lease report: %s", err)
+	}
+
+	nodeResources := scheduling.AggregateNodeTypeAllocations(request.Nodes)
+	clusterSchedulingInfo := scheduling.CreateClusterSchedulingInfoReport(request, nodeResources)
+	clusterSchedulingInfo.Draining = true
+	err = q.schedulingInfoRepository.UpdateClusterSchedulingInfo(clusterSchedulingInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[LeaseJobs] error updating cluster scheduling info: %s", err)
+	}
+	return &api.JobLease{Draining: drained}, nil
+}
+
+// DrainCluster starts or stops draining a cluster. No jobs are leased to a drained cluster,
+// and its executor returns the leases of jobs still running after its drain deadline.
+func (q *AggregatedQueueServer) DrainCluster(ctx context.Context, request *api.ClusterDrainRequest) (*types.Empty, error) {
+	if err := checkPermission(q.permissions, ctx, permissions.DrainClusters); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[DrainCluster] error: %s", err)
+	}
+	if request.ClusterId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[DrainCluster] cluster id must not be empty")
+	}
+
+	err := q.schedulingInfoRepository.SetClusterDrained(request.ClusterId, request.Draining)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[DrainCluster] error updating cluster drain state: %s", err)
+	}
+	if request.Draining {
+		log.Infof("Draining cluster %s", request.ClusterId)
+	} else {
+		log.Infof("Stopped draining cluster %s", request.ClusterId)
+	}
+	return &types.Empty{}, nil
+}
+
 func (q *AggregatedQueueServer) RenewLease(ctx context.Context, request *api.RenewLeaseRequest) (*api.IdList, error) {
 	if err := checkPermission(q.permissions, ctx, permissions.ExecuteJobs); err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, "[RenewLease] error: %s", err)
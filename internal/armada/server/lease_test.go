@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/G-Research/armada/internal/armada/cache"
 	"github.com/G-Research/armada/internal/armada/configuration"
@@ -110,6 +112,69 @@ func TestAggregatedQueueServer_ReturningLeaseMoreThanMaxRetriesSendsJobFailedEve
 	assert.Equal(t, fmt.Sprintf("Exceeded maximum number of retries: %d", maxRetries), failedEvent.Reason)
 }
 
+func TestAggregatedQueueServer_LeaseJobsWhenDraining_RecordsDrainingWithoutLeasing(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{{Id: "job-id-1", Queue: "queue"}})
+	assert.Nil(t, addJobsErr)
+
+	lease, err := aggregatedQueueClient.LeaseJobs(context.TODO(), &api.LeaseRequest{
+		ClusterId: "cluster-1",
+		Pool:      "pool",
+		Draining:  true,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, lease.Job)
+
+	schedulingInfoRepository := aggregatedQueueClient.schedulingInfoRepository.(*fakeSchedulingInfoRepository)
+	if assert.Len(t, schedulingInfoRepository.updatedReports, 1) {
+		report := schedulingInfoRepository.updatedReports[0]
+		assert.Equal(t, "cluster-1", report.ClusterId)
+		assert.True(t, report.Draining)
+	}
+}
+
+func TestAggregatedQueueServer_LeaseJobsWhenDrainedOnServer_ReportsDrainingWithoutLeasing(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+
+	_, addJobsErr := mockJobRepository.AddJobs([]*api.Job{{Id: "job-id-1", Queue: "queue"}})
+	assert.Nil(t, addJobsErr)
+
+	_, err := aggregatedQueueClient.DrainCluster(context.TODO(), &api.ClusterDrainRequest{ClusterId: "cluster-1", Draining: true})
+	assert.Nil(t, err)
+
+	lease, err := aggregatedQueueClient.LeaseJobs(context.TODO(), &api.LeaseRequest{ClusterId: "cluster-1", Pool: "pool"})
+	assert.Nil(t, err)
+	assert.Empty(t, lease.Job)
+	assert.True(t, lease.Draining)
+
+	schedulingInfoRepository := aggregatedQueueClient.schedulingInfoRepository.(*fakeSchedulingInfoRepository)
+	if assert.Len(t, schedulingInfoRepository.updatedReports, 1) {
+		assert.True(t, schedulingInfoRepository.updatedReports[0].Draining)
+	}
+
+	_, err = aggregatedQueueClient.DrainCluster(context.TODO(), &api.ClusterDrainRequest{ClusterId: "cluster-1", Draining: false})
+	assert.Nil(t, err)
+	drained, err := schedulingInfoRepository.IsClusterDrained("cluster-1")
+	assert.Nil(t, err)
+	assert.False(t, drained)
+}
+
+func TestAggregatedQueueServer_DrainCluster_RequiresPermission(t *testing.T) {
+	_, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+	aggregatedQueueClient.permissions = &FakeDenyAllPermissionChecker{}
+
+	_, err := aggregatedQueueClient.DrainCluster(context.TODO(), &api.ClusterDrainRequest{ClusterId: "cluster-1", Draining: true})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAggregatedQueueServer_DrainCluster_RequiresClusterId(t *testing.T) {
+	_, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+
+	_, err := aggregatedQueueClient.DrainCluster(context.TODO(), &api.ClusterDrainRequest{Draining: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobRepository, *fakeEventStore, *AggregatedQueueServer) {
 	mockJobRepository := newMockJobRepository()
 	fakeEventStore := &fakeEventStore{}
@@ -331,12 +396,32 @@ func (es *fakeEventStore) ReportEvents(message []*api.EventMessage) error {
 	return nil
 }
 
-type fakeSchedulingInfoRepository struct{}
+type fakeSchedulingInfoRepository struct {
+	updatedReports  []*api.ClusterSchedulingInfoReport
+	drainedClusters map[string]bool
+}
 
 func (repo *fakeSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
 	return map[string]*api.ClusterSchedulingInfoReport{}, nil
 }
 
 func (repo *fakeSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error {
+	repo.updatedReports = append(repo.updatedReports, report)
+	return nil
+}
+
+func (repo *fakeSchedulingInfoRepository) IsClusterDrained(clusterId string) (bool, error) {
+	return repo.drainedClusters[clusterId], nil
+}
+
+func (repo *fakeSchedulingInfoRepository) SetClusterDrained(clusterId string, drained bool) error {
+	if repo.drainedClusters == nil {
+		repo.drainedClusters = map[string]bool{}
+	}
+	if drained {
+		repo.drainedClusters[clusterId] = true
+	} else {
+		delete(repo.drainedClusters, clusterId)
+	}
 	return nil
 }
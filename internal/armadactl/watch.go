@@ -14,14 +14,32 @@ import (
 	"github.com/G-Research/armada/pkg/client/domain"
 )
 
+const (
+	WatchOutputSummary     = "summary"
+	WatchOutputRaw         = "raw"
+	WatchOutputCloudEvents = "cloudevents"
+)
+
 // Watch prints events associated with a particular job set.
-func (a *App) Watch(queue string, jobSetId string, raw bool, exit_on_inactive bool) error {
-	fmt.Fprintf(a.Out, "Watching job set %s\n", jobSetId)
+// The output format is one of WatchOutputSummary, WatchOutputRaw or WatchOutputCloudEvents.
+func (a *App) Watch(queue string, jobSetId string, output string, exit_on_inactive bool) error {
+	switch output {
+	case WatchOutputSummary, WatchOutputRaw, WatchOutputCloudEvents:
+	default:
+		return fmt.Errorf("invalid output format %q, expected one of %s, %s or %s", output, WatchOutputSummary, WatchOutputRaw, WatchOutputCloudEvents)
+	}
+
+	// CloudEvents are written one per line so the output can be piped to other tools
+	if output != WatchOutputCloudEvents {
+		fmt.Fprintf(a.Out, "Watching job set %s\n", jobSetId)
+	}
 
 	client.WithConnection(a.Params.ApiConnectionDetails, func(conn *grpc.ClientConn) {
 		eventsClient := api.NewEventClient(conn)
 		client.WatchJobSet(eventsClient, queue, jobSetId, true, true, context.Background(), func(state *domain.WatchContext, event api.Event) bool {
-			if raw {
+			if output == WatchOutputCloudEvents {
+				a.printCloudEvent(event)
+			} else if output == WatchOutputRaw {
 				data, err := json.Marshal(event)
 				if err != nil {
 					fmt.Fprintf(a.Out, "error parsing event %s: %s\n", event, err)
@@ -56,6 +74,25 @@ func (a *App) Watch(queue string, jobSetId string, raw bool, exit_on_inactive bo
 	return nil
 }
 
+func (a *App) printCloudEvent(e api.Event) {
+	message, err := api.Wrap(e)
+	if err != nil {
+		fmt.Fprintf(a.Out, "error wrapping event %s: %s\n", e, err)
+		return
+	}
+	cloudEvent, err := api.NewCloudEvent(message)
+	if err != nil {
+		fmt.Fprintf(a.Out, "error mapping event %s to cloud event: %s\n", e, err)
+		return
+	}
+	data, err := json.Marshal(cloudEvent)
+	if err != nil {
+		fmt.Fprintf(a.Out, "error parsing event %s: %s\n", e, err)
+		return
+	}
+	fmt.Fprintf(a.Out, "%s\n", string(data))
+}
+
 func (a *App) printSummary(state *domain.WatchContext, e api.Event) {
 	summary := fmt.Sprintf("%s | ", e.GetCreated().Format(time.Stamp))
 	summary += state.GetCurrentStateSummary()
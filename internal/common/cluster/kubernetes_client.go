@@ -24,6 +24,22 @@ func NewKubernetesClientProvider(impersonateUsers bool) (*ConfigKubernetesClient
 	if err != nil {
 		return nil, err
 	}
+	return newKubernetesClientProvider(config, impersonateUsers)
+}
+
+// NewKubernetesClientProviderForKubeconfig creates a client provider for the cluster of the context in the kubeconfig.
+// The current context of the kubeconfig is used if kubeContext is empty.
+func NewKubernetesClientProviderForKubeconfig(kubeconfig string, kubeContext string, impersonateUsers bool) (*ConfigKubernetesClientProvider, error) {
+	rules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	return newKubernetesClientProvider(config, impersonateUsers)
+}
+
+func newKubernetesClientProvider(config *rest.Config, impersonateUsers bool) (*ConfigKubernetesClientProvider, error) {
 
 	config.Burst = 10000
 	config.QPS = 10000
@@ -1,14 +1,26 @@
 package eventstream
 
-import "github.com/G-Research/armada/pkg/api"
+import (
+	"fmt"
+
+	"github.com/G-Research/armada/pkg/api"
+)
 
 type AckFn func() error
 
 type Message struct {
+	// Id identifies the message in the stream, it is the same every time the message is delivered.
+	// It is empty if the stream doesn't identify its messages.
+	Id           string
 	EventMessage *api.EventMessage
 	Ack          AckFn
 }
 
+// JetstreamMessageId returns the id of the message with the given sequence number in a JetStream stream.
+func JetstreamMessageId(stream string, sequence uint64) string {
+	return fmt.Sprintf("%s:%d", stream, sequence)
+}
+
 type EventStream interface {
 	Publish(events []*api.EventMessage) []error
 	Subscribe(queue string, callback func(event *Message) error) error
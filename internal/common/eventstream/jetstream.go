@@ -112,7 +112,12 @@ func (c *JetstreamEventStream) Subscribe(queue string, callback func(event *Mess
 		ackFn := func() error {
 			return msg.Ack()
 		}
+		id := ""
+		if metadata, err := msg.Metadata(); err == nil {
+			id = JetstreamMessageId(metadata.Stream, metadata.Sequence.Stream)
+		}
 		err = callback(&Message{
+			Id:           id,
 			EventMessage: event,
 			Ack:          ackFn,
 		})
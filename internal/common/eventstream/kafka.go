@@ -0,0 +1,250 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type KafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type KafkaReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// KafkaEventStream publishes events to a single Kafka topic.
+// Messages are keyed by queue and job set, so all events of a job set land on the same partition
+// and are consumed in order. Each call to Subscribe joins the consumer group named by the queue argument.
+type KafkaEventStream struct {
+	writer    KafkaWriter
+	newReader func(groupId string) KafkaReader
+	check     func() error
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	readers []KafkaReader
+
+	mutex sync.Mutex
+}
+
+func NewKafkaEventStream(config *configuration.KafkaConfig) (*KafkaEventStream, error) {
+	if len(config.Brokers) == 0 {
+		return nil, errors.New("no kafka brokers configured")
+	}
+	dialer := &kafka.Dialer{Timeout: config.ConnTimeout}
+
+	err := createKafkaTopic(dialer, config)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating topic %q: %v", config.Topic, err)
+	}
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(config.Brokers...),
+		Topic:        config.Topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchTimeout: config.BatchTimeout,
+	}
+	newReader := func(groupId string) KafkaReader {
+		return kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     config.Brokers,
+			Topic:       config.Topic,
+			GroupID:     groupId,
+			Dialer:      dialer,
+			StartOffset: kafka.LastOffset,
+		})
+	}
+	check := func() error {
+		return checkKafkaTopic(dialer, config)
+	}
+	return NewKafkaEventStreamWithClients(writer, newReader, check), nil
+}
+
+func NewKafkaEventStreamWithClients(writer KafkaWriter, newReader func(groupId string) KafkaReader, check func() error) *KafkaEventStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &KafkaEventStream{
+		writer:    writer,
+		newReader: newReader,
+		check:     check,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (stream *KafkaEventStream) Publish(events []*api.EventMessage) []error {
+	if len(events) == 0 {
+		return nil
+	}
+	var errs []error
+
+	messages := make([]kafka.Message, 0, len(events))
+	for _, m := range events {
+		event, err := api.UnwrapEvent(m)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		data, err := proto.Marshal(m)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error while marshalling event: %v", err))
+			continue
+		}
+		messages = append(messages, kafka.Message{
+			Key:   []byte(event.GetQueue() + "/" + event.GetJobSetId()),
+			Value: data,
+		})
+	}
+
+	err := stream.writer.WriteMessages(stream.ctx, messages...)
+	var writeErrs kafka.WriteErrors
+	if errors.As(err, &writeErrs) {
+		for _, writeErr := range writeErrs {
+			if writeErr != nil {
+				errs = append(errs, fmt.Errorf("error when publishing event to kafka: %v", writeErr))
+			}
+		}
+	} else if err != nil {
+		errs = append(errs, fmt.Errorf("error when publishing events to kafka: %v", err))
+	}
+	return errs
+}
+
+func (stream *KafkaEventStream) Subscribe(queue string, callback func(event *Message) error) error {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	if stream.ctx.Err() != nil {
+		return errors.New("kafka event stream is closed")
+	}
+
+	reader := stream.newReader(queue)
+	stream.readers = append(stream.readers, reader)
+
+	stream.wg.Add(1)
+	go func() {
+		defer stream.wg.Done()
+		stream.consume(reader, callback)
+	}()
+	return nil
+}
+
+func (stream *KafkaEventStream) consume(reader KafkaReader, callback func(event *Message) error) {
+	for {
+		msg, err := reader.FetchMessage(stream.ctx)
+		if stream.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Errorf("error when fetching message from kafka: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		event := &api.EventMessage{}
+		err = proto.Unmarshal(msg.Value, event)
+		if err != nil {
+			log.Errorf("failed to unmarsal event: %v", err)
+			err = reader.CommitMessages(stream.ctx, msg)
+			if err != nil {
+				log.Errorf("kafka error when acknowledging message: %v", err)
+			}
+			continue
+		}
+
+		message := msg
+		ackFn := func() error {
+			return reader.CommitMessages(context.Background(), message)
+		}
+		err = callback(&Message{
+			Id:           fmt.Sprintf("%s:%d:%d", msg.Topic, msg.Partition, msg.Offset),
+			EventMessage: event,
+			Ack:          ackFn,
+		})
+		if err != nil {
+			log.Errorf("kafka subscribe callback error: %v", err)
+		}
+	}
+}
+
+func (stream *KafkaEventStream) Close() error {
+	stream.mutex.Lock()
+	defer stream.mutex.Unlock()
+
+	stream.cancel()
+	stream.wg.Wait()
+
+	for _, reader := range stream.readers {
+		err := reader.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return stream.writer.Close()
+}
+
+func (stream *KafkaEventStream) Check() error {
+	return stream.check()
+}
+
+func createKafkaTopic(dialer *kafka.Dialer, config *configuration.KafkaConfig) error {
+	conn, err := dialer.Dial("tcp", config.Brokers[0])
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	controller, err := conn.Controller()
+	if err != nil {
+		return err
+	}
+	controllerConn, err := dialer.Dial("tcp", net.JoinHostPort(controller.Host, strconv.Itoa(controller.Port)))
+	if err != nil {
+		return err
+	}
+	defer controllerConn.Close()
+
+	return controllerConn.CreateTopics(kafka.TopicConfig{
+		Topic:             config.Topic,
+		NumPartitions:     config.NumPartitions,
+		ReplicationFactor: config.ReplicationFactor,
+	})
+}
+
+func checkKafkaTopic(dialer *kafka.Dialer, config *configuration.KafkaConfig) error {
+	var lastErr error
+	for _, broker := range config.Brokers {
+		conn, err := dialer.Dial("tcp", broker)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		partitions, err := conn.ReadPartitions(config.Topic)
+		conn.Close()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(partitions) == 0 {
+			return fmt.Errorf("kafka topic %q has no partitions", config.Topic)
+		}
+		return nil
+	}
+	return fmt.Errorf("not connected to kafka: %v", lastErr)
+}
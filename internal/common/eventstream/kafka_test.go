@@ -0,0 +1,271 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestKafkaEvents_DeliversToEachConsumerGroup(t *testing.T) {
+	broker := newFakeKafkaBroker(4)
+	stream := NewKafkaEventStreamWithClients(broker, broker.newReader, func() error { return nil })
+	defer stream.Close()
+
+	nEvents := 1000
+	storeWg := &sync.WaitGroup{}
+	storeWg.Add(nEvents)
+	jobStatusWg := &sync.WaitGroup{}
+	jobStatusWg.Add(nEvents)
+
+	err := stream.Subscribe("ArmadaEventRedisProcessor", func(msg *Message) error {
+		assert.NoError(t, msg.Ack())
+		storeWg.Done()
+		return nil
+	})
+	assert.NoError(t, err)
+	err = stream.Subscribe("ArmadaEventJobStatusProcessor", func(msg *Message) error {
+		assert.NoError(t, msg.Ack())
+		jobStatusWg.Done()
+		return nil
+	})
+	assert.NoError(t, err)
+
+	errs := stream.Publish(makeLeasedEvents(nEvents, 10))
+	assert.Len(t, errs, 0)
+
+	storeWg.Wait()
+	jobStatusWg.Wait()
+
+	assert.Equal(t, int64(nEvents), broker.committedCount("ArmadaEventRedisProcessor"))
+	assert.Equal(t, int64(nEvents), broker.committedCount("ArmadaEventJobStatusProcessor"))
+}
+
+func TestKafkaEvents_PreservesOrderWithinJobSet(t *testing.T) {
+	broker := newFakeKafkaBroker(8)
+	stream := NewKafkaEventStreamWithClients(broker, broker.newReader, func() error { return nil })
+	defer stream.Close()
+
+	nEvents := 500
+	events := makeLeasedEvents(nEvents, 7)
+
+	mutex := sync.Mutex{}
+	received := map[string][]string{}
+	wg := &sync.WaitGroup{}
+	wg.Add(nEvents)
+	err := stream.Subscribe("test-queue", func(msg *Message) error {
+		leased := msg.EventMessage.GetLeased()
+		mutex.Lock()
+		received[leased.JobSetId] = append(received[leased.JobSetId], leased.JobId)
+		mutex.Unlock()
+		wg.Done()
+		return msg.Ack()
+	})
+	assert.NoError(t, err)
+
+	errs := stream.Publish(events)
+	assert.Len(t, errs, 0)
+	wg.Wait()
+
+	expected := map[string][]string{}
+	for _, e := range events {
+		leased := e.GetLeased()
+		expected[leased.JobSetId] = append(expected[leased.JobSetId], leased.JobId)
+	}
+	assert.Equal(t, expected, received)
+}
+
+func TestKafkaEvents_PublishReportsWriteErrors(t *testing.T) {
+	broker := newFakeKafkaBroker(1)
+	broker.writeErr = errors.New("broker unavailable")
+	stream := NewKafkaEventStreamWithClients(broker, broker.newReader, func() error { return nil })
+	defer stream.Close()
+
+	errs := stream.Publish(makeLeasedEvents(3, 1))
+	assert.Len(t, errs, 3)
+}
+
+// Runs against a real broker, e.g. the single broker container from docs/dev/docker-compose.yaml:
+// KAFKA_BROKERS=localhost:9092 go test ./internal/common/eventstream/...
+func TestKafkaEvents_LocalBroker(t *testing.T) {
+	brokers := os.Getenv("KAFKA_BROKERS")
+	if brokers == "" {
+		t.Skip("KAFKA_BROKERS not set")
+	}
+
+	stream, err := NewKafkaEventStream(&configuration.KafkaConfig{
+		Brokers:           strings.Split(brokers, ","),
+		Topic:             "armada-test-" + util.NewULID(),
+		NumPartitions:     3,
+		ReplicationFactor: 1,
+		ConnTimeout:       10 * time.Second,
+		BatchTimeout:      10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	defer stream.Close()
+	assert.NoError(t, stream.Check())
+
+	nEvents := 100
+	wg := &sync.WaitGroup{}
+	wg.Add(nEvents)
+	err = stream.Subscribe("test-queue", func(msg *Message) error {
+		wg.Done()
+		return msg.Ack()
+	})
+	assert.NoError(t, err)
+
+	// Consumer group join is asynchronous and the reader starts at the latest offset
+	time.Sleep(5 * time.Second)
+
+	errs := stream.Publish(makeLeasedEvents(nEvents, 5))
+	assert.Len(t, errs, 0)
+	wg.Wait()
+}
+
+func makeLeasedEvents(nEvents int, nJobSets int) []*api.EventMessage {
+	events := make([]*api.EventMessage, nEvents, nEvents)
+	for i := 0; i < nEvents; i++ {
+		events[i] = &api.EventMessage{
+			Events: &api.EventMessage_Leased{
+				Leased: &api.JobLeasedEvent{
+					JobId:    util.NewULID(),
+					JobSetId: fmt.Sprintf("jobset-%d", i%nJobSets),
+					Queue:    "test",
+				},
+			},
+		}
+	}
+	return events
+}
+
+// fakeKafkaBroker is an in-process stand-in for a topic with a fixed number of partitions.
+// Messages are assigned to partitions with the same hash balancer the real writer uses,
+// and each consumer group tracks its own committed offsets.
+type fakeKafkaBroker struct {
+	mutex      sync.Mutex
+	partitions [][]kafka.Message
+	committed  map[string]map[int]int64
+	writeErr   error
+	balancer   kafka.Balancer
+}
+
+func newFakeKafkaBroker(nPartitions int) *fakeKafkaBroker {
+	return &fakeKafkaBroker{
+		partitions: make([][]kafka.Message, nPartitions),
+		committed:  map[string]map[int]int64{},
+		balancer:   &kafka.Hash{},
+	}
+}
+
+func (b *fakeKafkaBroker) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if b.writeErr != nil {
+		errs := make(kafka.WriteErrors, len(msgs))
+		for i := range msgs {
+			errs[i] = b.writeErr
+		}
+		return errs
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	ids := make([]int, len(b.partitions))
+	for i := range ids {
+		ids[i] = i
+	}
+	for _, msg := range msgs {
+		p := b.balancer.Balance(msg, ids...)
+		msg.Partition = p
+		msg.Offset = int64(len(b.partitions[p]))
+		b.partitions[p] = append(b.partitions[p], msg)
+	}
+	return nil
+}
+
+func (b *fakeKafkaBroker) Close() error {
+	return nil
+}
+
+func (b *fakeKafkaBroker) newReader(groupId string) KafkaReader {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if _, ok := b.committed[groupId]; !ok {
+		b.committed[groupId] = map[int]int64{}
+	}
+	return &fakeKafkaReader{broker: b, groupId: groupId, fetched: map[int]int64{}}
+}
+
+func (b *fakeKafkaBroker) committedCount(groupId string) int64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	total := int64(0)
+	for _, offset := range b.committed[groupId] {
+		total += offset
+	}
+	return total
+}
+
+type fakeKafkaReader struct {
+	broker  *fakeKafkaBroker
+	groupId string
+	fetched map[int]int64
+	next    int
+}
+
+func (r *fakeKafkaReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	for {
+		if msg, ok := r.tryFetch(); ok {
+			return msg, nil
+		}
+		select {
+		case <-ctx.Done():
+			return kafka.Message{}, ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func (r *fakeKafkaReader) tryFetch() (kafka.Message, bool) {
+	r.broker.mutex.Lock()
+	defer r.broker.mutex.Unlock()
+
+	n := len(r.broker.partitions)
+	for i := 0; i < n; i++ {
+		p := (r.next + i) % n
+		offset, ok := r.fetched[p]
+		if !ok {
+			offset = r.broker.committed[r.groupId][p]
+		}
+		if offset < int64(len(r.broker.partitions[p])) {
+			r.fetched[p] = offset + 1
+			r.next = p + 1
+			return r.broker.partitions[p][offset], true
+		}
+	}
+	return kafka.Message{}, false
+}
+
+func (r *fakeKafkaReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	r.broker.mutex.Lock()
+	defer r.broker.mutex.Unlock()
+	for _, msg := range msgs {
+		if msg.Offset+1 > r.broker.committed[r.groupId][msg.Partition] {
+			r.broker.committed[r.groupId][msg.Partition] = msg.Offset + 1
+		}
+	}
+	return nil
+}
+
+func (r *fakeKafkaReader) Close() error {
+	return nil
+}
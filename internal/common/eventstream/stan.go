@@ -123,6 +123,7 @@ func (stream *StanEventStream) Subscribe(queue string, callback func(event *Mess
 				return msg.Ack()
 			}
 			err = callback(&Message{
+				Id:           fmt.Sprintf("%s:%d", msg.Subject, msg.Sequence),
 				EventMessage: event,
 				Ack:          ackFn,
 			})
@@ -14,6 +14,7 @@ import (
 
 	protoutil "github.com/G-Research/armada/internal/common/grpc/protoutils"
 	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 // CreateGatewayHandler configures the gRPC API gateway
@@ -33,6 +34,7 @@ func CreateGatewayHandler(
 	m := new(protoutil.JSONMarshaller)
 	gw := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, m),
+		runtime.WithMarshalerOption(api.CloudEventsContentType, &protoutil.CloudEventsMarshaller{}),
 		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
 			if key == strings.ToLower(spnego.HTTPHeaderAuthResponse) {
 				return spnego.HTTPHeaderAuthResponse, true
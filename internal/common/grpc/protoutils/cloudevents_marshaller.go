@@ -0,0 +1,31 @@
+package protoutil
+
+import (
+	"encoding/json"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// CloudEventsMarshaller writes streamed events as newline delimited CloudEvents in structured JSON mode,
+// any other response is marshalled as plain JSON.
+// The gateway wraps every streamed message as {"result": message}, which is unwrapped here.
+type CloudEventsMarshaller struct {
+	JSONMarshaller
+}
+
+func (*CloudEventsMarshaller) ContentType() string {
+	return api.CloudEventsContentType
+}
+
+func (m *CloudEventsMarshaller) Marshal(v interface{}) ([]byte, error) {
+	if chunk, ok := v.(map[string]interface{}); ok {
+		if message, ok := chunk["result"].(*api.EventStreamMessage); ok && message.Message != nil {
+			cloudEvent, err := api.NewCloudEvent(message.Message)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(cloudEvent)
+		}
+	}
+	return m.JSONMarshaller.Marshal(v)
+}
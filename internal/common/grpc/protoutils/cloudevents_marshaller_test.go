@@ -0,0 +1,62 @@
+package protoutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestCloudEventsMarshaller_ContentType(t *testing.T) {
+	marshaller := &CloudEventsMarshaller{}
+	assert.Equal(t, "application/cloudevents+json", marshaller.ContentType())
+}
+
+func TestCloudEventsMarshaller_MarshalsStreamedEventAsCloudEvent(t *testing.T) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	message := &api.EventMessage{
+		Events: &api.EventMessage_Failed{
+			Failed: &api.JobFailedEvent{
+				JobId:     "job-1",
+				JobSetId:  "job-set",
+				Queue:     "queue",
+				Created:   created,
+				ClusterId: "cluster",
+				Reason:    "OOMKilled",
+			},
+		},
+	}
+	expected, err := api.NewCloudEvent(message)
+	assert.NoError(t, err)
+
+	marshaller := &CloudEventsMarshaller{}
+	data, err := marshaller.Marshal(map[string]interface{}{"result": &api.EventStreamMessage{Id: "1-0", Message: message}})
+	assert.NoError(t, err)
+
+	cloudEvent := &api.CloudEvent{}
+	assert.NoError(t, marshaller.Unmarshal(data, cloudEvent))
+	assert.Equal(t, "1.0", cloudEvent.SpecVersion)
+	assert.Equal(t, expected.Id, cloudEvent.Id)
+	assert.Equal(t, "io.armada.job.failed", cloudEvent.Type)
+	assert.Equal(t, "/clusters/cluster/queues/queue", cloudEvent.Source)
+	assert.Equal(t, "job-1", cloudEvent.Subject)
+	assert.True(t, created.Equal(cloudEvent.Time))
+	assert.Equal(t, "application/json", cloudEvent.DataContentType)
+	assert.Equal(t, "queue", cloudEvent.Queue)
+	assert.Equal(t, "job-set", cloudEvent.JobSetId)
+
+	eventData, ok := cloudEvent.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "job-1", eventData["jobId"])
+	assert.Equal(t, "OOMKilled", eventData["reason"])
+}
+
+func TestCloudEventsMarshaller_MarshalsOtherValuesAsJson(t *testing.T) {
+	marshaller := &CloudEventsMarshaller{}
+
+	data, err := marshaller.Marshal(map[string]interface{}{"error": "failed"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"error": "failed"}`, string(data))
+}
@@ -3,9 +3,11 @@ package common
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -128,12 +130,17 @@ func ServeMetricsFor(port uint16, gatherer prometheus.Gatherer) (shutdown func()
 
 // ServeHttp starts a HTTP server listening on the given port.
 func ServeHttp(port uint16, mux http.Handler) (shutdown func()) {
+	return ServeHttpOn("", port, mux)
+}
+
+// ServeHttpOn serves mux on the given port of the given host, or of all interfaces if the host is empty.
+func ServeHttpOn(host string, port uint16, mux http.Handler) (shutdown func()) {
 	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
+		Addr:    net.JoinHostPort(host, strconv.Itoa(int(port))),
 		Handler: mux}
 
 	go func() {
-		log.Printf("Starting http server listening on %d", port)
+		log.Printf("Starting http server listening on %s", srv.Addr)
 		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
 			panic(err) // TODO Don't panic, return an error
 		}
@@ -143,7 +150,7 @@ func ServeHttp(port uint16, mux http.Handler) (shutdown func()) {
 	return func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
-		log.Printf("Stopping http server listening on %d", port)
+		log.Printf("Stopping http server listening on %s", srv.Addr)
 		e := srv.Shutdown(ctx)
 		if e != nil {
 			panic(e)
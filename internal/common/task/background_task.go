@@ -24,15 +24,23 @@ type task struct {
 type BackgroundTaskManager struct {
 	tasks         []*task
 	metricsPrefix string
+	registerer    prometheus.Registerer
 	wg            *sync.WaitGroup
 }
 
 // NewBackgroundTaskManager returns a new BackgroundTaskManager with no registered tasks.
 // Call Register to add and start tasks.
 func NewBackgroundTaskManager(metricsPrefix string) *BackgroundTaskManager {
+	return NewBackgroundTaskManagerWithRegisterer(metricsPrefix, prometheus.DefaultRegisterer)
+}
+
+// NewBackgroundTaskManagerWithRegisterer returns a new BackgroundTaskManager which registers the task
+// metrics with registerer, so several task managers can run the same tasks with different metric labels.
+func NewBackgroundTaskManagerWithRegisterer(metricsPrefix string, registerer prometheus.Registerer) *BackgroundTaskManager {
 	return &BackgroundTaskManager{
 		tasks:         []*task{},
 		metricsPrefix: metricsPrefix,
+		registerer:    registerer,
 		wg:            &sync.WaitGroup{},
 	}
 }
@@ -60,7 +68,7 @@ func (m *BackgroundTaskManager) StopAll(timeout time.Duration) bool {
 }
 
 func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
-	var taskDurationHistogram = promauto.NewHistogram(
+	var taskDurationHistogram = promauto.With(m.registerer).NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    m.metricsPrefix + task.metricName + "_latency_seconds",
 			Help:    "Background loop " + task.metricName + " latency in seconds",
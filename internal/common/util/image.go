@@ -0,0 +1,41 @@
+package util
+
+import "strings"
+
+const (
+	defaultImageDomain     = "docker.io"
+	legacyImageDomain      = "index.docker.io"
+	defaultImageRepository = "library"
+	defaultImageTag        = "latest"
+)
+
+// NormalizeImageName expands an image reference to the form the container runtime reports it in, so the same image
+// referenced as "ubuntu", "ubuntu:latest" and "docker.io/library/ubuntu:latest" gets the same name.
+func NormalizeImageName(image string) string {
+	name := image
+	if name == "" {
+		return name
+	}
+
+	components := strings.SplitN(name, "/", 2)
+	if components[0] == legacyImageDomain && len(components) == 2 {
+		name = defaultImageDomain + "/" + components[1]
+		components[0] = defaultImageDomain
+	}
+	if len(components) == 1 || !isImageDomain(components[0]) {
+		if len(components) == 1 {
+			name = defaultImageRepository + "/" + name
+		}
+		name = defaultImageDomain + "/" + name
+	}
+
+	lastComponent := name[strings.LastIndex(name, "/")+1:]
+	if !strings.Contains(lastComponent, ":") && !strings.Contains(lastComponent, "@") {
+		name = name + ":" + defaultImageTag
+	}
+	return name
+}
+
+func isImageDomain(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost"
+}
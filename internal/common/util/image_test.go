@@ -0,0 +1,25 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeImageName(t *testing.T) {
+	expected := map[string]string{
+		"":                                   "",
+		"ubuntu":                             "docker.io/library/ubuntu:latest",
+		"ubuntu:20.04":                       "docker.io/library/ubuntu:20.04",
+		"ubuntu@sha256:abc":                  "docker.io/library/ubuntu@sha256:abc",
+		"org/image":                          "docker.io/org/image:latest",
+		"docker.io/library/ubuntu:20.04":     "docker.io/library/ubuntu:20.04",
+		"index.docker.io/library/ubuntu":     "docker.io/library/ubuntu:latest",
+		"registry.example.com/org/image:1.0": "registry.example.com/org/image:1.0",
+		"localhost/image":                    "localhost/image:latest",
+		"localhost:5000/image":               "localhost:5000/image:latest",
+	}
+	for image, normalized := range expected {
+		assert.Equal(t, normalized, NormalizeImageName(image), image)
+	}
+}
@@ -0,0 +1,187 @@
+package admission
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/common/util"
+	config "github.com/G-Research/armada/internal/executor/configuration/admission"
+)
+
+const defaultNamespace = "default"
+
+// PolicyViolationError is returned for pods violating the admission policy.
+// Jobs with such pods can't run on this cluster, so there is no point in retrying them.
+type PolicyViolationError struct {
+	Violations []string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("pod violates the admission policy of the executor: %s", strings.Join(e.Violations, "; "))
+}
+
+func IsPolicyViolation(err error) bool {
+	_, ok := err.(*PolicyViolationError)
+	return ok
+}
+
+// Policy checks pods against the configured admission policy before they are submitted.
+type Policy struct {
+	config              config.Policy
+	allowedNamespaces   map[string]bool
+	allowedVolumeTypes  map[string]bool
+	allowedRegistries   map[string]bool
+	allowedCapabilities map[string]bool
+}
+
+func NewPolicy(policyConfig config.Policy) *Policy {
+	return &Policy{
+		config:              policyConfig,
+		allowedNamespaces:   util.StringListToSet(policyConfig.AllowedNamespaces),
+		allowedVolumeTypes:  util.StringListToSet(policyConfig.AllowedVolumeTypes),
+		allowedRegistries:   util.StringListToSet(policyConfig.AllowedImageRegistries),
+		allowedCapabilities: util.StringListToSet(policyConfig.SecurityContext.AllowedCapabilities),
+	}
+}
+
+// Check returns a PolicyViolationError listing all the ways the pod violates the policy, or nil if it doesn't.
+func (p *Policy) Check(pod *v1.Pod) error {
+	violations := []string{}
+	violations = append(violations, p.checkNamespace(pod)...)
+	violations = append(violations, p.checkVolumes(pod)...)
+	violations = append(violations, p.checkHostNamespaces(pod)...)
+
+	containers := append(append([]v1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		violations = append(violations, p.checkImage(container)...)
+		violations = append(violations, p.checkSecurityContext(pod, container)...)
+	}
+
+	if len(violations) > 0 {
+		return &PolicyViolationError{Violations: violations}
+	}
+	return nil
+}
+
+func (p *Policy) checkNamespace(pod *v1.Pod) []string {
+	namespace := pod.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	if len(p.allowedNamespaces) > 0 && !p.allowedNamespaces[namespace] {
+		return []string{fmt.Sprintf("namespace %s is not allowed", namespace)}
+	}
+	return nil
+}
+
+func (p *Policy) checkVolumes(pod *v1.Pod) []string {
+	violations := []string{}
+	for _, volume := range pod.Spec.Volumes {
+		volumeType := getVolumeType(volume)
+		if len(p.allowedVolumeTypes) > 0 && !p.allowedVolumeTypes[volumeType] {
+			violations = append(violations, fmt.Sprintf("volume %s of type %s is not allowed", volume.Name, volumeType))
+			continue
+		}
+		if volume.HostPath != nil && len(p.config.AllowedHostPaths) > 0 && !isUnderAnyPath(volume.HostPath.Path, p.config.AllowedHostPaths) {
+			violations = append(violations, fmt.Sprintf("host path %s of volume %s is not allowed", volume.HostPath.Path, volume.Name))
+		}
+	}
+	return violations
+}
+
+func (p *Policy) checkHostNamespaces(pod *v1.Pod) []string {
+	if !p.config.SecurityContext.ForbidHostNamespaces {
+		return nil
+	}
+	violations := []string{}
+	if pod.Spec.HostNetwork {
+		violations = append(violations, "host network is not allowed")
+	}
+	if pod.Spec.HostPID {
+		violations = append(violations, "host PID namespace is not allowed")
+	}
+	if pod.Spec.HostIPC {
+		violations = append(violations, "host IPC namespace is not allowed")
+	}
+	return violations
+}
+
+func (p *Policy) checkImage(container v1.Container) []string {
+	if len(p.allowedRegistries) == 0 {
+		return nil
+	}
+	image := util.NormalizeImageName(container.Image)
+	registry := strings.SplitN(image, "/", 2)[0]
+	if !p.allowedRegistries[registry] {
+		return []string{fmt.Sprintf("image %s of container %s is not from an allowed registry", container.Image, container.Name)}
+	}
+	return nil
+}
+
+func (p *Policy) checkSecurityContext(pod *v1.Pod, container v1.Container) []string {
+	policy := p.config.SecurityContext
+	securityContext := container.SecurityContext
+	if securityContext == nil {
+		securityContext = &v1.SecurityContext{}
+	}
+	violations := []string{}
+
+	if policy.ForbidPrivileged && securityContext.Privileged != nil && *securityContext.Privileged {
+		violations = append(violations, fmt.Sprintf("container %s is privileged", container.Name))
+	}
+	if policy.ForbidPrivilegeEscalation && (securityContext.AllowPrivilegeEscalation == nil || *securityContext.AllowPrivilegeEscalation) {
+		violations = append(violations, fmt.Sprintf("container %s allows privilege escalation", container.Name))
+	}
+	if policy.RequireRunAsNonRoot && !runsAsNonRoot(pod.Spec.SecurityContext, securityContext) {
+		violations = append(violations, fmt.Sprintf("container %s may run as root", container.Name))
+	}
+	if len(p.allowedCapabilities) > 0 && securityContext.Capabilities != nil {
+		for _, capability := range securityContext.Capabilities.Add {
+			if !p.allowedCapabilities[string(capability)] {
+				violations = append(violations, fmt.Sprintf("capability %s of container %s is not allowed", capability, container.Name))
+			}
+		}
+	}
+	return violations
+}
+
+// runsAsNonRoot follows kubernetes in letting the container security context override the pod security context.
+func runsAsNonRoot(podSecurityContext *v1.PodSecurityContext, securityContext *v1.SecurityContext) bool {
+	runAsNonRoot := securityContext.RunAsNonRoot
+	runAsUser := securityContext.RunAsUser
+	if podSecurityContext != nil {
+		if runAsNonRoot == nil {
+			runAsNonRoot = podSecurityContext.RunAsNonRoot
+		}
+		if runAsUser == nil {
+			runAsUser = podSecurityContext.RunAsUser
+		}
+	}
+	return (runAsNonRoot != nil && *runAsNonRoot) || (runAsUser != nil && *runAsUser != 0)
+}
+
+// getVolumeType returns the name of the volume source the volume uses, as it appears in the pod spec.
+func getVolumeType(volume v1.Volume) string {
+	source := reflect.ValueOf(volume.VolumeSource)
+	for i := 0; i < source.NumField(); i++ {
+		if !source.Field(i).IsNil() {
+			return strings.Split(source.Type().Field(i).Tag.Get("json"), ",")[0]
+		}
+	}
+	return "unknown"
+}
+
+func isUnderAnyPath(hostPath string, allowedPaths []string) bool {
+	hostPath = path.Clean(hostPath)
+	for _, allowedPath := range allowedPaths {
+		allowedPath = path.Clean(allowedPath)
+		if hostPath == allowedPath || strings.HasPrefix(hostPath, strings.TrimSuffix(allowedPath, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package admission
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/G-Research/armada/internal/executor/configuration/admission"
+)
+
+func TestPolicy_EmptyPolicyAllowsEverything(t *testing.T) {
+	pod := makePod("any")
+	privileged := true
+	pod.Spec.HostNetwork = true
+	pod.Spec.Volumes = []v1.Volume{{Name: "host", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/"}}}}
+	pod.Spec.Containers[0].SecurityContext = &v1.SecurityContext{Privileged: &privileged}
+
+	assert.NoError(t, NewPolicy(config.Policy{}).Check(pod))
+}
+
+func TestPolicy_Namespaces(t *testing.T) {
+	policy := NewPolicy(config.Policy{AllowedNamespaces: []string{"default", "batch"}})
+
+	assert.NoError(t, policy.Check(makePod("batch")))
+	assert.NoError(t, policy.Check(makePod("")))
+	assertViolations(t, policy.Check(makePod("kube-system")), "namespace kube-system is not allowed")
+}
+
+func TestPolicy_Volumes(t *testing.T) {
+	policy := NewPolicy(config.Policy{
+		AllowedVolumeTypes: []string{"emptyDir", "hostPath"},
+		AllowedHostPaths:   []string{"/data/"},
+	})
+	pod := makePod("default")
+	pod.Spec.Volumes = []v1.Volume{
+		{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+		{Name: "data", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/data/shared"}}},
+		{Name: "database", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/database"}}},
+		{Name: "secret", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "secret"}}},
+	}
+
+	assertViolations(t, policy.Check(pod),
+		"host path /database of volume database is not allowed",
+		"volume secret of type secret is not allowed")
+}
+
+func TestPolicy_ImageRegistries(t *testing.T) {
+	policy := NewPolicy(config.Policy{AllowedImageRegistries: []string{"docker.io", "registry.example.com:5000"}})
+	pod := makePod("default")
+	pod.Spec.InitContainers = []v1.Container{{Name: "init", Image: "registry.example.com:5000/init:1.0"}}
+	pod.Spec.Containers = []v1.Container{
+		{Name: "main", Image: "ubuntu"},
+		{Name: "sidecar", Image: "quay.io/org/sidecar"},
+	}
+
+	assertViolations(t, policy.Check(pod), "image quay.io/org/sidecar of container sidecar is not from an allowed registry")
+}
+
+func TestPolicy_SecurityContext(t *testing.T) {
+	policy := NewPolicy(config.Policy{SecurityContext: config.SecurityContextPolicy{
+		ForbidPrivileged:          true,
+		ForbidPrivilegeEscalation: true,
+		ForbidHostNamespaces:      true,
+		RequireRunAsNonRoot:       true,
+		AllowedCapabilities:       []string{"NET_BIND_SERVICE"},
+	}})
+
+	yes := true
+	no := false
+	user := int64(1000)
+	compliant := makePod("default")
+	compliant.Spec.SecurityContext = &v1.PodSecurityContext{RunAsUser: &user}
+	compliant.Spec.Containers[0].SecurityContext = &v1.SecurityContext{
+		AllowPrivilegeEscalation: &no,
+		Capabilities:             &v1.Capabilities{Add: []v1.Capability{"NET_BIND_SERVICE"}},
+	}
+	assert.NoError(t, policy.Check(compliant))
+
+	violating := makePod("default")
+	violating.Spec.HostPID = true
+	violating.Spec.Containers[0].SecurityContext = &v1.SecurityContext{
+		Privileged:   &yes,
+		Capabilities: &v1.Capabilities{Add: []v1.Capability{"SYS_ADMIN"}},
+	}
+	assertViolations(t, policy.Check(violating),
+		"host PID namespace is not allowed",
+		"container main is privileged",
+		"container main allows privilege escalation",
+		"container main may run as root",
+		"capability SYS_ADMIN of container main is not allowed")
+}
+
+func assertViolations(t *testing.T, err error, expected ...string) {
+	if assert.True(t, IsPolicyViolation(err), "expected policy violation, got %v", err) {
+		assert.Equal(t, expected, err.(*PolicyViolationError).Violations)
+	}
+}
+
+func makePod(namespace string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: namespace},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "main", Image: "ubuntu"}},
+		},
+	}
+}
@@ -2,25 +2,31 @@ package executor
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
 	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/G-Research/armada/internal/common"
 	"github.com/G-Research/armada/internal/common/cluster"
 	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/executor/admission"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/drain"
 	"github.com/G-Research/armada/internal/executor/job"
 	"github.com/G-Research/armada/internal/executor/metrics"
 	"github.com/G-Research/armada/internal/executor/metrics/pod_metrics"
 	"github.com/G-Research/armada/internal/executor/node"
 	"github.com/G-Research/armada/internal/executor/podchecks"
+	"github.com/G-Research/armada/internal/executor/podmutation"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	"github.com/G-Research/armada/internal/executor/service"
 	"github.com/G-Research/armada/internal/executor/utilisation"
@@ -28,6 +34,13 @@ import (
 	"github.com/G-Research/armada/pkg/client"
 )
 
+const (
+	clusterLabel = "cluster"
+
+	clusterStartUpShutdownTimeout = 10 * time.Second
+	clusterStartUpRetryInterval   = 30 * time.Second
+)
+
 func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGroup) {
 
 	err := validateConfig(config)
@@ -36,6 +49,19 @@ func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGrou
 		os.Exit(-1)
 	}
 
+	drainHandler := drain.NewHttpHandler(config.Drain.Deadline)
+	shutdownDrainEndpoint := startDrainEndpoint(config.Drain, drainHandler)
+
+	if len(config.Clusters) > 0 {
+		shutdown, wg := startUpClusters(config.Clusters, func(clusterConfig configuration.ClusterConfiguration) (func(), error) {
+			return startUpCluster(config, clusterConfig, drainHandler)
+		}, clusterStartUpRetryInterval)
+		return func() {
+			shutdownDrainEndpoint()
+			shutdown()
+		}, wg
+	}
+
 	kubernetesClientProvider, err := cluster.NewKubernetesClientProvider(config.Kubernetes.ImpersonateUsers)
 
 	if err != nil {
@@ -43,26 +69,236 @@ func StartUp(config configuration.ExecutorConfiguration) (func(), *sync.WaitGrou
 		os.Exit(-1)
 	}
 
-	clusterContext := context.NewClusterContext(
-		config.Application,
-		2*time.Minute,
-		kubernetesClientProvider)
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	clusterContext, taskManager := createClusterContext(config, kubernetesClientProvider, prometheus.DefaultRegisterer)
+
+	stop, err := startServices(config, clusterContext, taskManager, prometheus.DefaultRegisterer, drainHandler)
+	if err != nil {
+		log.Error(err)
+		os.Exit(-1)
+	}
+	return func() {
+		shutdownDrainEndpoint()
+		stop()
+		wg.Done()
+	}, wg
+}
+
+// startDrainEndpoint serves the unauthenticated drain endpoint on the configured host, which is localhost by default.
+func startDrainEndpoint(config configuration.DrainConfiguration, drainHandler *drain.HttpHandler) (shutdown func()) {
+	if config.AdminPort == 0 {
+		return func() {}
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/drain", drainHandler)
+	return common.ServeHttpOn(config.AdminHost, config.AdminPort, mux)
+}
+
+// startUpClusters starts an isolated set of services for each of the configured clusters.
+// The clusters are started concurrently, so a cluster with an unreachable API server does not hold up the others,
+// and a cluster which fails to start is retried in the background without affecting the others.
+// The config is validated before, so starting a cluster only fails on errors connecting to it or to the API.
+func startUpClusters(
+	clusters []configuration.ClusterConfiguration,
+	startUp func(clusterConfig configuration.ClusterConfiguration) (func(), error),
+	retryInterval time.Duration) (func(), *sync.WaitGroup) {
 
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 
-	taskManager := task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix)
+	stop := make(chan struct{})
+	starting := &sync.WaitGroup{}
+	mutex := sync.Mutex{}
+	stopped := false
+	var shutdowns []func()
+
+	for _, clusterConfig := range clusters {
+		starting.Add(1)
+		go func(clusterConfig configuration.ClusterConfiguration) {
+			defer starting.Done()
+			for {
+				shutdown, err := startUp(clusterConfig)
+				if err == nil {
+					mutex.Lock()
+					defer mutex.Unlock()
+					if stopped {
+						shutdown()
+					} else {
+						shutdowns = append(shutdowns, shutdown)
+					}
+					return
+				}
+				log.Errorf("Failed to start executor for cluster %s, retrying in %s: %s", clusterConfig.ClusterId, retryInterval, err)
+				select {
+				case <-stop:
+					return
+				case <-time.After(retryInterval):
+				}
+			}
+		}(clusterConfig)
+	}
+
+	return func() {
+		mutex.Lock()
+		stopped = true
+		close(stop)
+		started := shutdowns
+		mutex.Unlock()
+
+		for _, shutdown := range started {
+			shutdown()
+		}
+		if waitTimeout(starting, clusterStartUpShutdownTimeout) {
+			log.Warnf("%d clusters are still starting up, shutting down without waiting for them", len(clusters)-len(started))
+		}
+		wg.Done()
+	}, wg
+}
+
+// waitTimeout waits for wg and returns true if it timed out.
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return false
+	case <-time.After(timeout):
+		return true
+	}
+}
+
+func startUpCluster(
+	config configuration.ExecutorConfiguration,
+	clusterConfig configuration.ClusterConfiguration,
+	drainHandler *drain.HttpHandler) (func(), error) {
+
+	config.Application.ClusterId = clusterConfig.ClusterId
+	if clusterConfig.Pool != "" {
+		config.Application.Pool = clusterConfig.Pool
+	}
+	log.Infof("Starting executor for cluster %s", clusterConfig.ClusterId)
+
+	kubernetesClientProvider, err := cluster.NewKubernetesClientProviderForKubeconfig(
+		clusterConfig.Kubeconfig,
+		clusterConfig.Context,
+		config.Kubernetes.ImpersonateUsers)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to kubernetes cluster %s because %s", clusterConfig.ClusterId, err)
+	}
+
+	// Each cluster has its own instances of the executor metrics, distinguished by the cluster label.
+	// The metrics are unregistered again if starting up fails, so the next attempt can register them.
+	registerer := &unregisteringRegisterer{
+		Registerer: prometheus.WrapRegistererWith(prometheus.Labels{clusterLabel: clusterConfig.ClusterId}, prometheus.DefaultRegisterer),
+	}
+	clusterContext, taskManager := createClusterContext(config, kubernetesClientProvider, registerer)
+
+	stop, err := startServices(config, clusterContext, taskManager, registerer, drainHandler)
+	if err != nil {
+		clusterContext.Stop()
+		taskManager.StopAll(time.Second)
+		registerer.unregisterAll()
+		return nil, err
+	}
+	log.Infof("Started executor for cluster %s", clusterConfig.ClusterId)
+	return stop, nil
+}
+
+// unregisteringRegisterer records the collectors registered with it, so they can all be unregistered again.
+type unregisteringRegisterer struct {
+	prometheus.Registerer
+	collectors []prometheus.Collector
+}
+
+func (r *unregisteringRegisterer) Register(collector prometheus.Collector) error {
+	err := r.Registerer.Register(collector)
+	if err == nil {
+		r.collectors = append(r.collectors, collector)
+	}
+	return err
+}
+
+func (r *unregisteringRegisterer) MustRegister(collectors ...prometheus.Collector) {
+	for _, collector := range collectors {
+		if err := r.Register(collector); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (r *unregisteringRegisterer) unregisterAll() {
+	for _, collector := range r.collectors {
+		r.Registerer.Unregister(collector)
+	}
+	r.collectors = nil
+}
+
+func createClusterContext(
+	config configuration.ExecutorConfiguration,
+	kubernetesClientProvider cluster.KubernetesClientProvider,
+	registerer prometheus.Registerer) (*context.KubernetesClusterContext, *task.BackgroundTaskManager) {
+
+	clusterContext := context.NewClusterContext(
+		config.Application,
+		2*time.Minute,
+		kubernetesClientProvider,
+		registerer)
+
+	taskManager := task.NewBackgroundTaskManagerWithRegisterer(metrics.ArmadaExecutorMetricsPrefix, registerer)
 	taskManager.Register(clusterContext.ProcessPodsToDelete, config.Task.PodDeletionInterval, "pod_deletion")
 
-	return StartUpWithContext(config, clusterContext, taskManager, wg)
+	return clusterContext, taskManager
 }
 
 func StartUpWithContext(config configuration.ExecutorConfiguration, clusterContext context.ClusterContext, taskManager *task.BackgroundTaskManager, wg *sync.WaitGroup) (func(), *sync.WaitGroup) {
+	stop, err := startServices(config, clusterContext, taskManager, prometheus.DefaultRegisterer, nil)
+	if err != nil {
+		log.Error(err)
+		os.Exit(-1)
+	}
+	return func() {
+		stop()
+		wg.Done()
+	}, wg
+}
+
+func startServices(
+	config configuration.ExecutorConfiguration,
+	clusterContext context.ClusterContext,
+	taskManager *task.BackgroundTaskManager,
+	registerer prometheus.Registerer,
+	drainHandler *drain.HttpHandler) (func(), error) {
+
+	if config.Kubernetes.PendingPodChecks == nil {
+		return nil, fmt.Errorf("config error: missing pending pod checks")
+	}
+	pendingPodChecker, err := podchecks.NewPodChecks(*config.Kubernetes.PendingPodChecks)
+	if err != nil {
+		return nil, fmt.Errorf("config error in pending pod checks: %s", err)
+	}
+	podMutations, err := podmutation.NewPipelineFromConfig(config.Kubernetes.PodMutations)
+	if err != nil {
+		return nil, fmt.Errorf("config error in pod mutations: %s", err)
+	}
+
+	nodeInfoService := node.NewKubernetesNodeInfoService(clusterContext, config.Kubernetes.ToleratedTaints)
+	queueUtilisationService := utilisation.NewMetricsServerQueueUtilisationService(
+		clusterContext, nodeInfoService)
+
+	runningPodChecker, err := podchecks.NewRunningPodChecks(config.Kubernetes.RunningPodChecks, clusterContext, queueUtilisationService)
+	if err != nil {
+		return nil, fmt.Errorf("config error in running pod checks: %s", err)
+	}
 
 	conn, err := createConnectionToApi(config)
 	if err != nil {
-		log.Errorf("Failed to connect to API because: %s", err)
-		os.Exit(-1)
+		return nil, fmt.Errorf("failed to connect to API because: %s", err)
 	}
 
 	queueClient := api.NewAggregatedQueueClient(conn)
@@ -71,7 +307,9 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
-		eventClient)
+		eventClient,
+		config.Kubernetes.FailedContainerLogLines,
+		config.Kubernetes.FailedContainerLogBytes)
 
 	jobLeaseService := service.NewJobLeaseService(
 		clusterContext,
@@ -80,39 +318,44 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 		config.Kubernetes.AvoidNodeLabelsOnRetry,
 	)
 
-	if config.Kubernetes.PendingPodChecks == nil {
-		log.Error("Config error: Missing pending pod checks")
-		os.Exit(-1)
-	}
-	pendingPodChecker, err := podchecks.NewPodChecks(*config.Kubernetes.PendingPodChecks)
-	if err != nil {
-		log.Errorf("Config error in pending pod checks: %s", err)
-		os.Exit(-1)
+	drainState := drain.NewState(registerer)
+	if drainHandler != nil {
+		drainHandler.Register(clusterContext.GetClusterId(), drainState)
 	}
 
 	jobContext := job.NewClusterJobContext(
 		clusterContext,
 		pendingPodChecker,
+		runningPodChecker,
+		drainState,
 		config.Kubernetes.StuckTerminatingPodExpiry,
 		config.Application.UpdateConcurrencyLimit)
-	submitter := job.NewSubmitter(clusterContext, config.Kubernetes.PodDefaults, config.Application.SubmitConcurrencyLimit)
 
-	nodeInfoService := node.NewKubernetesNodeInfoService(clusterContext, config.Kubernetes.ToleratedTaints)
-	queueUtilisationService := utilisation.NewMetricsServerQueueUtilisationService(
-		clusterContext, nodeInfoService)
+	admissionPolicy := admission.NewPolicy(config.Kubernetes.AdmissionPolicy)
+
+	submitter := job.NewSubmitter(
+		clusterContext,
+		config.Kubernetes.PodDefaults,
+		podMutations,
+		admissionPolicy,
+		config.Application.SubmitConcurrencyLimit)
+
 	clusterUtilisationService := utilisation.NewClusterUtilisationService(
 		clusterContext,
 		queueUtilisationService,
 		nodeInfoService,
 		usageClient,
-		config.Kubernetes.TrackedNodeLabels)
+		config.Kubernetes.TrackedNodeLabels,
+		config.Kubernetes.ReportCachedImages)
 
 	clusterAllocationService := service.NewClusterAllocationService(
 		clusterContext,
 		eventReporter,
 		jobLeaseService,
 		clusterUtilisationService,
-		submitter)
+		submitter,
+		drainState,
+		config.Drain.Deadline)
 
 	jobManager := service.NewJobManager(
 		clusterContext,
@@ -124,16 +367,18 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 
 	job.RunIngressCleanup(clusterContext)
 
-	pod_metrics.ExposeClusterContextMetrics(clusterContext, clusterUtilisationService, queueUtilisationService, nodeInfoService)
+	pod_metrics.ExposeClusterContextMetrics(clusterContext, clusterUtilisationService, queueUtilisationService, nodeInfoService, registerer)
 
 	taskManager.Register(clusterUtilisationService.ReportClusterUtilisation, config.Task.UtilisationReportingInterval, "utilisation_reporting")
 	taskManager.Register(eventReporter.ReportMissingJobEvents, config.Task.MissingJobEventReconciliationInterval, "event_reconciliation")
 	taskManager.Register(clusterAllocationService.AllocateSpareClusterCapacity, config.Task.AllocateSpareClusterCapacityInterval, "job_lease_request")
 	taskManager.Register(jobManager.ManageJobLeases, config.Task.JobLeaseRenewalInterval, "job_management")
 
-	if config.Metric.ExposeQueueUsageMetrics {
+	if config.Metric.ExposeQueueUsageMetrics || runningPodChecker.RequiresUtilisationData() {
 		taskManager.Register(queueUtilisationService.RefreshUtilisationData, config.Task.QueueUsageDataRefreshInterval, "pod_usage_data_refresh")
+	}
 
+	if config.Metric.ExposeQueueUsageMetrics {
 		if config.Task.UtilisationEventReportingInterval > 0 {
 			podUtilisationReporter := utilisation.NewUtilisationEventReporter(
 				clusterContext,
@@ -151,9 +396,8 @@ func StartUpWithContext(config configuration.ExecutorConfiguration, clusterConte
 		if taskManager.StopAll(2 * time.Second) {
 			log.Warnf("Graceful shutdown timed out")
 		}
-		log.Infof("Shutdown complete")
-		wg.Done()
-	}, wg
+		log.Infof("Shutdown of cluster %s complete", clusterContext.GetClusterId())
+	}, nil
 }
 
 func createConnectionToApi(config configuration.ExecutorConfiguration) (*grpc.ClientConn, error) {
@@ -177,5 +421,33 @@ func validateConfig(config configuration.ExecutorConfiguration) error {
 	if config.Application.DeleteConcurrencyLimit <= 0 {
 		return fmt.Errorf("DeleteConcurrencyLimit was %d, must be greater or equal to 1", config.Application.DeleteConcurrencyLimit)
 	}
+	clusterIds := map[string]bool{}
+	for _, clusterConfig := range config.Clusters {
+		if clusterConfig.ClusterId == "" {
+			return fmt.Errorf("Every cluster in clusters must have a clusterId")
+		}
+		if clusterIds[clusterConfig.ClusterId] {
+			return fmt.Errorf("Cluster %s is configured more than once in clusters", clusterConfig.ClusterId)
+		}
+		clusterIds[clusterConfig.ClusterId] = true
+	}
+	return validatePodConfig(config)
+}
+
+// validatePodConfig checks the pod checks and pod mutations can be created, so a broken config fails startup
+// instead of every cluster retrying to start with it.
+func validatePodConfig(config configuration.ExecutorConfiguration) error {
+	if config.Kubernetes.PendingPodChecks == nil {
+		return fmt.Errorf("Missing pendingPodChecks")
+	}
+	if _, err := podchecks.NewPodChecks(*config.Kubernetes.PendingPodChecks); err != nil {
+		return fmt.Errorf("Invalid pendingPodChecks: %s", err)
+	}
+	if _, err := podmutation.NewPipelineFromConfig(config.Kubernetes.PodMutations); err != nil {
+		return fmt.Errorf("Invalid podMutations: %s", err)
+	}
+	if _, err := podchecks.NewRunningPodChecks(config.Kubernetes.RunningPodChecks, nil, nil); err != nil {
+		return fmt.Errorf("Invalid runningPodChecks: %s", err)
+	}
 	return nil
 }
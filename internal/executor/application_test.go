@@ -1,11 +1,17 @@
 package executor
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/G-Research/armada/internal/executor/configuration"
+	podchecksConfig "github.com/G-Research/armada/internal/executor/configuration/podchecks"
+	podmutationConfig "github.com/G-Research/armada/internal/executor/configuration/podmutation"
 )
 
 func Test_ValidateConfig_When_AvoidNodeLabelsOnRetry_MissingFrom_TrackedNodeLabels_Fails(t *testing.T) {
@@ -74,6 +80,105 @@ func Test_ValidateConfig_When_DeleteThreadCount_LessThanOrEqualToZero(t *testing
 	assert.Error(t, validateConfig(config))
 }
 
+func Test_ValidateConfig_When_Clusters(t *testing.T) {
+	config := createBasicValidExecutorConfiguration()
+
+	config.Clusters = []configuration.ClusterConfiguration{{ClusterId: "cluster-1"}, {ClusterId: "cluster-2", Kubeconfig: "/kube/config"}}
+	assert.NoError(t, validateConfig(config))
+
+	config.Clusters = []configuration.ClusterConfiguration{{ClusterId: "cluster-1"}, {ClusterId: "cluster-1", Context: "other"}}
+	assert.Error(t, validateConfig(config))
+
+	config.Clusters = []configuration.ClusterConfiguration{{Kubeconfig: "/kube/config"}}
+	assert.Error(t, validateConfig(config))
+}
+
+func Test_ValidateConfig_When_PodConfigIsInvalid_Fails(t *testing.T) {
+	config := createBasicValidExecutorConfiguration()
+	config.Kubernetes.PendingPodChecks = nil
+	assert.Error(t, validateConfig(config))
+
+	config = createBasicValidExecutorConfiguration()
+	config.Kubernetes.PendingPodChecks.Events = []podchecksConfig.EventCheck{{Regexp: "(", Action: podchecksConfig.ActionFail}}
+	assert.Error(t, validateConfig(config))
+
+	config = createBasicValidExecutorConfiguration()
+	config.Kubernetes.PodMutations = []podmutationConfig.Mutation{{Name: "broken", Patch: "not a patch"}}
+	assert.Error(t, validateConfig(config))
+
+	config = createBasicValidExecutorConfiguration()
+	config.Kubernetes.RunningPodChecks.IdleCpu = &podchecksConfig.IdleCpuCheck{MaxCpu: 0.1, Action: podchecksConfig.ActionFail}
+	assert.Error(t, validateConfig(config))
+}
+
+func Test_StartUpClusters_RetriesBrokenClusterWithoutAffectingOthers(t *testing.T) {
+	clusters := []configuration.ClusterConfiguration{{ClusterId: "healthy"}, {ClusterId: "broken"}}
+
+	mutex := sync.Mutex{}
+	attempts := map[string]int{}
+	stopped := map[string]bool{}
+	startUp := func(clusterConfig configuration.ClusterConfiguration) (func(), error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		attempts[clusterConfig.ClusterId]++
+		if clusterConfig.ClusterId == "broken" && attempts["broken"] < 3 {
+			return nil, errors.New("invalid kubeconfig")
+		}
+		return func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			stopped[clusterConfig.ClusterId] = true
+		}, nil
+	}
+
+	shutdown, wg := startUpClusters(clusters, startUp, 10*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return attempts["broken"] == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	shutdown()
+	wg.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, map[string]int{"healthy": 1, "broken": 3}, attempts)
+	assert.Equal(t, map[string]bool{"healthy": true, "broken": true}, stopped)
+}
+
+func Test_StartUpClusters_StopsRetryingOnShutdown(t *testing.T) {
+	clusters := []configuration.ClusterConfiguration{{ClusterId: "broken"}}
+
+	attempts := make(chan bool, 10)
+	startUp := func(clusterConfig configuration.ClusterConfiguration) (func(), error) {
+		attempts <- true
+		return nil, errors.New("invalid kubeconfig")
+	}
+
+	shutdown, wg := startUpClusters(clusters, startUp, time.Hour)
+	<-attempts
+	shutdown()
+	wg.Wait()
+
+	assert.Len(t, attempts, 0)
+}
+
+func Test_UnregisteringRegisterer_AllowsRegisteringMetricsAgain(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	newGauge := func() prometheus.Gauge {
+		return prometheus.NewGauge(prometheus.GaugeOpts{Name: "armada_executor_test_gauge"})
+	}
+
+	registerer := &unregisteringRegisterer{Registerer: registry}
+	registerer.MustRegister(newGauge())
+	assert.Error(t, registry.Register(newGauge()))
+
+	registerer.unregisterAll()
+	assert.NoError(t, registry.Register(newGauge()))
+}
+
 func createBasicValidExecutorConfiguration() configuration.ExecutorConfiguration {
 	return configuration.ExecutorConfiguration{
 		Application: configuration.ApplicationConfiguration{
@@ -81,5 +186,8 @@ func createBasicValidExecutorConfiguration() configuration.ExecutorConfiguration
 			UpdateConcurrencyLimit: 1,
 			DeleteConcurrencyLimit: 1,
 		},
+		Kubernetes: configuration.KubernetesConfiguration{
+			PendingPodChecks: &podchecksConfig.Checks{},
+		},
 	}
 }
@@ -0,0 +1,19 @@
+package admission
+
+// Policy restricts the pods the executor creates, jobs with pods violating it fail without being submitted.
+// Rules which are not set allow everything.
+type Policy struct {
+	AllowedNamespaces      []string // Namespaces pods can be created in
+	AllowedVolumeTypes     []string // Volume sources pods can use, named as in the pod spec, e.g. "emptyDir", "configMap", "hostPath"
+	AllowedHostPaths       []string // Path prefixes hostPath volumes can mount
+	AllowedImageRegistries []string // Registries images can be pulled from, e.g. "docker.io", "registry.example.com:5000"
+	SecurityContext        SecurityContextPolicy
+}
+
+type SecurityContextPolicy struct {
+	ForbidPrivileged          bool
+	ForbidPrivilegeEscalation bool // Containers have to set allowPrivilegeEscalation to false
+	ForbidHostNamespaces      bool // Pods can't use the host network, PID or IPC namespaces
+	RequireRunAsNonRoot       bool // Containers have to run as non-root, through runAsNonRoot or a non-zero runAsUser
+	AllowedCapabilities       []string
+}
@@ -28,6 +28,7 @@ const (
 type Checks struct {
 	Events            []EventCheck
 	ContainerStatuses []ContainerStatusCheck
+	NodeConditions    []NodeConditionCheck
 }
 
 type EventCheck struct {
@@ -45,3 +46,33 @@ type ContainerStatusCheck struct {
 	GracePeriod  time.Duration
 	Action       Action
 }
+
+type NodeConditionCheck struct {
+	Type        v1.NodeConditionType
+	Status      v1.ConditionStatus
+	GracePeriod time.Duration
+	Action      Action
+}
+
+// RunningChecks are checks of pods which have started running. Checks which are not set are disabled.
+type RunningChecks struct {
+	IdleCpu     *IdleCpuCheck
+	NoLogOutput *NoLogOutputCheck
+	RestartLoop *RestartLoopCheck
+}
+
+type IdleCpuCheck struct {
+	MaxCpu float64 // Pod is idle while it uses at most this many cores
+	Period time.Duration
+	Action Action
+}
+
+type NoLogOutputCheck struct {
+	Period time.Duration
+	Action Action
+}
+
+type RestartLoopCheck struct {
+	MaxRestarts int32
+	Action      Action
+}
@@ -0,0 +1,10 @@
+package podmutation
+
+// Mutation is a JSON patch applied to the pods of matching jobs before they are submitted.
+// Mutations are applied in the order they are configured.
+type Mutation struct {
+	Name          string
+	Queues        []string // Queues of jobs to mutate, empty matches all queues
+	LabelSelector string   // Kubernetes label selector the pod has to match, e.g. "team=ml,tier!=batch"
+	Patch         string   // RFC 6902 JSON patch document, e.g. [{"op": "add", "path": "/spec/priorityClassName", "value": "high"}]
+}
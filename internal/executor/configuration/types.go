@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/internal/executor/configuration/admission"
 	"github.com/G-Research/armada/internal/executor/configuration/podchecks"
+	"github.com/G-Research/armada/internal/executor/configuration/podmutation"
 	"github.com/G-Research/armada/pkg/client"
 )
 
@@ -34,6 +36,7 @@ type ClientConfiguration struct {
 type KubernetesConfiguration struct {
 	ImpersonateUsers          bool
 	TrackedNodeLabels         []string
+	ReportCachedImages        bool // Report the images cached on each node, so jobs can be scheduled where their images are
 	AvoidNodeLabelsOnRetry    []string
 	ToleratedTaints           []string
 	MinimumPodAge             time.Duration
@@ -41,7 +44,12 @@ type KubernetesConfiguration struct {
 	FailedPodExpiry           time.Duration
 	MinimumJobSize            common.ComputeResources
 	PodDefaults               *PodDefaults
+	PodMutations              []podmutation.Mutation
+	AdmissionPolicy           admission.Policy
 	PendingPodChecks          *podchecks.Checks
+	RunningPodChecks          podchecks.RunningChecks
+	FailedContainerLogLines   int64 // Number of lines of each failed container's log attached to job failed events, 0 disables
+	FailedContainerLogBytes   int64 // Maximum size of the log excerpt of each failed container
 }
 
 type TaskConfiguration struct {
@@ -60,12 +68,28 @@ type MetricConfiguration struct {
 	ExposeQueueUsageMetrics bool
 }
 
+type DrainConfiguration struct {
+	AdminPort uint16        // Port of the drain admin endpoint, the endpoint is disabled when 0
+	AdminHost string        // Host the drain admin endpoint listens on, all interfaces when empty
+	Deadline  time.Duration // Time jobs running on a cluster have to finish after it starts draining, unless given when starting the drain
+}
+
+// ClusterConfiguration describes one of several Kubernetes clusters managed by a single executor.
+type ClusterConfiguration struct {
+	ClusterId  string
+	Pool       string
+	Kubeconfig string // Path to the kubeconfig of the cluster, the default kubeconfig is used when empty
+	Context    string // Context of the kubeconfig to use, the current context is used when empty
+}
+
 type ExecutorConfiguration struct {
 	Metric        MetricConfiguration
 	Application   ApplicationConfiguration
 	ApiConnection client.ApiConnectionDetails
 	Client        ClientConfiguration
+	Clusters      []ClusterConfiguration // When empty, the executor manages the cluster it runs in as Application.ClusterId
 
 	Kubernetes KubernetesConfiguration
 	Task       TaskConfiguration
+	Drain      DrainConfiguration
 }
@@ -4,8 +4,10 @@ import (
 	ctx "context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1beta1"
@@ -46,6 +48,8 @@ type ClusterContext interface {
 	GetNode(nodeName string) (*v1.Node, error)
 	GetNodeStatsSummary(*v1.Node) (*v1alpha1.Summary, error)
 	GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
+	GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error)
+	GetPodLastLogTime(pod *v1.Pod, containerName string) (*time.Time, error)
 	GetServices(pod *v1.Pod) ([]*v1.Service, error)
 	GetIngresses(pod *v1.Pod) ([]*networking.Ingress, error)
 
@@ -88,7 +92,8 @@ func (c *KubernetesClusterContext) GetClusterPool() string {
 func NewClusterContext(
 	configuration configuration.ApplicationConfiguration,
 	minTimeBetweenRepeatDeletionCalls time.Duration,
-	kubernetesClientProvider cluster.KubernetesClientProvider) *KubernetesClusterContext {
+	kubernetesClientProvider cluster.KubernetesClientProvider,
+	registerer prometheus.Registerer) *KubernetesClusterContext {
 
 	kubernetesClient := kubernetesClientProvider.Client()
 
@@ -98,8 +103,8 @@ func NewClusterContext(
 		clusterId:                configuration.ClusterId,
 		pool:                     configuration.Pool,
 		deleteThreadCount:        configuration.DeleteConcurrencyLimit,
-		submittedPods:            util.NewTimeExpiringPodCache(time.Minute, time.Second, "submitted_job"),
-		podsToDelete:             util.NewTimeExpiringPodCache(minTimeBetweenRepeatDeletionCalls, time.Second, "deleted_job"),
+		submittedPods:            util.NewTimeExpiringPodCacheWithRegisterer(time.Minute, time.Second, "submitted_job", registerer),
+		podsToDelete:             util.NewTimeExpiringPodCacheWithRegisterer(minTimeBetweenRepeatDeletionCalls, time.Second, "deleted_job", registerer),
 		stopper:                  make(chan struct{}),
 		podInformer:              factory.Core().V1().Pods(),
 		nodeInformer:             factory.Core().V1().Nodes(),
@@ -194,6 +199,49 @@ func (c *KubernetesClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error
 	return eventsTyped, nil
 }
 
+func (c *KubernetesClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	logOptions := &v1.PodLogOptions{
+		Container: containerName,
+		TailLines: &tailLines,
+	}
+
+	requestContext, cancel := ctx.WithTimeout(ctx.Background(), 10*time.Second)
+	defer cancel()
+
+	logs, err := c.kubernetesClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Do(requestContext).Raw()
+	if err != nil {
+		return "", err
+	}
+	return string(logs), nil
+}
+
+// GetPodLastLogTime returns the time of the last log line of the container, or nil if it hasn't logged anything.
+func (c *KubernetesClusterContext) GetPodLastLogTime(pod *v1.Pod, containerName string) (*time.Time, error) {
+	tailLines := int64(1)
+	logOptions := &v1.PodLogOptions{
+		Container:  containerName,
+		TailLines:  &tailLines,
+		Timestamps: true,
+	}
+
+	requestContext, cancel := ctx.WithTimeout(ctx.Background(), 10*time.Second)
+	defer cancel()
+
+	logs, err := c.kubernetesClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Do(requestContext).Raw()
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, nil
+	}
+	timestamp := strings.SplitN(string(logs), " ", 2)[0]
+	lastLogTime, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(timestamp))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse log timestamp %s", err)
+	}
+	return &lastLogTime, nil
+}
+
 func (c *KubernetesClusterContext) GetNodes() ([]*v1.Node, error) {
 	return c.nodeInformer.Lister().List(labels.Everything())
 }
@@ -45,6 +45,7 @@ func setupTestWithMinRepeatedDeletePeriod(minRepeatedDeletePeriod time.Duration)
 		configuration.ApplicationConfiguration{ClusterId: "test-cluster-1", Pool: "pool", DeleteConcurrencyLimit: 1},
 		minRepeatedDeletePeriod,
 		clientProvider,
+		prometheus.DefaultRegisterer,
 	)
 
 	return clusterContext, clientProvider
@@ -13,4 +13,5 @@ const (
 	IngressReported          = "ingress_reported"
 	MarkedForDeletion        = "deletion_requested"
 	JobDoneAnnotation        = "reported_done"
+	AppliedPodMutations      = "applied_pod_mutations"
 )
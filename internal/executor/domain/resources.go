@@ -5,6 +5,12 @@ import "github.com/G-Research/armada/internal/common"
 const AcceleratorDutyCycle = "armadaproject.io/accelerator-duty-cycle"
 const AcceleratorMemory = "armadaproject.io/accelerator-memory"
 
+// Network usage of a pod, the byte counts are cumulative and the rates are averaged since the previous stats sample.
+const NetworkReceivedBytes = "armadaproject.io/network-received-bytes"
+const NetworkTransmittedBytes = "armadaproject.io/network-transmitted-bytes"
+const NetworkReceiveRate = "armadaproject.io/network-receive-bytes-per-second"
+const NetworkTransmitRate = "armadaproject.io/network-transmit-bytes-per-second"
+
 type UtilisationData struct {
 	CurrentUsage    common.ComputeResources
 	CumulativeUsage common.ComputeResources
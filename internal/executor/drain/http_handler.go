@@ -0,0 +1,116 @@
+package drain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type clusterDrainStatus struct {
+	ClusterId string     `json:"clusterId"`
+	Draining  bool       `json:"draining"`
+	Deadline  *time.Time `json:"deadline,omitempty"`
+}
+
+// HttpHandler is the admin endpoint for draining the clusters managed by the executor.
+// GET shows the drain state of the clusters, POST starts draining and DELETE stops draining.
+// Jobs have until the deadline given by the "deadline" duration parameter to finish after draining starts.
+// POST and DELETE apply to the cluster given by the "cluster" parameter, or all clusters when it is missing.
+type HttpHandler struct {
+	defaultDeadline time.Duration
+	states          map[string]*State
+	mutex           sync.RWMutex
+}
+
+func NewHttpHandler(defaultDeadline time.Duration) *HttpHandler {
+	return &HttpHandler{
+		defaultDeadline: defaultDeadline,
+		states:          map[string]*State{},
+	}
+}
+
+// Register makes the drain state of the cluster available through the endpoint.
+func (h *HttpHandler) Register(clusterId string, state *State) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.states[clusterId] = state
+}
+
+func (h *HttpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	states, err := h.selectStates(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		deadline := h.defaultDeadline
+		if deadlineParameter := r.URL.Query().Get("deadline"); deadlineParameter != "" {
+			deadline, err = time.ParseDuration(deadlineParameter)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Invalid deadline: %s", err), http.StatusBadRequest)
+				return
+			}
+		}
+		for clusterId, state := range states {
+			log.Infof("Draining cluster %s, running jobs have until %s to finish", clusterId, deadline)
+			state.Start(time.Now().Add(deadline))
+		}
+	case http.MethodDelete:
+		for clusterId, state := range states {
+			log.Infof("Stopped draining cluster %s", clusterId)
+			state.Stop()
+		}
+	default:
+		http.Error(w, fmt.Sprintf("Method %s is not supported", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(createStatuses(states))
+	if err != nil {
+		log.Errorf("Failed to write drain response: %v", err)
+	}
+}
+
+func (h *HttpHandler) selectStates(r *http.Request) (map[string]*State, error) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	clusterId := r.URL.Query().Get("cluster")
+	if clusterId == "" {
+		states := make(map[string]*State, len(h.states))
+		for id, state := range h.states {
+			states[id] = state
+		}
+		return states, nil
+	}
+	state, exists := h.states[clusterId]
+	if !exists {
+		return nil, fmt.Errorf("Cluster %s is not managed by this executor", clusterId)
+	}
+	return map[string]*State{clusterId: state}, nil
+}
+
+func createStatuses(states map[string]*State) []clusterDrainStatus {
+	statuses := make([]clusterDrainStatus, 0, len(states))
+	for clusterId, state := range states {
+		status := clusterDrainStatus{ClusterId: clusterId}
+		if deadline, draining := state.Deadline(); draining {
+			status.Draining = true
+			status.Deadline = &deadline
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ClusterId < statuses[j].ClusterId
+	})
+	return statuses
+}
@@ -0,0 +1,97 @@
+package drain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpHandler_StartAndStopDrainOfCluster(t *testing.T) {
+	handler, states := makeHandler("cluster-1", "cluster-2")
+
+	response := serve(handler, http.MethodPost, "/drain?cluster=cluster-1&deadline=30m")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.True(t, states["cluster-1"].IsDraining())
+	assert.False(t, states["cluster-2"].IsDraining())
+
+	deadline, _ := states["cluster-1"].Deadline()
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), deadline, time.Minute)
+
+	response = serve(handler, http.MethodDelete, "/drain?cluster=cluster-1")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.False(t, states["cluster-1"].IsDraining())
+}
+
+func TestHttpHandler_StartDrainOfAllClusters_UsesDefaultDeadline(t *testing.T) {
+	handler, states := makeHandler("cluster-1", "cluster-2")
+
+	serve(handler, http.MethodPost, "/drain")
+
+	for _, state := range states {
+		deadline, draining := state.Deadline()
+		assert.True(t, draining)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Minute)
+	}
+}
+
+func TestHttpHandler_ShowsDrainState(t *testing.T) {
+	handler, states := makeHandler("cluster-1", "cluster-2")
+	states["cluster-2"].Start(time.Now())
+
+	response := serve(handler, http.MethodGet, "/drain")
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	statuses := []clusterDrainStatus{}
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &statuses))
+	if assert.Len(t, statuses, 2) {
+		assert.Equal(t, "cluster-1", statuses[0].ClusterId)
+		assert.False(t, statuses[0].Draining)
+		assert.Nil(t, statuses[0].Deadline)
+		assert.Equal(t, "cluster-2", statuses[1].ClusterId)
+		assert.True(t, statuses[1].Draining)
+		assert.NotNil(t, statuses[1].Deadline)
+	}
+}
+
+func TestHttpHandler_InvalidRequests(t *testing.T) {
+	handler, states := makeHandler("cluster-1")
+
+	assert.Equal(t, http.StatusNotFound, serve(handler, http.MethodPost, "/drain?cluster=unknown").Code)
+	assert.Equal(t, http.StatusBadRequest, serve(handler, http.MethodPost, "/drain?deadline=soon").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(handler, http.MethodPut, "/drain").Code)
+	assert.False(t, states["cluster-1"].IsDraining())
+}
+
+func TestState_IsDeadlinePassed(t *testing.T) {
+	state := NewState(prometheus.NewRegistry())
+	now := time.Now()
+	assert.False(t, state.IsDeadlinePassed(now))
+
+	state.Start(now.Add(time.Minute))
+	assert.False(t, state.IsDeadlinePassed(now))
+	assert.True(t, state.IsDeadlinePassed(now.Add(time.Minute)))
+
+	state.Stop()
+	assert.False(t, state.IsDeadlinePassed(now.Add(time.Minute)))
+}
+
+func makeHandler(clusterIds ...string) (*HttpHandler, map[string]*State) {
+	handler := NewHttpHandler(time.Hour)
+	states := map[string]*State{}
+	for _, clusterId := range clusterIds {
+		states[clusterId] = NewState(prometheus.NewRegistry())
+		handler.Register(clusterId, states[clusterId])
+	}
+	return handler, states
+}
+
+func serve(handler http.Handler, method string, url string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, url, nil))
+	return recorder
+}
@@ -0,0 +1,90 @@
+package drain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/G-Research/armada/internal/executor/metrics"
+)
+
+// State is the drain state of a cluster. While a cluster is draining, the executor doesn't lease new jobs
+// and returns the leases of jobs still running once the drain deadline has passed.
+type State struct {
+	mutex    sync.RWMutex
+	draining bool
+	deadline time.Time
+	// drainedOnServer is true if the drain was started because the cluster was drained on the server
+	drainedOnServer bool
+}
+
+func NewState(registerer prometheus.Registerer) *State {
+	state := &State{}
+	promauto.With(registerer).NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: metrics.ArmadaExecutorMetricsPrefix + "draining",
+			Help: "Whether the cluster is being drained and doesn't lease new jobs",
+		},
+		func() float64 {
+			if state.IsDraining() {
+				return 1
+			}
+			return 0
+		})
+	return state
+}
+
+// Start drains the cluster, jobs not finished by the deadline will have their leases returned.
+// Starting a drain of a cluster already being drained moves the deadline.
+func (s *State) Start(deadline time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.draining = true
+	s.deadline = deadline
+	s.drainedOnServer = false
+}
+
+func (s *State) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.draining = false
+	s.deadline = time.Time{}
+	s.drainedOnServer = false
+}
+
+// SetDrainedOnServer follows the drain state of the cluster on the server. The cluster starts draining with the
+// given deadline when the server has drained it, and a drain started this way stops once the server no longer does.
+// Drains started with Start are not affected.
+func (s *State) SetDrainedOnServer(drained bool, deadline time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if drained && !s.draining {
+		s.draining = true
+		s.deadline = deadline
+		s.drainedOnServer = true
+	} else if !drained && s.drainedOnServer {
+		s.draining = false
+		s.deadline = time.Time{}
+		s.drainedOnServer = false
+	}
+}
+
+func (s *State) IsDraining() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.draining
+}
+
+// Deadline returns the time jobs have to finish by, or false if the cluster isn't draining.
+func (s *State) Deadline() (time.Time, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.deadline, s.draining
+}
+
+func (s *State) IsDeadlinePassed(now time.Time) bool {
+	deadline, draining := s.Deadline()
+	return draining && !now.Before(deadline)
+}
@@ -0,0 +1,44 @@
+package drain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestState_SetDrainedOnServer_StartsAndStopsDrain(t *testing.T) {
+	state := &State{}
+	deadline := time.Now().Add(time.Hour)
+
+	state.SetDrainedOnServer(true, deadline)
+	actualDeadline, draining := state.Deadline()
+	assert.True(t, draining)
+	assert.Equal(t, deadline, actualDeadline)
+
+	// The deadline is kept while the cluster stays drained on the server
+	state.SetDrainedOnServer(true, deadline.Add(time.Hour))
+	actualDeadline, _ = state.Deadline()
+	assert.Equal(t, deadline, actualDeadline)
+
+	state.SetDrainedOnServer(false, time.Time{})
+	assert.False(t, state.IsDraining())
+}
+
+func TestState_SetDrainedOnServer_DoesNotStopDrainStartedLocally(t *testing.T) {
+	state := &State{}
+	deadline := time.Now().Add(time.Hour)
+
+	state.Start(deadline)
+	state.SetDrainedOnServer(false, time.Time{})
+	assert.True(t, state.IsDraining())
+
+	state.SetDrainedOnServer(true, deadline.Add(time.Hour))
+	actualDeadline, _ := state.Deadline()
+	assert.Equal(t, deadline, actualDeadline)
+
+	// Stopping the local drain does not stop the server from draining the cluster again
+	state.Stop()
+	state.SetDrainedOnServer(true, deadline)
+	assert.True(t, state.IsDraining())
+}
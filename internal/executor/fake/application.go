@@ -10,8 +10,13 @@ import (
 	"github.com/G-Research/armada/internal/executor/metrics"
 )
 
-func StartUp(config configuration.ExecutorConfiguration, nodes []*context.NodeSpec) (func(), *sync.WaitGroup) {
+func StartUp(config configuration.ExecutorConfiguration, nodes []*context.NodeSpec, workload *context.WorkloadSpec) (func(), *sync.WaitGroup, error) {
+	clusterContext, err := context.NewFakeClusterContextWithWorkload(config.Application, nodes, workload)
+	if err != nil {
+		return nil, nil, err
+	}
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
-	return executor.StartUpWithContext(config, context.NewFakeClusterContext(config.Application, nodes), task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix), wg)
+	shutdown, wg := executor.StartUpWithContext(config, clusterContext, task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix), wg)
+	return shutdown, wg, nil
 }
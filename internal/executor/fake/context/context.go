@@ -10,7 +10,6 @@ import (
 	"sync"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -25,6 +24,8 @@ import (
 	"github.com/G-Research/armada/internal/executor/context"
 )
 
+const fakeNetworkBytesPerSecond = 1024 * 1024
+
 type NodeSpec struct {
 	Name        string
 	Count       int
@@ -50,25 +51,56 @@ type FakeClusterContext struct {
 	handlers              []*cache.ResourceEventHandlerFuncs
 	rwLock                sync.RWMutex
 	pods                  map[string]*v1.Pod
+	podEvents             map[string][]*v1.Event
 	nodes                 []*v1.Node
 	nodeAvailableResource map[string]common.ComputeResources
+	workload              *WorkloadSpec
+	stop                  chan struct{}
 }
 
 func NewFakeClusterContext(appConfig configuration.ApplicationConfiguration, nodeSpecs []*NodeSpec) context.ClusterContext {
+	// The default workload is always valid
+	c, _ := NewFakeClusterContextWithWorkload(appConfig, nodeSpecs, nil)
+	return c
+}
+
+// NewFakeClusterContextWithWorkload returns a fake cluster whose pods behave as modelled by workload, or an error if the
+// workload is invalid.
+func NewFakeClusterContextWithWorkload(
+	appConfig configuration.ApplicationConfiguration,
+	nodeSpecs []*NodeSpec,
+	workload *WorkloadSpec) (context.ClusterContext, error) {
+
+	if workload != nil {
+		if err := workload.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	c := &FakeClusterContext{
 		clusterId:             appConfig.ClusterId,
 		pool:                  appConfig.Pool,
 		pods:                  map[string]*v1.Pod{},
+		podEvents:             map[string][]*v1.Event{},
 		nodeAvailableResource: map[string]common.ComputeResources{},
+		workload:              workload,
+		stop:                  make(chan struct{}),
 	}
 	if nodeSpecs == nil {
 		nodeSpecs = DefaultNodeSpec
 	}
+	if c.workload == nil {
+		c.workload = &WorkloadSpec{}
+	}
 	c.addNodes(nodeSpecs)
-	return c
+	if c.workload.NodeFailures.MeanTimeBetweenFailures > 0 {
+		go c.simulateNodeFailures()
+	}
+	return c, nil
 }
 
-func (FakeClusterContext) Stop() {
+func (c *FakeClusterContext) Stop() {
+	close(c.stop)
 }
 
 func (c *FakeClusterContext) AddPodEventHandler(handler cache.ResourceEventHandlerFuncs) {
@@ -95,18 +127,47 @@ func (c *FakeClusterContext) GetActiveBatchPods() ([]*v1.Pod, error) {
 }
 
 func (c *FakeClusterContext) GetNodes() ([]*v1.Node, error) {
-	return c.nodes, nil
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+
+	nodes := make([]*v1.Node, len(c.nodes))
+	copy(nodes, c.nodes)
+	return nodes, nil
 }
 
 func (c *FakeClusterContext) GetNode(nodeName string) (*v1.Node, error) {
-	return c.nodes[0], nil
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+
+	for _, n := range c.nodes {
+		if n.Name == nodeName {
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("Node %s not found", nodeName)
 }
 
 func (c *FakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
-	return []*v1.Event{}, nil
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+
+	events := []*v1.Event{}
+	for _, event := range c.podEvents[pod.Name] {
+		events = append(events, event.DeepCopy())
+	}
+	return events, nil
+}
+
+func (c *FakeClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	return "", nil
+}
+
+func (c *FakeClusterContext) GetPodLastLogTime(pod *v1.Pod, containerName string) (*time.Time, error) {
+	return nil, nil
 }
 
 func (c *FakeClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
+	plan := c.workload.plan(pod)
 	saved := c.savePod(pod)
 
 	for _, h := range c.handlers {
@@ -129,21 +190,33 @@ func (c *FakeClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []
 			time.Sleep(100 * time.Millisecond)
 		}
 
+		if plan.stuckPending != nil {
+			c.keepPending(saved, plan.stuckPending)
+			c.deallocate(saved)
+			return
+		}
+
 		start := metav1.Now()
 		c.updateStatus(saved, v1.PodRunning, v1.ContainerState{Running: &v1.ContainerStateRunning{
 			StartedAt: start,
 		}})
 
-		runtime := c.extractSleepTime(saved)
-		time.Sleep(time.Duration(runtime) * time.Second)
+		time.Sleep(plan.runtime)
 
 		c.deallocate(saved)
 
-		c.updateStatus(saved, v1.PodSucceeded, v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+		terminated := &v1.ContainerStateTerminated{
 			StartedAt:  start,
 			FinishedAt: metav1.Now(),
 			ExitCode:   0,
-		}})
+		}
+		if plan.failure == nil {
+			c.updateStatus(saved, v1.PodSucceeded, v1.ContainerState{Terminated: terminated})
+			return
+		}
+		c.updatePodStatus(saved, v1.PodFailed, v1.ContainerState{Terminated: terminated}, func(pod *v1.Pod) {
+			plan.failure.apply(pod, terminated)
+		})
 	}()
 
 	return pod, nil
@@ -185,9 +258,64 @@ func (c *FakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return fmt.Errorf("Ingresses not implemented in FakeClusterContext")
 }
 
+// keepPending leaves the pod pending with its containers waiting, until the pod is deleted.
+func (c *FakeClusterContext) keepPending(saved *v1.Pod, stuckPending *StuckPendingSpec) {
+	c.rwLock.Lock()
+	now := metav1.Now()
+	events := make([]*v1.Event, 0, len(stuckPending.Events))
+	for i, e := range stuckPending.Events {
+		events = append(events, &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      saved.Name + "." + strconv.Itoa(i),
+				Namespace: saved.Namespace,
+			},
+			InvolvedObject: v1.ObjectReference{
+				Kind:      "Pod",
+				Name:      saved.Name,
+				Namespace: saved.Namespace,
+				UID:       saved.UID,
+			},
+			Type:           e.Type,
+			Reason:         e.Reason,
+			Message:        e.Message,
+			FirstTimestamp: now,
+			LastTimestamp:  now,
+			Count:          1,
+		})
+	}
+	c.podEvents[saved.Name] = events
+	c.rwLock.Unlock()
+
+	c.updateStatus(saved, v1.PodPending, v1.ContainerState{Waiting: &v1.ContainerStateWaiting{
+		Reason:  stuckPending.Reason,
+		Message: stuckPending.Message,
+	}})
+
+	for !c.isRemoved(saved) {
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (c *FakeClusterContext) isRemoved(pod *v1.Pod) bool {
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+	_, exists := c.pods[pod.Name]
+	return !exists
+}
+
 func (c *FakeClusterContext) updateStatus(saved *v1.Pod, phase v1.PodPhase, state v1.ContainerState) (*v1.Pod, *v1.Pod) {
+	return c.updatePodStatus(saved, phase, state, func(*v1.Pod) {})
+}
+
+// updatePodStatus sets the phase and container states of the pod, applying any further changes with updateFunc.
+// Pods which already finished, e.g. because their node failed, are left as they are.
+func (c *FakeClusterContext) updatePodStatus(saved *v1.Pod, phase v1.PodPhase, state v1.ContainerState, updateFunc func(*v1.Pod)) (*v1.Pod, *v1.Pod) {
 	c.rwLock.Lock()
 	oldPod := saved.DeepCopy()
+	if oldPod.Status.Phase == v1.PodSucceeded || oldPod.Status.Phase == v1.PodFailed {
+		c.rwLock.Unlock()
+		return oldPod, oldPod
+	}
 	saved.Status.Phase = phase
 	containerStatuses := []v1.ContainerStatus{}
 	for _, c := range saved.Spec.Containers {
@@ -199,6 +327,7 @@ func (c *FakeClusterContext) updateStatus(saved *v1.Pod, phase v1.PodPhase, stat
 	}
 
 	saved.Status.ContainerStatuses = containerStatuses
+	updateFunc(saved)
 
 	newPod := saved.DeepCopy()
 	c.rwLock.Unlock()
@@ -210,10 +339,10 @@ func (c *FakeClusterContext) updateStatus(saved *v1.Pod, phase v1.PodPhase, stat
 	return oldPod, newPod
 }
 
-func (c *FakeClusterContext) extractSleepTime(pod *v1.Pod) float32 {
-	c.rwLock.Lock()
-	defer c.rwLock.Unlock()
-
+func extractSleepTime(pod *v1.Pod) (time.Duration, bool) {
+	if len(pod.Spec.Containers) == 0 {
+		return 0, false
+	}
 	command := append(pod.Spec.Containers[0].Command, pod.Spec.Containers[0].Args...)
 	commandString := strings.Join(command, " ")
 
@@ -224,11 +353,10 @@ func (c *FakeClusterContext) extractSleepTime(pod *v1.Pod) float32 {
 		if len(randomCallMatches) == 3 {
 			random, _ := strconv.Atoi(randomCallMatches[1])
 			fixed, _ := strconv.Atoi(randomCallMatches[2])
-			return rand.Float32()*float32(random) + float32(fixed)
+			return time.Duration((rand.Float32()*float32(random) + float32(fixed)) * float32(time.Second)), true
 		}
 	}
-	log.Errorf("Default sleep 1s, could not interpret command: %s", commandString)
-	return 1
+	return 0, false
 }
 
 func (c *FakeClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
@@ -255,6 +383,7 @@ func (c *FakeClusterContext) DeletePods(pods []*v1.Pod) {
 
 		for _, p := range pods {
 			delete(c.pods, p.Name)
+			delete(c.podEvents, p.Name)
 		}
 	}()
 }
@@ -267,8 +396,67 @@ func (c FakeClusterContext) GetClusterPool() string {
 	return c.pool
 }
 
-func (c FakeClusterContext) GetNodeStatsSummary(node *v1.Node) (*v1alpha1.Summary, error) {
-	return &v1alpha1.Summary{}, nil
+// GetNodeStatsSummary reports each running pod on the node as using the resources it requested,
+// and as receiving and transmitting fakeNetworkBytesPerSecond since it started.
+func (c *FakeClusterContext) GetNodeStatsSummary(node *v1.Node) (*v1alpha1.Summary, error) {
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+
+	now := metav1.Now()
+	summary := &v1alpha1.Summary{Node: v1alpha1.NodeStats{NodeName: node.Name}}
+	for _, pod := range c.pods {
+		if pod.Spec.NodeName != node.Name || pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+		summary.Pods = append(summary.Pods, fakePodStats(pod, now))
+	}
+	return summary, nil
+}
+
+func fakePodStats(pod *v1.Pod, now metav1.Time) v1alpha1.PodStats {
+	request := common.TotalPodResourceRequest(&pod.Spec)
+	runningSeconds := 0.0
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running != nil {
+			runningSeconds = now.Sub(status.State.Running.StartedAt.Time).Seconds()
+			break
+		}
+	}
+
+	cpu := request["cpu"]
+	memory := request["memory"]
+	ephemeralStorage := request["ephemeral-storage"]
+	usageNanoCores := uint64(cpu.ScaledValue(resource.Nano))
+	usageCoreNanoSeconds := uint64(float64(usageNanoCores) * runningSeconds)
+	workingSetBytes := uint64(memory.Value())
+	usedBytes := uint64(ephemeralStorage.Value())
+	networkBytes := uint64(fakeNetworkBytesPerSecond * runningSeconds)
+
+	return v1alpha1.PodStats{
+		PodRef:    v1alpha1.PodReference{Name: pod.Name, Namespace: pod.Namespace, UID: string(pod.UID)},
+		StartTime: pod.CreationTimestamp,
+		CPU: &v1alpha1.CPUStats{
+			Time:                 now,
+			UsageNanoCores:       &usageNanoCores,
+			UsageCoreNanoSeconds: &usageCoreNanoSeconds,
+		},
+		Memory: &v1alpha1.MemoryStats{
+			Time:            now,
+			WorkingSetBytes: &workingSetBytes,
+		},
+		EphemeralStorage: &v1alpha1.FsStats{
+			Time:      now,
+			UsedBytes: &usedBytes,
+		},
+		Network: &v1alpha1.NetworkStats{
+			Time: now,
+			InterfaceStats: v1alpha1.InterfaceStats{
+				Name:    "eth0",
+				RxBytes: &networkBytes,
+				TxBytes: &networkBytes,
+			},
+		},
+	}
 }
 
 func (c *FakeClusterContext) addNodes(specs []*NodeSpec) {
@@ -285,6 +473,13 @@ func (c *FakeClusterContext) addNodes(specs []*NodeSpec) {
 				},
 				Status: v1.NodeStatus{
 					Allocatable: s.Allocatable,
+					Conditions: []v1.NodeCondition{{
+						Type:               v1.NodeReady,
+						Status:             v1.ConditionTrue,
+						Reason:             "KubeletReady",
+						LastHeartbeatTime:  metav1.Now(),
+						LastTransitionTime: metav1.Now(),
+					}},
 				}}
 			c.nodes = append(c.nodes, node)
 			c.nodeAvailableResource[node.Name] = common.FromResourceList(s.Allocatable)
@@ -331,6 +526,10 @@ func (c *FakeClusterContext) deallocate(pod *v1.Pod) {
 }
 
 func (c *FakeClusterContext) isSchedulableOn(pod *v1.Pod, n *v1.Node) bool {
+	if !isNodeReady(n) {
+		return false
+	}
+
 	requiredResource := common.TotalPodResourceRequest(&pod.Spec)
 	availableResource := c.nodeAvailableResource[n.Name].DeepCopy()
 	availableResource.Sub(requiredResource)
@@ -0,0 +1,97 @@
+package context
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const nodeFailureCheckInterval = time.Second
+
+func (c *FakeClusterContext) simulateNodeFailures() {
+	failureProbability := float64(nodeFailureCheckInterval) / float64(c.workload.NodeFailures.MeanTimeBetweenFailures)
+	ticker := time.NewTicker(nodeFailureCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			nodes, _ := c.GetNodes()
+			for _, n := range nodes {
+				if isNodeReady(n) && rand.Float64() < failureProbability {
+					c.failNode(n.Name)
+				}
+			}
+		}
+	}
+}
+
+// failNode makes the node not ready and fails the pods on it, the node recovers after the configured recovery time.
+func (c *FakeClusterContext) failNode(nodeName string) {
+	log.Infof("Simulating failure of node %s", nodeName)
+	c.setNodeReady(nodeName, v1.ConditionFalse, "NodeStatusUnknown", "Kubelet stopped posting node status.")
+
+	c.rwLock.RLock()
+	podsOnNode := []*v1.Pod{}
+	for _, pod := range c.pods {
+		if pod.Spec.NodeName == nodeName {
+			podsOnNode = append(podsOnNode, pod)
+		}
+	}
+	c.rwLock.RUnlock()
+
+	for _, pod := range podsOnNode {
+		state := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+			ExitCode:   137,
+			Reason:     "NodeLost",
+			FinishedAt: metav1.Now(),
+		}}
+		c.updatePodStatus(pod, v1.PodFailed, state, func(pod *v1.Pod) {
+			pod.Status.Reason = "NodeLost"
+			pod.Status.Message = fmt.Sprintf("Node %s which was running pod %s is unresponsive", nodeName, pod.Name)
+		})
+	}
+
+	time.AfterFunc(c.workload.NodeFailures.RecoveryTime, func() {
+		log.Infof("Simulated failure of node %s is over", nodeName)
+		c.setNodeReady(nodeName, v1.ConditionTrue, "KubeletReady", "kubelet is posting ready status")
+	})
+}
+
+func (c *FakeClusterContext) setNodeReady(nodeName string, status v1.ConditionStatus, reason string, message string) {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+
+	now := metav1.Now()
+	for i, n := range c.nodes {
+		if n.Name != nodeName {
+			continue
+		}
+		// Nodes are replaced rather than modified, as they are shared with callers of GetNodes
+		node := n.DeepCopy()
+		node.Status.Conditions = []v1.NodeCondition{{
+			Type:               v1.NodeReady,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+		}}
+		c.nodes[i] = node
+	}
+}
+
+func isNodeReady(node *v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return true
+}
@@ -0,0 +1,221 @@
+package context
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+)
+
+// Annotations a job can set to override the workload model for its pods.
+const (
+	RuntimeAnnotation            = "armadaproject.io/fake-runtime"
+	FailureCauseAnnotation       = "armadaproject.io/fake-failure-cause"
+	ExitCodeAnnotation           = "armadaproject.io/fake-exit-code"
+	StuckPendingReasonAnnotation = "armadaproject.io/fake-stuck-pending-reason"
+)
+
+type FailureCause string
+
+const (
+	FailureCauseError            FailureCause = "Error"
+	FailureCauseOOM              FailureCause = "OOM"
+	FailureCauseEvicted          FailureCause = "Evicted"
+	FailureCauseDeadlineExceeded FailureCause = "DeadlineExceeded"
+)
+
+// WorkloadSpec models how pods behave on the fake cluster.
+// Pods run for a runtime picked uniformly between MinRuntime and MaxRuntime, unless their command
+// is of the form `sleep $(( (RANDOM % 60) + 100 ))` or they have the RuntimeAnnotation.
+type WorkloadSpec struct {
+	MinRuntime   time.Duration
+	MaxRuntime   time.Duration
+	Failures     []FailureSpec
+	StuckPending []StuckPendingSpec
+	NodeFailures NodeFailureSpec
+}
+
+// FailureSpec makes pods fail at the end of their runtime with the given probability.
+// ExitCode defaults to 137 for pods killed by the system, and to 1 otherwise.
+type FailureSpec struct {
+	Probability float64
+	Cause       FailureCause
+	ExitCode    int32
+	Message     string
+}
+
+// StuckPendingSpec makes pods stay pending after they are scheduled with the given probability,
+// with their containers waiting for Reason, until they are deleted.
+type StuckPendingSpec struct {
+	Probability float64
+	Reason      string
+	Message     string
+	Events      []EventSpec
+}
+
+type EventSpec struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+// NodeFailureSpec makes nodes become not ready, failing the pods running on them, on average once every
+// MeanTimeBetweenFailures. Failed nodes recover after RecoveryTime. Node failures are disabled when
+// MeanTimeBetweenFailures is zero.
+type NodeFailureSpec struct {
+	MeanTimeBetweenFailures time.Duration
+	RecoveryTime            time.Duration
+}
+
+// Validate checks that pods can be planned with the workload and nodes failed with it.
+func (w *WorkloadSpec) Validate() error {
+	if w.MinRuntime < 0 {
+		return fmt.Errorf("invalid workload: MinRuntime %s must not be negative", w.MinRuntime)
+	}
+	if w.MinRuntime > w.MaxRuntime {
+		return fmt.Errorf("invalid workload: MinRuntime %s must not be greater than MaxRuntime %s", w.MinRuntime, w.MaxRuntime)
+	}
+	failureProbabilities := make([]float64, 0, len(w.Failures))
+	for _, failure := range w.Failures {
+		failureProbabilities = append(failureProbabilities, failure.Probability)
+	}
+	if err := validateProbabilities("Failures", failureProbabilities); err != nil {
+		return err
+	}
+	stuckPendingProbabilities := make([]float64, 0, len(w.StuckPending))
+	for _, stuckPending := range w.StuckPending {
+		stuckPendingProbabilities = append(stuckPendingProbabilities, stuckPending.Probability)
+	}
+	if err := validateProbabilities("StuckPending", stuckPendingProbabilities); err != nil {
+		return err
+	}
+	if w.NodeFailures.MeanTimeBetweenFailures < 0 {
+		return fmt.Errorf("invalid workload: NodeFailures.MeanTimeBetweenFailures %s must not be negative",
+			w.NodeFailures.MeanTimeBetweenFailures)
+	}
+	if w.NodeFailures.MeanTimeBetweenFailures > 0 && w.NodeFailures.RecoveryTime <= 0 {
+		return fmt.Errorf("invalid workload: NodeFailures.RecoveryTime must be greater than 0 when node failures are enabled")
+	}
+	return nil
+}
+
+// validateProbabilities checks that each probability is between 0 and 1 and that they sum to at most 1, as at most
+// one of the options is picked.
+func validateProbabilities(name string, probabilities []float64) error {
+	sum := 0.0
+	for i, probability := range probabilities {
+		if probability < 0 || probability > 1 {
+			return fmt.Errorf("invalid workload: probability %v of %s[%d] must be between 0 and 1", probability, name, i)
+		}
+		sum += probability
+	}
+	if sum > 1 {
+		return fmt.Errorf("invalid workload: probabilities of %s sum to %v, which is more than 1", name, sum)
+	}
+	return nil
+}
+
+type podPlan struct {
+	runtime      time.Duration
+	failure      *FailureSpec
+	stuckPending *StuckPendingSpec
+}
+
+func (w *WorkloadSpec) plan(pod *v1.Pod) podPlan {
+	plan := podPlan{runtime: w.runtime(pod)}
+
+	if reason, present := pod.Annotations[StuckPendingReasonAnnotation]; present {
+		plan.stuckPending = &StuckPendingSpec{
+			Reason: reason,
+			Events: []EventSpec{{Type: v1.EventTypeWarning, Reason: reason, Message: "Pod is stuck pending because " + reason}},
+		}
+	} else if index := pickByProbability(len(w.StuckPending), func(i int) float64 { return w.StuckPending[i].Probability }); index >= 0 {
+		plan.stuckPending = &w.StuckPending[index]
+	}
+
+	if cause, present := pod.Annotations[FailureCauseAnnotation]; present {
+		plan.failure = &FailureSpec{Cause: FailureCause(cause)}
+	} else if index := pickByProbability(len(w.Failures), func(i int) float64 { return w.Failures[i].Probability }); index >= 0 {
+		failure := w.Failures[index]
+		plan.failure = &failure
+	}
+	if exitCode, present := pod.Annotations[ExitCodeAnnotation]; present {
+		code, err := strconv.ParseInt(exitCode, 10, 32)
+		if err != nil {
+			log.Errorf("Invalid %s annotation on pod %s: %s", ExitCodeAnnotation, pod.Name, err)
+		} else {
+			if plan.failure == nil {
+				plan.failure = &FailureSpec{Cause: FailureCauseError}
+			}
+			plan.failure.ExitCode = int32(code)
+		}
+	}
+	return plan
+}
+
+func (w *WorkloadSpec) runtime(pod *v1.Pod) time.Duration {
+	if runtime, present := pod.Annotations[RuntimeAnnotation]; present {
+		duration, err := time.ParseDuration(runtime)
+		if err == nil {
+			return duration
+		}
+		log.Errorf("Invalid %s annotation on pod %s: %s", RuntimeAnnotation, pod.Name, err)
+	}
+	if runtime, ok := extractSleepTime(pod); ok {
+		return runtime
+	}
+	if w.MaxRuntime > 0 {
+		return w.MinRuntime + time.Duration(rand.Int63n(int64(w.MaxRuntime-w.MinRuntime)+1))
+	}
+	log.Errorf("Default sleep 1s, could not interpret command of pod %s", pod.Name)
+	return time.Second
+}
+
+// pickByProbability returns the index of the picked option, or -1 if none was picked.
+func pickByProbability(count int, probability func(int) float64) int {
+	r := rand.Float64()
+	for i := 0; i < count; i++ {
+		if r < probability(i) {
+			return i
+		}
+		r -= probability(i)
+	}
+	return -1
+}
+
+// apply sets the status of a pod terminated by the failure.
+func (f *FailureSpec) apply(pod *v1.Pod, state *v1.ContainerStateTerminated) {
+	reason := string(FailureCauseError)
+	exitCode := int32(1)
+	message := f.Message
+
+	switch f.Cause {
+	case FailureCauseOOM:
+		reason = "OOMKilled"
+		exitCode = 137
+	case FailureCauseEvicted:
+		exitCode = 137
+		pod.Status.Reason = "Evicted"
+		pod.Status.Message = message
+		if pod.Status.Message == "" {
+			pod.Status.Message = "The node was low on resource: memory."
+		}
+	case FailureCauseDeadlineExceeded:
+		exitCode = 137
+		pod.Status.Reason = "DeadlineExceeded"
+		pod.Status.Message = message
+		if pod.Status.Message == "" {
+			pod.Status.Message = "Pod was active on the node longer than the specified deadline"
+		}
+	}
+	if f.ExitCode != 0 {
+		exitCode = f.ExitCode
+	}
+
+	state.Reason = reason
+	state.ExitCode = exitCode
+	state.Message = message
+}
@@ -0,0 +1,227 @@
+package context
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/executor/configuration"
+	"github.com/G-Research/armada/internal/executor/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestWorkloadSpec_Plan_UsesAnnotations(t *testing.T) {
+	workload := &WorkloadSpec{MinRuntime: time.Hour, MaxRuntime: time.Hour}
+	pod := createTestPod(map[string]string{
+		RuntimeAnnotation:            "5s",
+		FailureCauseAnnotation:       string(FailureCauseOOM),
+		ExitCodeAnnotation:           "3",
+		StuckPendingReasonAnnotation: "ImagePullBackOff",
+	})
+
+	plan := workload.plan(pod)
+
+	assert.Equal(t, 5*time.Second, plan.runtime)
+	assert.Equal(t, FailureCauseOOM, plan.failure.Cause)
+	assert.Equal(t, int32(3), plan.failure.ExitCode)
+	assert.Equal(t, "ImagePullBackOff", plan.stuckPending.Reason)
+	assert.Len(t, plan.stuckPending.Events, 1)
+}
+
+func TestWorkloadSpec_Plan_UsesWorkload(t *testing.T) {
+	workload := &WorkloadSpec{
+		MinRuntime: time.Minute,
+		MaxRuntime: 2 * time.Minute,
+		Failures:   []FailureSpec{{Probability: 1, Cause: FailureCauseEvicted}},
+	}
+
+	plan := workload.plan(createTestPod(map[string]string{}))
+
+	assert.True(t, plan.runtime >= time.Minute && plan.runtime <= 2*time.Minute)
+	assert.Equal(t, FailureCauseEvicted, plan.failure.Cause)
+	assert.Nil(t, plan.stuckPending)
+}
+
+func TestWorkloadSpec_Plan_UsesSleepCommand(t *testing.T) {
+	pod := createTestPod(map[string]string{})
+	pod.Spec.Containers[0].Command = []string{"sh", "-c", "sleep $(( (RANDOM % 1) + 100 ))"}
+
+	plan := (&WorkloadSpec{}).plan(pod)
+
+	assert.True(t, plan.runtime >= 100*time.Second && plan.runtime <= 101*time.Second)
+	assert.Nil(t, plan.failure)
+}
+
+func TestFailureSpec_Apply(t *testing.T) {
+	expectedCauses := map[FailureCause]api.Cause{
+		FailureCauseError:            api.Cause_Error,
+		FailureCauseOOM:              api.Cause_OOM,
+		FailureCauseEvicted:          api.Cause_Evicted,
+		FailureCauseDeadlineExceeded: api.Cause_DeadlineExceeded,
+	}
+	for cause, expected := range expectedCauses {
+		pod := createTestPod(map[string]string{})
+		terminated := &v1.ContainerStateTerminated{}
+		(&FailureSpec{Cause: cause}).apply(pod, terminated)
+		pod.Status.ContainerStatuses = []v1.ContainerStatus{{Name: "container", State: v1.ContainerState{Terminated: terminated}}}
+
+		assert.Equal(t, expected, util.ExtractPodFailedCause(pod), cause)
+		assert.NotZero(t, terminated.ExitCode, cause)
+	}
+}
+
+func TestWorkloadSpec_Validate(t *testing.T) {
+	assert.NoError(t, (&WorkloadSpec{}).Validate())
+	assert.NoError(t, (&WorkloadSpec{
+		MinRuntime:   time.Minute,
+		MaxRuntime:   time.Hour,
+		Failures:     []FailureSpec{{Probability: 0.5}, {Probability: 0.5}},
+		StuckPending: []StuckPendingSpec{{Probability: 1}},
+		NodeFailures: NodeFailureSpec{MeanTimeBetweenFailures: time.Hour, RecoveryTime: time.Minute},
+	}).Validate())
+
+	for name, workload := range map[string]*WorkloadSpec{
+		"min runtime greater than max runtime": {MinRuntime: time.Hour, MaxRuntime: time.Minute},
+		"min runtime without max runtime":      {MinRuntime: time.Hour},
+		"negative min runtime":                 {MinRuntime: -time.Minute, MaxRuntime: time.Minute},
+		"negative probability":                 {Failures: []FailureSpec{{Probability: -0.1}}},
+		"probability greater than one":         {StuckPending: []StuckPendingSpec{{Probability: 1.5}}},
+		"probabilities sum to more than one":   {Failures: []FailureSpec{{Probability: 0.6}, {Probability: 0.6}}},
+		"node failures without recovery time":  {NodeFailures: NodeFailureSpec{MeanTimeBetweenFailures: time.Hour}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, workload.Validate())
+
+			_, err := NewFakeClusterContextWithWorkload(testAppConfig(), testNodeSpecs(), workload)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFakeClusterContext_FailsPods(t *testing.T) {
+	clusterContext, err := NewFakeClusterContextWithWorkload(testAppConfig(), testNodeSpecs(), &WorkloadSpec{
+		MinRuntime: 10 * time.Millisecond,
+		MaxRuntime: 10 * time.Millisecond,
+		Failures:   []FailureSpec{{Probability: 1, Cause: FailureCauseError, ExitCode: 42}},
+	})
+	require.NoError(t, err)
+	defer clusterContext.Stop()
+
+	_, err = clusterContext.SubmitPod(createTestPod(map[string]string{}), "user", []string{})
+	assert.NoError(t, err)
+
+	pod := waitForPhase(t, clusterContext, v1.PodFailed)
+	assert.Equal(t, map[string]int32{"container": 42}, util.ExtractPodExitCodes(pod))
+}
+
+func TestFakeClusterContext_KeepsPodsPending(t *testing.T) {
+	clusterContext, err := NewFakeClusterContextWithWorkload(testAppConfig(), testNodeSpecs(), &WorkloadSpec{
+		StuckPending: []StuckPendingSpec{{
+			Probability: 1,
+			Reason:      "ImagePullBackOff",
+			Events:      []EventSpec{{Type: v1.EventTypeWarning, Reason: "Failed", Message: "Failed to pull image"}},
+		}},
+	})
+	require.NoError(t, err)
+	defer clusterContext.Stop()
+
+	_, err = clusterContext.SubmitPod(createTestPod(map[string]string{}), "user", []string{})
+	assert.NoError(t, err)
+
+	var pod *v1.Pod
+	assert.Eventually(t, func() bool {
+		pods, _ := clusterContext.GetActiveBatchPods()
+		pod = pods[0]
+		statuses := pod.Status.ContainerStatuses
+		return len(statuses) == 1 && statuses[0].State.Waiting != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, v1.PodPending, pod.Status.Phase)
+	assert.Equal(t, "ImagePullBackOff", pod.Status.ContainerStatuses[0].State.Waiting.Reason)
+	events, err := clusterContext.GetPodEvents(pod)
+	assert.NoError(t, err)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "Failed to pull image", events[0].Message)
+		assert.Equal(t, v1.EventTypeWarning, events[0].Type)
+	}
+}
+
+func TestFakeClusterContext_FailNode(t *testing.T) {
+	clusterContext, err := NewFakeClusterContextWithWorkload(testAppConfig(), testNodeSpecs(), &WorkloadSpec{
+		MinRuntime:   time.Hour,
+		MaxRuntime:   time.Hour,
+		NodeFailures: NodeFailureSpec{RecoveryTime: 100 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	c := clusterContext.(*FakeClusterContext)
+	defer c.Stop()
+
+	_, err = c.SubmitPod(createTestPod(map[string]string{}), "user", []string{})
+	assert.NoError(t, err)
+	pod := waitForPhase(t, c, v1.PodRunning)
+
+	c.failNode(pod.Spec.NodeName)
+
+	node, err := c.GetNode(pod.Spec.NodeName)
+	assert.NoError(t, err)
+	assert.False(t, isNodeReady(node))
+
+	pods, _ := c.GetActiveBatchPods()
+	assert.Equal(t, v1.PodFailed, pods[0].Status.Phase)
+	assert.Equal(t, "NodeLost", pods[0].Status.Reason)
+
+	assert.Eventually(t, func() bool {
+		node, _ := c.GetNode(pod.Spec.NodeName)
+		return isNodeReady(node)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func waitForPhase(t *testing.T, clusterContext interface {
+	GetActiveBatchPods() ([]*v1.Pod, error)
+}, phase v1.PodPhase) *v1.Pod {
+	var pod *v1.Pod
+	assert.Eventually(t, func() bool {
+		pods, _ := clusterContext.GetActiveBatchPods()
+		pod = pods[0]
+		return pod.Status.Phase == phase
+	}, 5*time.Second, 10*time.Millisecond)
+	return pod
+}
+
+func testAppConfig() configuration.ApplicationConfiguration {
+	return configuration.ApplicationConfiguration{ClusterId: "test", Pool: "pool"}
+}
+
+func testNodeSpecs() []*NodeSpec {
+	return []*NodeSpec{{
+		Name:  "worker",
+		Count: 1,
+		Allocatable: v1.ResourceList{
+			"cpu":    resource.MustParse("8"),
+			"memory": resource.MustParse("64Gi"),
+		},
+	}}
+}
+
+func createTestPod(annotations map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod",
+			Annotations: annotations,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "container",
+				Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+					"cpu":    resource.MustParse("1"),
+					"memory": resource.MustParse("1Gi"),
+				}},
+			}},
+		},
+	}
+}
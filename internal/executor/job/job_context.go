@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/drain"
 	"github.com/G-Research/armada/internal/executor/podchecks"
 	"github.com/G-Research/armada/internal/executor/util"
 )
@@ -20,6 +21,8 @@ const (
 	UnableToSchedule  IssueType = iota
 	StuckTerminating  IssueType = iota
 	ExternallyDeleted IssueType = iota
+	Unhealthy         IssueType = iota
+	Drained           IssueType = iota
 )
 
 type RunningJob struct {
@@ -54,6 +57,8 @@ type ClusterJobContext struct {
 	clusterContext            context.ClusterContext
 	stuckTerminatingPodExpiry time.Duration
 	pendingPodChecker         podchecks.PodChecker
+	runningPodChecker         podchecks.RunningPodChecker
+	drainState                *drain.State
 	updateThreadCount         int
 
 	activeJobs        map[string]*jobRecord
@@ -63,12 +68,16 @@ type ClusterJobContext struct {
 func NewClusterJobContext(
 	clusterContext context.ClusterContext,
 	pendingPodChecker podchecks.PodChecker,
+	runningPodChecker podchecks.RunningPodChecker,
+	drainState *drain.State,
 	stuckTerminatingPodExpiry time.Duration,
 	updateThreadCount int) *ClusterJobContext {
 	jobContext := &ClusterJobContext{
 		clusterContext:            clusterContext,
 		stuckTerminatingPodExpiry: stuckTerminatingPodExpiry,
 		pendingPodChecker:         pendingPodChecker,
+		runningPodChecker:         runningPodChecker,
+		drainState:                drainState,
 		updateThreadCount:         updateThreadCount,
 		activeJobs:                map[string]*jobRecord{},
 		activeJobIdsMutex:         sync.Mutex{},
@@ -95,7 +104,9 @@ func (c *ClusterJobContext) GetJobs() ([]*RunningJob, error) {
 	}
 
 	runningJobs := groupRunningJobs(pods)
-	return c.addIssues(runningJobs), nil
+	runningJobs = c.addIssues(runningJobs)
+	c.runningPodChecker.RemoveInactivePods(pods)
+	return runningJobs, nil
 }
 
 func (c *ClusterJobContext) MarkIssuesResolved(job *RunningJob) {
@@ -208,6 +219,23 @@ func (c *ClusterJobContext) addIssues(jobs []*RunningJob) []*RunningJob {
 
 func (c *ClusterJobContext) detectStuckPods(runningJob *RunningJob) {
 
+	if c.drainState.IsDeadlinePassed(time.Now()) {
+		unfinishedPods := util.FilterNonCompletedPods(runningJob.ActivePods)
+		if len(unfinishedPods) > 0 {
+			message := fmt.Sprintf("Cluster %s is being drained and the job did not finish by the drain deadline, Armada will return lease and retry.", c.clusterContext.GetClusterId())
+			log.Infof("Returning job %s: %s", runningJob.JobId, message)
+
+			c.registerIssue(runningJob, &PodIssue{
+				OriginatingPod: unfinishedPods[0].DeepCopy(),
+				Pods:           runningJob.ActivePods,
+				Message:        message,
+				Retryable:      true,
+				Type:           Drained,
+			})
+			return
+		}
+	}
+
 	for _, pod := range runningJob.ActivePods {
 		if pod.DeletionTimestamp != nil && pod.DeletionTimestamp.Add(c.stuckTerminatingPodExpiry).Before(time.Now()) {
 			// pod is stuck in terminating phase, this sometimes happen on node failure
@@ -233,7 +261,15 @@ func (c *ClusterJobContext) detectStuckPods(runningJob *RunningJob) {
 				continue
 			}
 
-			action, podCheckMessage := c.pendingPodChecker.GetAction(pod, podEvents, time.Now().Sub(lastStateChange))
+			var node *v1.Node
+			if pod.Spec.NodeName != "" {
+				node, err = c.clusterContext.GetNode(pod.Spec.NodeName)
+				if err != nil {
+					log.Warnf("Unable to get node %s of pod %s: %v", pod.Spec.NodeName, pod.Name, err)
+				}
+			}
+
+			action, podCheckMessage := c.pendingPodChecker.GetAction(pod, node, podEvents, time.Now().Sub(lastStateChange))
 
 			if action != podchecks.ActionWait {
 				retryable := action == podchecks.ActionRetry
@@ -250,10 +286,36 @@ func (c *ClusterJobContext) detectStuckPods(runningJob *RunningJob) {
 				})
 				break
 			}
+		} else if pod.Status.Phase == v1.PodRunning {
+
+			action, podCheckMessage := c.runningPodChecker.GetAction(pod)
+
+			if action != podchecks.ActionWait {
+				retryable := action == podchecks.ActionRetry
+				message := createUnhealthyPodMessage(retryable, podCheckMessage)
+
+				log.Warnf("Found issue with running pod %s in namespace %s: %s", pod.Name, pod.Namespace, message)
+
+				c.registerIssue(runningJob, &PodIssue{
+					OriginatingPod: pod.DeepCopy(),
+					Pods:           runningJob.ActivePods,
+					Message:        message,
+					Retryable:      retryable,
+					Type:           Unhealthy,
+				})
+				break
+			}
 		}
 	}
 }
 
+func createUnhealthyPodMessage(retryable bool, originalMessage string) string {
+	if retryable {
+		return fmt.Sprintf("Running pod is unhealthy, Armada will return lease and retry.\n%s", originalMessage)
+	}
+	return fmt.Sprintf("Running pod is unhealthy, Armada will not retry.\n%s", originalMessage)
+}
+
 func createStuckPodMessage(retryable bool, originalMessage string) string {
 	if retryable {
 		return fmt.Sprintf("Unable to schedule pod, Armada will return lease and retry.\n%s", originalMessage)
@@ -11,9 +11,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/executor/admission"
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/internal/executor/podmutation"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	util2 "github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/pkg/api"
@@ -29,17 +31,23 @@ type SubmitService struct {
 	eventReporter         reporter.EventReporter
 	clusterContext        context.ClusterContext
 	podDefaults           *configuration.PodDefaults
+	podMutations          *podmutation.Pipeline
+	admissionPolicy       *admission.Policy
 	submissionThreadCount int
 }
 
 func NewSubmitter(
 	clusterContext context.ClusterContext,
 	podDefaults *configuration.PodDefaults,
+	podMutations *podmutation.Pipeline,
+	admissionPolicy *admission.Policy,
 	submissionThreadCount int) *SubmitService {
 
 	return &SubmitService{
 		clusterContext:        clusterContext,
 		podDefaults:           podDefaults,
+		podMutations:          podMutations,
+		admissionPolicy:       admissionPolicy,
 		submissionThreadCount: submissionThreadCount,
 	}
 }
@@ -91,6 +99,9 @@ func (allocationService *SubmitService) submitWorker(wg *sync.WaitGroup, jobsToS
 
 				status, ok := err.(errors.APIStatus)
 				recoverable := !ok || isNotRecoverable(status.Status())
+				if admission.IsPolicyViolation(err) || podmutation.IsMutationError(err) {
+					recoverable = false
+				}
 
 				errDetails := &FailedSubmissionDetails{
 					Job:         job,
@@ -111,6 +122,14 @@ func (allocationService *SubmitService) submitWorker(wg *sync.WaitGroup, jobsToS
 
 func (allocationService *SubmitService) submitPod(job *api.Job, i int) (*v1.Pod, error) {
 	pod := util2.CreatePod(job, allocationService.podDefaults, i)
+	err := allocationService.podMutations.Apply(pod, job)
+	if err != nil {
+		return pod, err
+	}
+	err = allocationService.admissionPolicy.Check(pod)
+	if err != nil {
+		return pod, err
+	}
 
 	if exposesPorts(job, &pod.Spec) {
 		services, ingresses := util2.GenerateIngresses(job, pod, allocationService.podDefaults.Ingress)
@@ -0,0 +1,65 @@
+package job
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/executor/admission"
+	"github.com/G-Research/armada/internal/executor/configuration"
+	config "github.com/G-Research/armada/internal/executor/configuration/admission"
+	mutationConfig "github.com/G-Research/armada/internal/executor/configuration/podmutation"
+	fakeContext "github.com/G-Research/armada/internal/executor/fake/context"
+	"github.com/G-Research/armada/internal/executor/podmutation"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestSubmitService_FailsJobsViolatingAdmissionPolicy(t *testing.T) {
+	clusterContext := fakeContext.NewFakeClusterContext(configuration.ApplicationConfiguration{ClusterId: "test"}, nil)
+	policy := admission.NewPolicy(config.Policy{AllowedNamespaces: []string{"batch"}})
+	submitter := NewSubmitter(clusterContext, nil, podmutation.NewPipeline(), policy, 1)
+
+	failed := submitter.SubmitJobs([]*api.Job{makeJob("allowed", "batch"), makeJob("forbidden", "kube-system")})
+
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, "forbidden", failed[0].Job.Id)
+		assert.False(t, failed[0].Recoverable)
+		assert.Contains(t, failed[0].Error.Error(), "namespace kube-system is not allowed")
+	}
+	pods, err := clusterContext.GetBatchPods()
+	assert.NoError(t, err)
+	assert.Len(t, pods, 1)
+}
+
+func TestSubmitService_FailsJobsWhosePodMutationFails(t *testing.T) {
+	clusterContext := fakeContext.NewFakeClusterContext(configuration.ApplicationConfiguration{ClusterId: "test"}, nil)
+	pipeline, err := podmutation.NewPipelineFromConfig([]mutationConfig.Mutation{
+		{Name: "missing-path", Patch: `[{"op": "replace", "path": "/spec/missing/path", "value": "other"}]`},
+	})
+	assert.NoError(t, err)
+	submitter := NewSubmitter(clusterContext, nil, pipeline, admission.NewPolicy(config.Policy{}), 1)
+
+	failed := submitter.SubmitJobs([]*api.Job{makeJob("job", "batch")})
+
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, "job", failed[0].Job.Id)
+		assert.False(t, failed[0].Recoverable)
+		assert.Contains(t, failed[0].Error.Error(), "pod mutation missing-path failed")
+	}
+	pods, err := clusterContext.GetBatchPods()
+	assert.NoError(t, err)
+	assert.Empty(t, pods)
+}
+
+func makeJob(id string, namespace string) *api.Job {
+	return &api.Job{
+		Id:        id,
+		Queue:     "queue",
+		JobSetId:  "job-set",
+		Namespace: namespace,
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{Name: "main", Image: "ubuntu"}},
+		},
+	}
+}
@@ -76,14 +76,15 @@ func ExposeClusterContextMetrics(
 	context context.ClusterContext,
 	utilisationService utilisation.UtilisationService,
 	queueUtilisationService utilisation.PodUtilisationService,
-	nodeInfoService node.NodeInfoService) *ClusterContextMetrics {
+	nodeInfoService node.NodeInfoService,
+	registerer prometheus.Registerer) *ClusterContextMetrics {
 	m := &ClusterContextMetrics{
 		context:                 context,
 		utilisationService:      utilisationService,
 		queueUtilisationService: queueUtilisationService,
 		nodeInfoService:         nodeInfoService,
 		knownQueues:             map[string]map[string]bool{},
-		podCountTotal: promauto.NewCounterVec(
+		podCountTotal: promauto.With(registerer).NewCounterVec(
 			prometheus.CounterOpts{
 				Name: metrics.ArmadaExecutorMetricsPrefix + "job_pod_total",
 				Help: "Counter for pods in different phases by queue",
@@ -108,7 +109,7 @@ func ExposeClusterContextMetrics(
 			m.reportPhase(newPod)
 		},
 	})
-	prometheus.MustRegister(m)
+	registerer.MustRegister(m)
 	return m
 }
 
@@ -0,0 +1,92 @@
+package podchecks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	config "github.com/G-Research/armada/internal/executor/configuration/podchecks"
+)
+
+type nodeConditionChecker interface {
+	getAction(pod *v1.Pod, node *v1.Node, timeInState time.Duration) (Action, string)
+}
+
+type nodeConditionCheck struct {
+	conditionType v1.NodeConditionType
+	status        v1.ConditionStatus
+	gracePeriod   time.Duration
+	action        Action
+}
+
+type nodeConditionChecks struct {
+	checks []nodeConditionCheck
+}
+
+func newNodeConditionChecks(configs []config.NodeConditionCheck) (*nodeConditionChecks, error) {
+	nodeConditionChecks := &nodeConditionChecks{}
+	for _, cfg := range configs {
+		if cfg.Type == "" {
+			return nil, fmt.Errorf("Node condition type must be set")
+		}
+
+		if cfg.Status != v1.ConditionTrue && cfg.Status != v1.ConditionFalse && cfg.Status != v1.ConditionUnknown {
+			return nil, fmt.Errorf("Invalid node condition status: \"%s\"", cfg.Status)
+		}
+
+		action, err := mapAction(cfg.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		check := nodeConditionCheck{conditionType: cfg.Type, status: cfg.Status, gracePeriod: cfg.GracePeriod, action: action}
+		nodeConditionChecks.checks = append(nodeConditionChecks.checks, check)
+		log.Infof("   Created node condition check %s=%s %s %s", check.conditionType, check.status, check.gracePeriod, check.action)
+	}
+	return nodeConditionChecks, nil
+}
+
+// getAction checks the conditions of the node the pod is bound to. A check applies once both the pod has been in its
+// current state and the node has had the condition for longer than the grace period.
+func (ncc *nodeConditionChecks) getAction(pod *v1.Pod, node *v1.Node, timeInState time.Duration) (Action, string) {
+	resultAction := ActionWait
+	resultMessages := []string{}
+	if node == nil {
+		return resultAction, ""
+	}
+
+	for _, condition := range node.Status.Conditions {
+		action, message := ncc.getConditionAction(pod, node, condition, timeInState)
+		resultAction = maxAction(resultAction, action)
+		if action != ActionWait {
+			resultMessages = append(resultMessages, message)
+		}
+	}
+	return resultAction, strings.Join(resultMessages, "\n")
+}
+
+func (ncc *nodeConditionChecks) getConditionAction(pod *v1.Pod, node *v1.Node, condition v1.NodeCondition, timeInState time.Duration) (Action, string) {
+	timeInCondition := timeInState
+	if !condition.LastTransitionTime.IsZero() {
+		sinceTransition := time.Now().Sub(condition.LastTransitionTime.Time)
+		if sinceTransition < timeInCondition {
+			timeInCondition = sinceTransition
+		}
+	}
+
+	for _, check := range ncc.checks {
+		if condition.Type == check.conditionType && condition.Status == check.status {
+			if timeInCondition >= check.gracePeriod {
+				log.Warnf("Pod %s in namespace %s is on node %s which has had condition %s=%s (%s) for more than %v, required action is %s",
+					pod.Name, pod.Namespace, node.Name, condition.Type, condition.Status, condition.Message, check.gracePeriod, check.action)
+				return check.action, fmt.Sprintf("Node %s has had condition %s=%s (%s) for more than timeout %v", node.Name, condition.Type, condition.Status, condition.Message, check.gracePeriod)
+			} else {
+				return ActionWait, ""
+			}
+		}
+	}
+	return ActionWait, ""
+}
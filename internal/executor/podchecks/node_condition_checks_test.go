@@ -0,0 +1,101 @@
+package podchecks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/G-Research/armada/internal/executor/configuration/podchecks"
+)
+
+func Test_nodeConditionChecks_WhenNoNode_ReturnsWait(t *testing.T) {
+	ncc, err := newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionRetry}})
+	assert.Nil(t, err)
+
+	action, message := ncc.getAction(&v1.Pod{}, nil, time.Hour)
+	assert.Equal(t, ActionWait, action)
+	assert.Empty(t, message)
+}
+
+func Test_nodeConditionChecks_WhenMatchingCondition_ReturnsAction(t *testing.T) {
+	ncc, err := newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionRetry}})
+	assert.Nil(t, err)
+
+	node := nodeWithCondition(v1.NodeDiskPressure, v1.ConditionTrue, time.Now().Add(-time.Hour))
+
+	action, message := ncc.getAction(&v1.Pod{}, node, time.Minute*2)
+	assert.Equal(t, ActionRetry, action)
+	assert.NotEmpty(t, message)
+}
+
+func Test_nodeConditionChecks_WhenConditionHasDifferentStatus_ReturnsWait(t *testing.T) {
+	ncc, err := newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionRetry}})
+	assert.Nil(t, err)
+
+	node := nodeWithCondition(v1.NodeDiskPressure, v1.ConditionFalse, time.Now().Add(-time.Hour))
+
+	action, message := ncc.getAction(&v1.Pod{}, node, time.Minute*2)
+	assert.Equal(t, ActionWait, action)
+	assert.Empty(t, message)
+}
+
+func Test_nodeConditionChecks_WhenPodNotInStateLongEnough_ReturnsWait(t *testing.T) {
+	ncc, err := newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionRetry}})
+	assert.Nil(t, err)
+
+	node := nodeWithCondition(v1.NodeDiskPressure, v1.ConditionTrue, time.Now().Add(-time.Hour))
+
+	action, _ := ncc.getAction(&v1.Pod{}, node, time.Second)
+	assert.Equal(t, ActionWait, action)
+}
+
+func Test_nodeConditionChecks_WhenConditionNotPresentLongEnough_ReturnsWait(t *testing.T) {
+	ncc, err := newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionRetry}})
+	assert.Nil(t, err)
+
+	node := nodeWithCondition(v1.NodeDiskPressure, v1.ConditionTrue, time.Now().Add(-time.Second))
+
+	action, _ := ncc.getAction(&v1.Pod{}, node, time.Hour)
+	assert.Equal(t, ActionWait, action)
+}
+
+func Test_nodeConditionChecks_CustomConditionAndMaxAction(t *testing.T) {
+	ncc, err := newNodeConditionChecks([]config.NodeConditionCheck{
+		{Type: v1.NodeNetworkUnavailable, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionRetry},
+		{Type: "KernelDeadlock", Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionFail},
+	})
+	assert.Nil(t, err)
+
+	node := nodeWithCondition(v1.NodeNetworkUnavailable, v1.ConditionTrue, time.Now().Add(-time.Hour))
+	node.Status.Conditions = append(node.Status.Conditions, nodeWithCondition("KernelDeadlock", v1.ConditionTrue, time.Now().Add(-time.Hour)).Status.Conditions...)
+
+	action, _ := ncc.getAction(&v1.Pod{}, node, time.Hour)
+	assert.Equal(t, ActionFail, action)
+}
+
+func Test_newNodeConditionChecks_InvalidConfig(t *testing.T) {
+	_, err := newNodeConditionChecks([]config.NodeConditionCheck{{Status: v1.ConditionTrue, Action: config.ActionRetry}})
+	assert.Error(t, err)
+
+	_, err = newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: "Yes", Action: config.ActionRetry}})
+	assert.Error(t, err)
+
+	_, err = newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, Action: "Wait"}})
+	assert.Error(t, err)
+}
+
+func nodeWithCondition(conditionType v1.NodeConditionType, status v1.ConditionStatus, lastTransition time.Time) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{
+				Type:               conditionType,
+				Status:             status,
+				LastTransitionTime: metav1.NewTime(lastTransition),
+			}},
+		},
+	}
+}
@@ -11,12 +11,13 @@ import (
 )
 
 type PodChecker interface {
-	GetAction(pod *v1.Pod, podEvents []*v1.Event, timeInState time.Duration) (Action, string)
+	GetAction(pod *v1.Pod, node *v1.Node, podEvents []*v1.Event, timeInState time.Duration) (Action, string)
 }
 
 type PodChecks struct {
 	eventChecks          eventChecker
 	containerStateChecks containerStateChecker
+	nodeConditionChecks  nodeConditionChecker
 }
 
 func NewPodChecks(cfg config.Checks) (*PodChecks, error) {
@@ -31,10 +32,17 @@ func NewPodChecks(cfg config.Checks) (*PodChecks, error) {
 		return nil, err
 	}
 
-	return &PodChecks{eventChecks: ec, containerStateChecks: csc}, nil
+	ncc, err := newNodeConditionChecks(cfg.NodeConditions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PodChecks{eventChecks: ec, containerStateChecks: csc, nodeConditionChecks: ncc}, nil
 }
 
-func (pc *PodChecks) GetAction(pod *v1.Pod, podEvents []*v1.Event, timeInState time.Duration) (Action, string) {
+// GetAction returns the action to take for a pod which hasn't started running. The node is the node the pod is
+// bound to, or nil if it hasn't been scheduled yet.
+func (pc *PodChecks) GetAction(pod *v1.Pod, node *v1.Node, podEvents []*v1.Event, timeInState time.Duration) (Action, string) {
 
 	messages := []string{}
 	eventAction, message := pc.eventChecks.getAction(pod.Name, podEvents, timeInState)
@@ -47,7 +55,12 @@ func (pc *PodChecks) GetAction(pod *v1.Pod, podEvents []*v1.Event, timeInState t
 		messages = append(messages, message)
 	}
 
-	resultAction := maxAction(eventAction, containerStateAction)
+	nodeConditionAction, message := pc.nodeConditionChecks.getAction(pod, node, timeInState)
+	if nodeConditionAction != ActionWait {
+		messages = append(messages, message)
+	}
+
+	resultAction := maxAction(maxAction(eventAction, containerStateAction), nodeConditionAction)
 	resultMessage := strings.Join(messages, "\n")
 	log.Infof("Pod checks for pod %s returned %s %s\n", pod.Name, resultAction, resultMessage)
 	return resultAction, resultMessage
@@ -31,15 +31,25 @@ func Test_GetAction(t *testing.T) {
 
 	for _, test := range tests {
 		podChecks := podChecksWithMocks(test.eventAction, test.containerStateAction)
-		result, _ := podChecks.GetAction(&v1.Pod{}, []*v1.Event{}, time.Minute)
+		result, _ := podChecks.GetAction(&v1.Pod{}, nil, []*v1.Event{}, time.Minute)
 		assert.Equal(t, test.expectedResult, result)
 	}
 }
 
+func Test_GetAction_IncludesNodeConditionAction(t *testing.T) {
+	podChecks := podChecksWithMocks(ActionRetry, ActionWait)
+	podChecks.nodeConditionChecks = &mockNodeConditionChecks{result: ActionFail, message: mockMessage(ActionFail)}
+
+	result, message := podChecks.GetAction(&v1.Pod{}, &v1.Node{}, []*v1.Event{}, time.Minute)
+	assert.Equal(t, ActionFail, result)
+	assert.Equal(t, "please retry\nplease fail", message)
+}
+
 func podChecksWithMocks(eventResult Action, containerStateResult Action) *PodChecks {
 	return &PodChecks{
 		eventChecks:          &mockEventChecks{result: eventResult, message: mockMessage(eventResult)},
 		containerStateChecks: &mockContainerStateChecks{result: containerStateResult, message: mockMessage(containerStateResult)},
+		nodeConditionChecks:  &mockNodeConditionChecks{result: ActionWait, message: mockMessage(ActionWait)},
 	}
 }
 
@@ -66,6 +76,11 @@ type mockContainerStateChecks struct {
 	message string
 }
 
+type mockNodeConditionChecks struct {
+	result  Action
+	message string
+}
+
 func (ec *mockEventChecks) getAction(podName string, podEvents []*v1.Event, timeInState time.Duration) (Action, string) {
 	return ec.result, ec.message
 }
@@ -73,3 +88,7 @@ func (ec *mockEventChecks) getAction(podName string, podEvents []*v1.Event, time
 func (csc *mockContainerStateChecks) getAction(pod *v1.Pod, timeInState time.Duration) (Action, string) {
 	return csc.result, csc.message
 }
+
+func (ncc *mockNodeConditionChecks) getAction(pod *v1.Pod, node *v1.Node, timeInState time.Duration) (Action, string) {
+	return ncc.result, ncc.message
+}
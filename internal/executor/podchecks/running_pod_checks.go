@@ -0,0 +1,225 @@
+package podchecks
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	config "github.com/G-Research/armada/internal/executor/configuration/podchecks"
+	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/utilisation"
+)
+
+type RunningPodChecker interface {
+	GetAction(pod *v1.Pod) (Action, string)
+	RemoveInactivePods(activePods []*v1.Pod)
+}
+
+type idleCpuCheck struct {
+	maxMilliCpu int64
+	period      time.Duration
+	action      Action
+}
+
+type noLogOutputCheck struct {
+	period time.Duration
+	action Action
+}
+
+type restartLoopCheck struct {
+	maxRestarts int32
+	action      Action
+}
+
+// RunningPodChecks checks running pods for being idle, not logging anything or restarting containers repeatedly.
+// As the idle CPU and log output checks span a period of time, it keeps track of when each pod was last active.
+type RunningPodChecks struct {
+	clusterContext     context.ClusterContext
+	utilisationService utilisation.PodUtilisationService
+
+	idleCpu     *idleCpuCheck
+	noLogOutput *noLogOutputCheck
+	restartLoop *restartLoopCheck
+
+	idleSince   map[types.UID]time.Time
+	lastLogTime map[types.UID]time.Time
+	stateMutex  sync.Mutex
+}
+
+func NewRunningPodChecks(
+	cfg config.RunningChecks,
+	clusterContext context.ClusterContext,
+	utilisationService utilisation.PodUtilisationService) (*RunningPodChecks, error) {
+
+	log.Info("Creating running pod checks...")
+	checks := &RunningPodChecks{
+		clusterContext:     clusterContext,
+		utilisationService: utilisationService,
+		idleSince:          map[types.UID]time.Time{},
+		lastLogTime:        map[types.UID]time.Time{},
+	}
+
+	if cfg.IdleCpu != nil {
+		action, err := mapAction(cfg.IdleCpu.Action)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.IdleCpu.Period <= 0 {
+			return nil, fmt.Errorf("Idle cpu check period must be positive")
+		}
+		checks.idleCpu = &idleCpuCheck{maxMilliCpu: int64(cfg.IdleCpu.MaxCpu * 1000), period: cfg.IdleCpu.Period, action: action}
+		log.Infof("   Created idle cpu check %v %s %s", cfg.IdleCpu.MaxCpu, checks.idleCpu.period, checks.idleCpu.action)
+	}
+
+	if cfg.NoLogOutput != nil {
+		action, err := mapAction(cfg.NoLogOutput.Action)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.NoLogOutput.Period <= 0 {
+			return nil, fmt.Errorf("No log output check period must be positive")
+		}
+		checks.noLogOutput = &noLogOutputCheck{period: cfg.NoLogOutput.Period, action: action}
+		log.Infof("   Created no log output check %s %s", checks.noLogOutput.period, checks.noLogOutput.action)
+	}
+
+	if cfg.RestartLoop != nil {
+		action, err := mapAction(cfg.RestartLoop.Action)
+		if err != nil {
+			return nil, err
+		}
+		checks.restartLoop = &restartLoopCheck{maxRestarts: cfg.RestartLoop.MaxRestarts, action: action}
+		log.Infof("   Created restart loop check %d %s", checks.restartLoop.maxRestarts, checks.restartLoop.action)
+	}
+
+	return checks, nil
+}
+
+// RequiresUtilisationData returns whether pod utilisation data has to be refreshed for the checks.
+func (rpc *RunningPodChecks) RequiresUtilisationData() bool {
+	return rpc.idleCpu != nil
+}
+
+func (rpc *RunningPodChecks) GetAction(pod *v1.Pod) (Action, string) {
+	rpc.stateMutex.Lock()
+	defer rpc.stateMutex.Unlock()
+
+	resultAction := ActionWait
+	messages := []string{}
+	for _, check := range []func(pod *v1.Pod, now time.Time) (Action, string){rpc.checkRestartLoop, rpc.checkIdleCpu, rpc.checkNoLogOutput} {
+		action, message := check(pod, time.Now())
+		if action != ActionWait {
+			resultAction = maxAction(resultAction, action)
+			messages = append(messages, message)
+		}
+	}
+
+	resultMessage := strings.Join(messages, "\n")
+	if resultAction != ActionWait {
+		log.Infof("Running pod checks for pod %s returned %s %s\n", pod.Name, resultAction, resultMessage)
+	}
+	return resultAction, resultMessage
+}
+
+func (rpc *RunningPodChecks) RemoveInactivePods(activePods []*v1.Pod) {
+	rpc.stateMutex.Lock()
+	defer rpc.stateMutex.Unlock()
+
+	active := map[types.UID]bool{}
+	for _, pod := range activePods {
+		active[pod.UID] = true
+	}
+	for uid := range rpc.idleSince {
+		if !active[uid] {
+			delete(rpc.idleSince, uid)
+		}
+	}
+	for uid := range rpc.lastLogTime {
+		if !active[uid] {
+			delete(rpc.lastLogTime, uid)
+		}
+	}
+}
+
+func (rpc *RunningPodChecks) checkRestartLoop(pod *v1.Pod, now time.Time) (Action, string) {
+	if rpc.restartLoop == nil {
+		return ActionWait, ""
+	}
+	// The pod is shared with the informer cache, so its status slices must not be appended to
+	containerStatuses := make([]v1.ContainerStatus, 0, len(pod.Status.ContainerStatuses)+len(pod.Status.InitContainerStatuses))
+	containerStatuses = append(containerStatuses, pod.Status.ContainerStatuses...)
+	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.RestartCount > rpc.restartLoop.maxRestarts {
+			return rpc.restartLoop.action, fmt.Sprintf("Container %s has restarted %d times, more than the limit of %d", containerStatus.Name, containerStatus.RestartCount, rpc.restartLoop.maxRestarts)
+		}
+	}
+	return ActionWait, ""
+}
+
+func (rpc *RunningPodChecks) checkIdleCpu(pod *v1.Pod, now time.Time) (Action, string) {
+	if rpc.idleCpu == nil {
+		return ActionWait, ""
+	}
+	cpu, present := rpc.utilisationService.GetPodUtilisation(pod).CurrentUsage["cpu"]
+	if !present {
+		return ActionWait, ""
+	}
+	if cpu.MilliValue() > rpc.idleCpu.maxMilliCpu {
+		delete(rpc.idleSince, pod.UID)
+		return ActionWait, ""
+	}
+
+	idleSince, idle := rpc.idleSince[pod.UID]
+	if !idle {
+		rpc.idleSince[pod.UID] = now
+		return ActionWait, ""
+	}
+	if now.Sub(idleSince) >= rpc.idleCpu.period {
+		return rpc.idleCpu.action, fmt.Sprintf("Pod has used at most %dm cpu for more than %v", rpc.idleCpu.maxMilliCpu, rpc.idleCpu.period)
+	}
+	return ActionWait, ""
+}
+
+// checkNoLogOutput only fetches the logs of the pod once the last log line known is older than the period.
+func (rpc *RunningPodChecks) checkNoLogOutput(pod *v1.Pod, now time.Time) (Action, string) {
+	if rpc.noLogOutput == nil {
+		return ActionWait, ""
+	}
+	lastLogTime, known := rpc.lastLogTime[pod.UID]
+	if known && now.Sub(lastLogTime) < rpc.noLogOutput.period {
+		return ActionWait, ""
+	}
+
+	lastActive := time.Time{}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Running == nil {
+			continue
+		}
+		if containerStatus.State.Running.StartedAt.Time.After(lastActive) {
+			lastActive = containerStatus.State.Running.StartedAt.Time
+		}
+		containerLastLogTime, err := rpc.clusterContext.GetPodLastLogTime(pod, containerStatus.Name)
+		if err != nil {
+			log.Warnf("Unable to get logs of container %s in pod %s: %v", containerStatus.Name, pod.Name, err)
+			return ActionWait, ""
+		}
+		if containerLastLogTime != nil && containerLastLogTime.After(lastActive) {
+			lastActive = *containerLastLogTime
+		}
+	}
+	if lastActive.IsZero() {
+		return ActionWait, ""
+	}
+
+	rpc.lastLogTime[pod.UID] = lastActive
+	if now.Sub(lastActive) >= rpc.noLogOutput.period {
+		return rpc.noLogOutput.action, fmt.Sprintf("Pod has not logged anything for more than %v", rpc.noLogOutput.period)
+	}
+	return ActionWait, ""
+}
@@ -0,0 +1,203 @@
+package podchecks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/G-Research/armada/internal/common"
+	config "github.com/G-Research/armada/internal/executor/configuration/podchecks"
+	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/domain"
+)
+
+func Test_RunningPodChecks_WhenNoChecks_ReturnsWait(t *testing.T) {
+	rpc, err := NewRunningPodChecks(config.RunningChecks{}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	action, message := rpc.GetAction(runningPod(time.Now().Add(-time.Hour)))
+	assert.Equal(t, ActionWait, action)
+	assert.Empty(t, message)
+	assert.False(t, rpc.RequiresUtilisationData())
+}
+
+func Test_RunningPodChecks_RestartLoop(t *testing.T) {
+	rpc, err := NewRunningPodChecks(config.RunningChecks{
+		RestartLoop: &config.RestartLoopCheck{MaxRestarts: 3, Action: config.ActionRetry},
+	}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	pod := runningPod(time.Now())
+	pod.Status.ContainerStatuses[0].RestartCount = 3
+	action, _ := rpc.GetAction(pod)
+	assert.Equal(t, ActionWait, action)
+
+	pod.Status.ContainerStatuses[0].RestartCount = 4
+	action, message := rpc.GetAction(pod)
+	assert.Equal(t, ActionRetry, action)
+	assert.NotEmpty(t, message)
+}
+
+func Test_RunningPodChecks_RestartLoop_DoesNotModifyPod(t *testing.T) {
+	rpc, err := NewRunningPodChecks(config.RunningChecks{
+		RestartLoop: &config.RestartLoopCheck{MaxRestarts: 3, Action: config.ActionRetry},
+	}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	pod := runningPod(time.Now())
+	containerStatuses := make([]v1.ContainerStatus, 1, 2)
+	containerStatuses[0] = pod.Status.ContainerStatuses[0]
+	pod.Status.ContainerStatuses = containerStatuses
+	pod.Status.InitContainerStatuses = []v1.ContainerStatus{{Name: "init", RestartCount: 4}}
+
+	action, _ := rpc.GetAction(pod)
+	assert.Equal(t, ActionRetry, action)
+	assert.Empty(t, containerStatuses[:2][1].Name)
+}
+
+func Test_RunningPodChecks_IdleCpu(t *testing.T) {
+	utilisation := &fixedPodUtilisation{cpu: "10m"}
+	rpc, err := NewRunningPodChecks(config.RunningChecks{
+		IdleCpu: &config.IdleCpuCheck{MaxCpu: 0.05, Period: time.Minute, Action: config.ActionFail},
+	}, &logClusterContext{}, utilisation)
+	assert.Nil(t, err)
+	assert.True(t, rpc.RequiresUtilisationData())
+
+	pod := runningPod(time.Now().Add(-time.Hour))
+	action, _ := rpc.GetAction(pod)
+	assert.Equal(t, ActionWait, action)
+
+	rpc.idleSince[pod.UID] = time.Now().Add(-2 * time.Minute)
+	action, message := rpc.GetAction(pod)
+	assert.Equal(t, ActionFail, action)
+	assert.NotEmpty(t, message)
+
+	utilisation.cpu = "1"
+	action, _ = rpc.GetAction(pod)
+	assert.Equal(t, ActionWait, action)
+	assert.NotContains(t, rpc.idleSince, pod.UID)
+}
+
+func Test_RunningPodChecks_IdleCpu_WhenNoUtilisationData_ReturnsWait(t *testing.T) {
+	rpc, err := NewRunningPodChecks(config.RunningChecks{
+		IdleCpu: &config.IdleCpuCheck{MaxCpu: 0.05, Period: time.Minute, Action: config.ActionFail},
+	}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	pod := runningPod(time.Now().Add(-time.Hour))
+	action, _ := rpc.GetAction(pod)
+	assert.Equal(t, ActionWait, action)
+	assert.NotContains(t, rpc.idleSince, pod.UID)
+}
+
+func Test_RunningPodChecks_NoLogOutput(t *testing.T) {
+	clusterContext := &logClusterContext{}
+	rpc, err := NewRunningPodChecks(config.RunningChecks{
+		NoLogOutput: &config.NoLogOutputCheck{Period: 10 * time.Minute, Action: config.ActionFail},
+	}, clusterContext, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	recentLog := time.Now().Add(-time.Minute)
+	clusterContext.lastLogTime = &recentLog
+	pod := runningPod(time.Now().Add(-time.Hour))
+	action, _ := rpc.GetAction(pod)
+	assert.Equal(t, ActionWait, action)
+	assert.Equal(t, 1, clusterContext.calls)
+
+	// Logs are not fetched again until the last known log line is older than the period
+	action, _ = rpc.GetAction(pod)
+	assert.Equal(t, ActionWait, action)
+	assert.Equal(t, 1, clusterContext.calls)
+
+	oldLog := time.Now().Add(-time.Hour)
+	clusterContext.lastLogTime = &oldLog
+	rpc.lastLogTime[pod.UID] = oldLog
+	action, message := rpc.GetAction(pod)
+	assert.Equal(t, ActionFail, action)
+	assert.NotEmpty(t, message)
+	assert.Equal(t, 2, clusterContext.calls)
+}
+
+func Test_RunningPodChecks_NoLogOutput_UsesContainerStartWhenNoLogs(t *testing.T) {
+	rpc, err := NewRunningPodChecks(config.RunningChecks{
+		NoLogOutput: &config.NoLogOutputCheck{Period: 10 * time.Minute, Action: config.ActionRetry},
+	}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	action, _ := rpc.GetAction(runningPod(time.Now().Add(-time.Minute)))
+	assert.Equal(t, ActionWait, action)
+
+	oldPod := runningPod(time.Now().Add(-time.Hour))
+	oldPod.UID = "old-pod-uid"
+	action, _ = rpc.GetAction(oldPod)
+	assert.Equal(t, ActionRetry, action)
+}
+
+func Test_RunningPodChecks_RemoveInactivePods(t *testing.T) {
+	rpc, err := NewRunningPodChecks(config.RunningChecks{}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Nil(t, err)
+
+	active := runningPod(time.Now())
+	active.UID = "active"
+	rpc.idleSince["active"] = time.Now()
+	rpc.idleSince["inactive"] = time.Now()
+	rpc.lastLogTime["inactive"] = time.Now()
+
+	rpc.RemoveInactivePods([]*v1.Pod{active})
+
+	assert.Contains(t, rpc.idleSince, active.UID)
+	assert.Len(t, rpc.idleSince, 1)
+	assert.Empty(t, rpc.lastLogTime)
+}
+
+func Test_NewRunningPodChecks_InvalidConfig(t *testing.T) {
+	_, err := NewRunningPodChecks(config.RunningChecks{
+		IdleCpu: &config.IdleCpuCheck{MaxCpu: 0.05, Action: config.ActionFail},
+	}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Error(t, err)
+
+	_, err = NewRunningPodChecks(config.RunningChecks{
+		NoLogOutput: &config.NoLogOutputCheck{Period: time.Minute, Action: "Wait"},
+	}, &logClusterContext{}, &fixedPodUtilisation{})
+	assert.Error(t, err)
+}
+
+func runningPod(startedAt time.Time) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", UID: "pod-uid"},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "container",
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(startedAt)}},
+			}},
+		},
+	}
+}
+
+type fixedPodUtilisation struct {
+	cpu string
+}
+
+func (f *fixedPodUtilisation) GetPodUtilisation(pod *v1.Pod) *domain.UtilisationData {
+	data := domain.EmptyUtilisationData()
+	if f.cpu != "" {
+		data.CurrentUsage = common.ComputeResources{"cpu": resource.MustParse(f.cpu)}
+	}
+	return data
+}
+
+type logClusterContext struct {
+	context.ClusterContext
+	lastLogTime *time.Time
+	calls       int
+}
+
+func (c *logClusterContext) GetPodLastLogTime(pod *v1.Pod, containerName string) (*time.Time, error) {
+	c.calls++
+	return c.lastLogTime, nil
+}
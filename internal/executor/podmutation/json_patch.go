@@ -0,0 +1,77 @@
+package podmutation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	config "github.com/G-Research/armada/internal/executor/configuration/podmutation"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type jsonPatchMutator struct {
+	name     string
+	queues   map[string]bool
+	selector labels.Selector
+	patch    jsonpatch.Patch
+}
+
+func newJsonPatchMutator(mutationConfig config.Mutation) (*jsonPatchMutator, error) {
+	if mutationConfig.Name == "" {
+		return nil, fmt.Errorf("pod mutation must have a name")
+	}
+
+	selector, err := labels.Parse(mutationConfig.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse label selector \"%s\" of pod mutation %s: %s", mutationConfig.LabelSelector, mutationConfig.Name, err)
+	}
+
+	patch, err := jsonpatch.DecodePatch([]byte(mutationConfig.Patch))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse patch of pod mutation %s: %s", mutationConfig.Name, err)
+	}
+
+	queues := map[string]bool{}
+	for _, queue := range mutationConfig.Queues {
+		queues[queue] = true
+	}
+
+	return &jsonPatchMutator{
+		name:     mutationConfig.Name,
+		queues:   queues,
+		selector: selector,
+		patch:    patch,
+	}, nil
+}
+
+func (m *jsonPatchMutator) Name() string {
+	return m.name
+}
+
+func (m *jsonPatchMutator) Mutate(pod *v1.Pod, job *api.Job) (bool, error) {
+	if len(m.queues) > 0 && !m.queues[job.Queue] {
+		return false, nil
+	}
+	if !m.selector.Matches(labels.Set(pod.Labels)) {
+		return false, nil
+	}
+
+	podJson, err := json.Marshal(pod)
+	if err != nil {
+		return false, err
+	}
+	patchedJson, err := m.patch.Apply(podJson)
+	if err != nil {
+		return false, err
+	}
+	patched := &v1.Pod{}
+	err = json.Unmarshal(patchedJson, patched)
+	if err != nil {
+		return false, err
+	}
+	*pod = *patched
+	return true, nil
+}
@@ -0,0 +1,104 @@
+package podmutation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/G-Research/armada/internal/common/util"
+	config "github.com/G-Research/armada/internal/executor/configuration/podmutation"
+	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// PodMutator changes a pod of a job before it is submitted to the cluster.
+type PodMutator interface {
+	Name() string
+	// Mutate changes the pod in place and returns whether the mutation applied to it.
+	Mutate(pod *v1.Pod, job *api.Job) (bool, error)
+}
+
+// MutationError is returned when a pod mutation can't be applied to a pod. Applying it again fails the same way.
+type MutationError struct {
+	Message string
+}
+
+func (e *MutationError) Error() string {
+	return e.Message
+}
+
+func IsMutationError(err error) bool {
+	_, ok := err.(*MutationError)
+	return ok
+}
+
+// Pipeline applies mutators to pods in order and records the applied mutations in the AppliedPodMutations annotation.
+type Pipeline struct {
+	mutators []PodMutator
+}
+
+func NewPipeline(mutators ...PodMutator) *Pipeline {
+	return &Pipeline{mutators: mutators}
+}
+
+// NewPipelineFromConfig creates a pipeline applying the configured mutations followed by any additional mutators.
+func NewPipelineFromConfig(configs []config.Mutation, mutators ...PodMutator) (*Pipeline, error) {
+	log.Info("Creating pod mutations...")
+	pipelineMutators := make([]PodMutator, 0, len(configs)+len(mutators))
+	for _, mutationConfig := range configs {
+		mutator, err := newJsonPatchMutator(mutationConfig)
+		if err != nil {
+			return nil, err
+		}
+		pipelineMutators = append(pipelineMutators, mutator)
+		log.Infof("   Created pod mutation %s", mutator.Name())
+	}
+	return NewPipeline(append(pipelineMutators, mutators...)...), nil
+}
+
+func (p *Pipeline) Apply(pod *v1.Pod, job *api.Job) error {
+	applied := []string{}
+	for _, mutator := range p.mutators {
+		original := pod.DeepCopy()
+		mutated, err := mutator.Mutate(pod, job)
+		if err != nil {
+			return &MutationError{Message: fmt.Sprintf("pod mutation %s failed: %s", mutator.Name(), err)}
+		}
+		if err := checkArmadaMetadataUnchanged(original, pod); err != nil {
+			return &MutationError{Message: fmt.Sprintf("pod mutation %s is invalid: %s", mutator.Name(), err)}
+		}
+		if mutated {
+			applied = append(applied, mutator.Name())
+		}
+	}
+	if len(applied) > 0 {
+		pod.Annotations = util.MergeMaps(pod.Annotations, map[string]string{
+			domain.AppliedPodMutations: strings.Join(applied, ","),
+		})
+	}
+	return nil
+}
+
+// checkArmadaMetadataUnchanged makes sure mutations don't change the metadata armada uses to track the pod.
+func checkArmadaMetadataUnchanged(original *v1.Pod, mutated *v1.Pod) error {
+	if original.Name != mutated.Name || original.Namespace != mutated.Namespace {
+		return fmt.Errorf("name or namespace of the pod changed")
+	}
+	for _, label := range []string{domain.JobId, domain.Queue, domain.PodNumber, domain.PodCount} {
+		if original.Labels[label] != mutated.Labels[label] {
+			return fmt.Errorf("label %s changed", label)
+		}
+	}
+	for _, annotation := range []string{domain.JobSetId, domain.Owner} {
+		if original.Annotations[annotation] != mutated.Annotations[annotation] {
+			return fmt.Errorf("annotation %s changed", annotation)
+		}
+	}
+	if !reflect.DeepEqual(original.OwnerReferences, mutated.OwnerReferences) {
+		return fmt.Errorf("owner references changed")
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package podmutation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/G-Research/armada/internal/executor/configuration/podmutation"
+	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestPipeline_AppliesMutationsInOrder(t *testing.T) {
+	pipeline, err := NewPipelineFromConfig([]config.Mutation{
+		{Name: "priority", Patch: `[{"op": "add", "path": "/spec/priorityClassName", "value": "low"}]`},
+		{Name: "override", Patch: `[{"op": "replace", "path": "/spec/priorityClassName", "value": "high"}]`},
+	})
+	assert.NoError(t, err)
+
+	pod := makePod("test")
+	err = pipeline.Apply(pod, &api.Job{Queue: "test"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "high", pod.Spec.PriorityClassName)
+	assert.Equal(t, "priority,override", pod.Annotations[domain.AppliedPodMutations])
+}
+
+func TestPipeline_AppliesOnlyMatchingMutations(t *testing.T) {
+	pipeline, err := NewPipelineFromConfig([]config.Mutation{
+		{Name: "other-queue", Queues: []string{"other"}, Patch: `[{"op": "add", "path": "/spec/priorityClassName", "value": "other"}]`},
+		{Name: "other-team", LabelSelector: "team=other", Patch: `[{"op": "add", "path": "/spec/priorityClassName", "value": "other"}]`},
+		{Name: "ml", Queues: []string{"test"}, LabelSelector: "team=ml", Patch: `[{"op": "add", "path": "/spec/nodeSelector", "value": {"gpu": "true"}}]`},
+	})
+	assert.NoError(t, err)
+
+	pod := makePod("test")
+	pod.Labels["team"] = "ml"
+	err = pipeline.Apply(pod, &api.Job{Queue: "test"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", pod.Spec.PriorityClassName)
+	assert.Equal(t, map[string]string{"gpu": "true"}, pod.Spec.NodeSelector)
+	assert.Equal(t, "ml", pod.Annotations[domain.AppliedPodMutations])
+}
+
+func TestPipeline_NoAnnotationWhenNothingApplied(t *testing.T) {
+	pipeline, err := NewPipelineFromConfig([]config.Mutation{
+		{Name: "other-queue", Queues: []string{"other"}, Patch: `[{"op": "add", "path": "/spec/priorityClassName", "value": "other"}]`},
+	})
+	assert.NoError(t, err)
+
+	pod := makePod("test")
+	err = pipeline.Apply(pod, &api.Job{Queue: "test"})
+	assert.NoError(t, err)
+
+	_, exists := pod.Annotations[domain.AppliedPodMutations]
+	assert.False(t, exists)
+}
+
+func TestPipeline_AppliesCustomMutators(t *testing.T) {
+	pipeline, err := NewPipelineFromConfig(
+		[]config.Mutation{{Name: "priority", Patch: `[{"op": "add", "path": "/spec/priorityClassName", "value": "low"}]`}},
+		&registryMutator{registry: "mirror.example.com/"})
+	assert.NoError(t, err)
+
+	pod := makePod("test")
+	err = pipeline.Apply(pod, &api.Job{Queue: "test"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "mirror.example.com/busybox", pod.Spec.Containers[0].Image)
+	assert.Equal(t, "priority,registry", pod.Annotations[domain.AppliedPodMutations])
+}
+
+func TestPipeline_FailsWhenPatchFails(t *testing.T) {
+	pipeline, err := NewPipelineFromConfig([]config.Mutation{
+		{Name: "test", Patch: `[{"op": "test", "path": "/spec/schedulerName", "value": "other"}]`},
+	})
+	assert.NoError(t, err)
+
+	err = pipeline.Apply(makePod("test"), &api.Job{Queue: "test"})
+	assert.True(t, IsMutationError(err), "expected mutation error, got %v", err)
+}
+
+func TestPipeline_FailsWhenArmadaMetadataChanged(t *testing.T) {
+	pipeline, err := NewPipelineFromConfig([]config.Mutation{
+		{Name: "job-id", Patch: `[{"op": "replace", "path": "/metadata/labels/armada_job_id", "value": "other"}]`},
+	})
+	assert.NoError(t, err)
+
+	err = pipeline.Apply(makePod("test"), &api.Job{Queue: "test"})
+	assert.True(t, IsMutationError(err), "expected mutation error, got %v", err)
+}
+
+func TestNewPipelineFromConfig_InvalidConfig(t *testing.T) {
+	_, err := NewPipelineFromConfig([]config.Mutation{{Patch: `[]`}})
+	assert.Error(t, err)
+
+	_, err = NewPipelineFromConfig([]config.Mutation{{Name: "selector", LabelSelector: "a in b", Patch: `[]`}})
+	assert.Error(t, err)
+
+	_, err = NewPipelineFromConfig([]config.Mutation{{Name: "patch", Patch: `{"op": "add"}`}})
+	assert.Error(t, err)
+}
+
+type registryMutator struct {
+	registry string
+}
+
+func (m *registryMutator) Name() string {
+	return "registry"
+}
+
+func (m *registryMutator) Mutate(pod *v1.Pod, job *api.Job) (bool, error) {
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Image = m.registry + pod.Spec.Containers[i].Image
+	}
+	return true, nil
+}
+
+func makePod(queue string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "armada-job-1-0",
+			Namespace:   "default",
+			Labels:      map[string]string{domain.JobId: "job-1", domain.Queue: queue},
+			Annotations: map[string]string{domain.JobSetId: "job-set"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "main", Image: "busybox"}},
+		},
+	}
+}
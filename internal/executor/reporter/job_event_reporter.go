@@ -1,6 +1,7 @@
 package reporter
 
 import (
+	"strings"
 	"sync"
 	"time"
 
@@ -35,17 +36,26 @@ type JobEventReporter struct {
 
 	clusterContext clusterContext.ClusterContext
 	stop           chan bool
+
+	failedContainerLogLines int64
+	failedContainerLogBytes int64
 }
 
-func NewJobEventReporter(clusterContext clusterContext.ClusterContext, eventClient api.EventClient) (*JobEventReporter, chan bool) {
+func NewJobEventReporter(
+	clusterContext clusterContext.ClusterContext,
+	eventClient api.EventClient,
+	failedContainerLogLines int64,
+	failedContainerLogBytes int64) (*JobEventReporter, chan bool) {
 
 	stop := make(chan bool)
 	reporter := &JobEventReporter{
-		eventClient:      eventClient,
-		clusterContext:   clusterContext,
-		eventBuffer:      make(chan *queuedEvent, 1000000),
-		eventQueued:      map[string]uint8{},
-		eventQueuedMutex: sync.Mutex{}}
+		eventClient:             eventClient,
+		clusterContext:          clusterContext,
+		eventBuffer:             make(chan *queuedEvent, 1000000),
+		eventQueued:             map[string]uint8{},
+		eventQueuedMutex:        sync.Mutex{},
+		failedContainerLogLines: failedContainerLogLines,
+		failedContainerLogBytes: failedContainerLogBytes}
 
 	clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
@@ -98,6 +108,10 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 		return
 	}
 
+	if failedEvent, ok := event.(*api.JobFailedEvent); ok {
+		eventReporter.attachFailedContainerLogs(pod, failedEvent)
+	}
+
 	eventReporter.QueueEvent(event, func(err error) {
 		if err != nil {
 			log.Errorf("Failed to report event because %s", err)
@@ -118,6 +132,37 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 	}
 }
 
+// attachFailedContainerLogs adds the end of the log of each failed container to the event,
+// so it is still available after the pod has been cleaned up.
+func (eventReporter *JobEventReporter) attachFailedContainerLogs(pod *v1.Pod, event *api.JobFailedEvent) {
+	if eventReporter.failedContainerLogLines <= 0 {
+		return
+	}
+	for _, containerStatus := range event.ContainerStatuses {
+		if containerStatus.ExitCode == 0 {
+			continue
+		}
+		logs, err := eventReporter.clusterContext.GetPodLogs(pod, containerStatus.Name, eventReporter.failedContainerLogLines)
+		if err != nil {
+			log.Warnf("Failed to get logs of container %s in pod %s because %s", containerStatus.Name, pod.Name, err)
+			continue
+		}
+		containerStatus.LogExcerpt = truncateLogExcerpt(logs, eventReporter.failedContainerLogBytes)
+	}
+}
+
+// truncateLogExcerpt keeps the last limitBytes bytes of the logs, starting at a line boundary where possible.
+func truncateLogExcerpt(logs string, limitBytes int64) string {
+	if limitBytes <= 0 || int64(len(logs)) <= limitBytes {
+		return logs
+	}
+	excerpt := logs[int64(len(logs))-limitBytes:]
+	if newLine := strings.Index(excerpt, "\n"); newLine >= 0 && newLine < len(excerpt)-1 {
+		excerpt = excerpt[newLine+1:]
+	}
+	return strings.ToValidUTF8(excerpt, "")
+}
+
 func (eventReporter *JobEventReporter) QueueEvent(event api.Event, callback func(error)) {
 	eventReporter.eventQueuedMutex.Lock()
 	defer eventReporter.eventQueuedMutex.Unlock()
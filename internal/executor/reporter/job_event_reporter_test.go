@@ -8,7 +8,9 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	clusterContext "github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 func TestRequiresIngressToBeReported_FalseWhenIngressHasBeenReported(t *testing.T) {
@@ -36,3 +38,50 @@ func TestRequiresIngressToBeReported_TrueWhenHasIngressButNotIngressReportedAnno
 	}
 	assert.True(t, requiresIngressToBeReported(pod))
 }
+
+func TestAttachFailedContainerLogs_AddsLogsOfFailedContainers(t *testing.T) {
+	logs := map[string]string{"failed": "line 1\nline 2\n", "succeeded": "done\n"}
+	reporter := &JobEventReporter{
+		clusterContext:          &logsClusterContext{logs: logs},
+		failedContainerLogLines: 10,
+		failedContainerLogBytes: 1024,
+	}
+	event := &api.JobFailedEvent{
+		ContainerStatuses: []*api.ContainerStatus{
+			{Name: "failed", ExitCode: 1},
+			{Name: "succeeded", ExitCode: 0},
+		},
+	}
+
+	reporter.attachFailedContainerLogs(&v1.Pod{}, event)
+
+	assert.Equal(t, "line 1\nline 2\n", event.ContainerStatuses[0].LogExcerpt)
+	assert.Equal(t, "", event.ContainerStatuses[1].LogExcerpt)
+}
+
+func TestAttachFailedContainerLogs_DisabledWhenNoLines(t *testing.T) {
+	reporter := &JobEventReporter{
+		clusterContext: &logsClusterContext{logs: map[string]string{"failed": "line 1\n"}},
+	}
+	event := &api.JobFailedEvent{ContainerStatuses: []*api.ContainerStatus{{Name: "failed", ExitCode: 1}}}
+
+	reporter.attachFailedContainerLogs(&v1.Pod{}, event)
+
+	assert.Equal(t, "", event.ContainerStatuses[0].LogExcerpt)
+}
+
+func TestTruncateLogExcerpt(t *testing.T) {
+	assert.Equal(t, "line 1\nline 2\n", truncateLogExcerpt("line 1\nline 2\n", 0))
+	assert.Equal(t, "line 1\nline 2\n", truncateLogExcerpt("line 1\nline 2\n", 100))
+	assert.Equal(t, "line 2\n", truncateLogExcerpt("line 1\nline 2\n", 10))
+	assert.Equal(t, "ne 2", truncateLogExcerpt("line 1\nline 2", 4))
+}
+
+type logsClusterContext struct {
+	clusterContext.ClusterContext
+	logs map[string]string
+}
+
+func (c *logsClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	return c.logs[containerName], nil
+}
@@ -2,16 +2,19 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/G-Research/armada/internal/executor/context"
+	"github.com/G-Research/armada/internal/executor/drain"
 	"github.com/G-Research/armada/internal/executor/job"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	"github.com/G-Research/armada/internal/executor/util"
 	"github.com/G-Research/armada/internal/executor/utilisation"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 type ClusterAllocationService struct {
@@ -20,6 +23,8 @@ type ClusterAllocationService struct {
 	utilisationService utilisation.UtilisationService
 	clusterContext     context.ClusterContext
 	submitter          job.Submitter
+	drainState         *drain.State
+	drainDeadline      time.Duration
 }
 
 func NewClusterAllocationService(
@@ -27,14 +32,18 @@ func NewClusterAllocationService(
 	eventReporter reporter.EventReporter,
 	leaseService LeaseService,
 	utilisationService utilisation.UtilisationService,
-	submitter job.Submitter) *ClusterAllocationService {
+	submitter job.Submitter,
+	drainState *drain.State,
+	drainDeadline time.Duration) *ClusterAllocationService {
 
 	return &ClusterAllocationService{
 		leaseService:       leaseService,
 		eventReporter:      eventReporter,
 		utilisationService: utilisationService,
 		clusterContext:     clusterContext,
-		submitter:          submitter}
+		submitter:          submitter,
+		drainState:         drainState,
+		drainDeadline:      drainDeadline}
 }
 
 func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity() {
@@ -51,17 +60,30 @@ func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity(
 		return
 	}
 	leasedJobs = util.FilterPods(leasedJobs, shouldBeRenewed)
-	newJobs, err := allocationService.leaseService.RequestJobLeases(capacityReport.AvailableCapacity, capacityReport.Nodes, utilisation.GetAllocationByQueue(leasedJobs))
 
-	cpu := (*capacityReport.AvailableCapacity)["cpu"]
-	memory := (*capacityReport.AvailableCapacity)["memory"]
-	log.Infof("Requesting new jobs with free resource cpu: %d, memory %d. Received %d new jobs. ", cpu.AsDec(), memory.Value(), len(newJobs))
+	if allocationService.drainState.IsDraining() {
+		log.Info("Cluster is draining, not requesting new jobs")
+		lease, err := allocationService.leaseService.ReportDraining(capacityReport.Nodes, utilisation.GetAllocationByQueue(leasedJobs))
+		if err != nil {
+			log.Errorf("Failed to report cluster draining because %s", err)
+			return
+		}
+		allocationService.updateDrainedOnServer(lease)
+		return
+	}
+
+	lease, err := allocationService.leaseService.RequestJobLeases(capacityReport.AvailableCapacity, capacityReport.Nodes, utilisation.GetAllocationByQueue(leasedJobs))
 
 	if err != nil {
 		log.Errorf("Failed to lease new jobs because %s", err)
 		return
 	} else {
-		failedJobs := allocationService.submitter.SubmitJobs(newJobs)
+		cpu := (*capacityReport.AvailableCapacity)["cpu"]
+		memory := (*capacityReport.AvailableCapacity)["memory"]
+		log.Infof("Requesting new jobs with free resource cpu: %d, memory %d. Received %d new jobs. ", cpu.AsDec(), memory.Value(), len(lease.Job))
+
+		allocationService.updateDrainedOnServer(lease)
+		failedJobs := allocationService.submitter.SubmitJobs(lease.Job)
 
 		err := allocationService.processFailedJobs(failedJobs)
 		if err != nil {
@@ -70,6 +92,17 @@ func (allocationService *ClusterAllocationService) AllocateSpareClusterCapacity(
 	}
 }
 
+func (allocationService *ClusterAllocationService) updateDrainedOnServer(lease *api.JobLease) {
+	wasDraining := allocationService.drainState.IsDraining()
+	allocationService.drainState.SetDrainedOnServer(lease.Draining, time.Now().Add(allocationService.drainDeadline))
+	isDraining := allocationService.drainState.IsDraining()
+	if !wasDraining && isDraining {
+		log.Infof("Cluster was drained on the server, draining with a deadline of %s", allocationService.drainDeadline)
+	} else if wasDraining && !isDraining {
+		log.Info("Cluster is no longer drained on the server, stopped draining")
+	}
+}
+
 func (allocationService *ClusterAllocationService) processFailedJobs(failedSubmissions []*job.FailedSubmissionDetails) error {
 	toBeReportedDone := make([]string, 0, 10)
 
@@ -15,6 +15,7 @@ import (
 type MockLeaseService struct {
 	ReturnLeaseCalls      int
 	RequestJobLeasesCalls int
+	ReportDrainingCalls   int
 	ReportDoneCalls       int
 
 	ReturnLeaseArg *v1.Pod
@@ -22,7 +23,7 @@ type MockLeaseService struct {
 }
 
 func NewMockLeaseService() *MockLeaseService {
-	return &MockLeaseService{0, 0, 0, nil, nil}
+	return &MockLeaseService{0, 0, 0, 0, nil, nil}
 }
 
 func (ls *MockLeaseService) RenewJobLeases(jobs []*job.RunningJob) ([]*job.RunningJob, error) {
@@ -35,9 +36,14 @@ func (ls *MockLeaseService) ReturnLease(pod *v1.Pod) error {
 	return nil
 }
 
-func (ls *MockLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error) {
+func (ls *MockLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) (*api.JobLease, error) {
 	ls.RequestJobLeasesCalls++
-	return make([]*api.Job, 0), nil
+	return &api.JobLease{Job: make([]*api.Job, 0)}, nil
+}
+
+func (ls *MockLeaseService) ReportDraining(nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) (*api.JobLease, error) {
+	ls.ReportDrainingCalls++
+	return &api.JobLease{}, nil
 }
 
 func (ls *MockLeaseService) ReportDone(jobIds []string) error {
@@ -3,6 +3,7 @@ package fake
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1beta1"
@@ -53,6 +54,14 @@ func (c *SyncFakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error)
 	return []*v1.Event{}, nil
 }
 
+func (c *SyncFakeClusterContext) GetPodLogs(pod *v1.Pod, containerName string, tailLines int64) (string, error) {
+	return "", nil
+}
+
+func (c *SyncFakeClusterContext) GetPodLastLogTime(pod *v1.Pod, containerName string) (*time.Time, error) {
+	return nil, nil
+}
+
 func (c *SyncFakeClusterContext) SubmitService(service *v1.Service) (*v1.Service, error) {
 	return nil, fmt.Errorf("Services not implemented in SyncFakeClusterContext")
 }
@@ -22,7 +22,8 @@ const maxPodRequestSize = 10000
 
 type LeaseService interface {
 	ReturnLease(pod *v1.Pod) error
-	RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error)
+	RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) (*api.JobLease, error)
+	ReportDraining(nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) (*api.JobLease, error)
 	RenewJobLeases(jobs []*job.RunningJob) ([]*job.RunningJob, error)
 	ReportDone(jobIds []string) error
 }
@@ -48,7 +49,33 @@ func NewJobLeaseService(
 	}
 }
 
-func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) ([]*api.Job, error) {
+// RequestJobLeases leases jobs for the available resources. The lease is marked as draining without any jobs
+// if the cluster was drained on the server.
+func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) (*api.JobLease, error) {
+	leaseRequest := jobLeaseService.createLeaseRequest(*availableResource, nodes, leasedResourceByQueue)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	response, err := jobLeaseService.queueClient.LeaseJobs(ctx, leaseRequest, grpc_retry.WithMax(1))
+
+	if err != nil {
+		return &api.JobLease{}, err
+	}
+
+	return response, nil
+}
+
+// ReportDraining tells the server the cluster is draining, so it keeps track of the cluster without leasing it any jobs.
+// The returned lease is marked as draining if the cluster was drained on the server.
+func (jobLeaseService *JobLeaseService) ReportDraining(nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) (*api.JobLease, error) {
+	leaseRequest := jobLeaseService.createLeaseRequest(common.ComputeResources{}, nodes, leasedResourceByQueue)
+	leaseRequest.Draining = true
+
+	ctx, cancel := common.ContextWithDefaultTimeout()
+	defer cancel()
+	return jobLeaseService.queueClient.LeaseJobs(ctx, leaseRequest, grpc_retry.WithMax(1))
+}
+
+func (jobLeaseService *JobLeaseService) createLeaseRequest(availableResource common.ComputeResources, nodes []api.NodeInfo, leasedResourceByQueue map[string]common.ComputeResources) *api.LeaseRequest {
 	leasedQueueReports := make([]*api.QueueLeasedReport, 0, len(leasedResourceByQueue))
 	for queueName, leasedResource := range leasedResourceByQueue {
 		leasedQueueReport := &api.QueueLeasedReport{
@@ -63,23 +90,14 @@ func (jobLeaseService *JobLeaseService) RequestJobLeases(availableResource *comm
 		Queues:     leasedQueueReports,
 	}
 
-	leaseRequest := api.LeaseRequest{
+	return &api.LeaseRequest{
 		ClusterId:           jobLeaseService.clusterContext.GetClusterId(),
 		Pool:                jobLeaseService.clusterContext.GetClusterPool(),
-		Resources:           *availableResource,
+		Resources:           availableResource,
 		ClusterLeasedReport: clusterLeasedReport,
 		Nodes:               nodes,
 		MinimumJobSize:      jobLeaseService.minimumJobSize,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-	response, err := jobLeaseService.queueClient.LeaseJobs(ctx, &leaseRequest, grpc_retry.WithMax(1))
-
-	if err != nil {
-		return make([]*api.Job, 0), err
-	}
-
-	return response.Job, nil
 }
 
 func (jobLeaseService *JobLeaseService) ReturnLease(pod *v1.Pod) error {
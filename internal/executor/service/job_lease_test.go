@@ -15,7 +15,7 @@ func TestJobLease_GetAvoidNodeLabels_EverythingSetUpCorrectly_ReturnsLabels(t *t
 
 	avoidNodeLabels := []string{"a", "c"}
 
-	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "node1"}}
+	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "test-node1-0"}}
 
 	var testAppConfig = configuration.ApplicationConfiguration{ClusterId: "test", Pool: "pool"}
 
@@ -52,7 +52,7 @@ func TestJobLease_GetAvoidNodeLabels_NoMatchingLabels_ReturnsError(t *testing.T)
 
 	avoidNodeLabels := []string{"a"}
 
-	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "node1"}}
+	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "test-node1-0"}}
 
 	var testAppConfig = configuration.ApplicationConfiguration{ClusterId: "test", Pool: "pool"}
 
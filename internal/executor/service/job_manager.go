@@ -12,6 +12,7 @@ import (
 	"github.com/G-Research/armada/internal/executor/job"
 	"github.com/G-Research/armada/internal/executor/reporter"
 	"github.com/G-Research/armada/internal/executor/util"
+	"github.com/G-Research/armada/pkg/api"
 )
 
 type JobManager struct {
@@ -221,7 +222,7 @@ func (m *JobManager) onStuckPodDeleted(job *job.RunningJob) (resolved bool) {
 			if pod.UID != job.Issue.OriginatingPod.UID {
 				message = fmt.Sprintf("Peer pod %d stuck.", util.ExtractPodNumber(job.Issue.OriginatingPod))
 			}
-			event := reporter.CreateSimpleJobFailedEvent(pod, message, m.clusterIdentity.GetClusterId())
+			event := createIssueFailedEvent(job.Issue, pod, message, m.clusterIdentity.GetClusterId())
 
 			err := m.eventReporter.Report(event)
 			if err != nil {
@@ -231,3 +232,10 @@ func (m *JobManager) onStuckPodDeleted(job *job.RunningJob) (resolved bool) {
 	}
 	return true
 }
+
+func createIssueFailedEvent(issue *job.PodIssue, pod *v1.Pod, message string, clusterId string) api.Event {
+	if issue.Type == job.Unhealthy {
+		return reporter.CreateJobFailedEvent(pod, message, api.Cause_Unhealthy, []*api.ContainerStatus{}, map[string]int32{}, clusterId)
+	}
+	return reporter.CreateSimpleJobFailedEvent(pod, message, clusterId)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +15,7 @@ import (
 	podchecksConfig "github.com/G-Research/armada/internal/executor/configuration/podchecks"
 	"github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/internal/executor/drain"
 	"github.com/G-Research/armada/internal/executor/job"
 	"github.com/G-Research/armada/internal/executor/podchecks"
 	"github.com/G-Research/armada/internal/executor/service/fake"
@@ -94,6 +96,29 @@ func TestJobManager_DeletesPodAndReportsFailedIfStuckTerminating(t *testing.T) {
 	assert.Contains(t, failedEvent.Reason, "terminating")
 }
 
+func TestJobManager_DeletesPodAndReportsFailedIfRunningPodUnhealthy(t *testing.T) {
+	restartingPod := makeRestartingPod()
+
+	fakeClusterContext, mockLeaseService, eventsReporter, jobManager := makejobManagerWithTestDoubles()
+
+	addPod(t, fakeClusterContext, restartingPod)
+
+	jobManager.ManageJobLeases()
+
+	remainingActivePods := getActivePods(t, fakeClusterContext)
+	assert.Equal(t, []*v1.Pod{}, remainingActivePods)
+
+	assert.Zero(t, mockLeaseService.ReturnLeaseCalls)
+	mockLeaseService.AssertReportDoneCalledOnceWith(t, []string{restartingPod.Labels[domain.JobId]})
+
+	jobManager.ManageJobLeases()
+
+	failedEvent, ok := eventsReporter.ReceivedEvents[0].(*api.JobFailedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, api.Cause_Unhealthy, failedEvent.Cause)
+	assert.Contains(t, failedEvent.Reason, "unhealthy")
+}
+
 func TestJobManager_ReturnsLeaseAndDeletesRetryableStuckPod(t *testing.T) {
 	retryableStuckPod := makeRetryableStuckPod()
 
@@ -125,6 +150,32 @@ func TestJobManager_ReturnsLeaseAndDeletesRetryableStuckPod(t *testing.T) {
 	assert.Equal(t, retryableStuckPod, mockLeaseService.ReturnLeaseArg)
 }
 
+func TestJobManager_ReturnsLeaseOfRunningPodAfterDrainDeadline(t *testing.T) {
+	runningPod := makeRunningPod()
+
+	drainState := drain.NewState(prometheus.NewRegistry())
+	fakeClusterContext, mockLeaseService, eventsReporter, jobManager := makejobManagerWithDrainState(drainState)
+
+	addPod(t, fakeClusterContext, runningPod)
+
+	drainState.Start(time.Now().Add(time.Hour))
+	jobManager.ManageJobLeases()
+	assert.Equal(t, []*v1.Pod{runningPod}, getActivePods(t, fakeClusterContext))
+
+	drainState.Start(time.Now().Add(-time.Second))
+	jobManager.ManageJobLeases()
+	assert.Equal(t, []*v1.Pod{}, getActivePods(t, fakeClusterContext))
+	assert.Zero(t, mockLeaseService.ReturnLeaseCalls)
+
+	jobManager.ManageJobLeases()
+
+	assert.Equal(t, 1, mockLeaseService.ReturnLeaseCalls)
+	assert.Equal(t, runningPod, mockLeaseService.ReturnLeaseArg)
+	leaseReturnedEvent, ok := eventsReporter.ReceivedEvents[0].(*api.JobLeaseReturnedEvent)
+	assert.True(t, ok)
+	assert.Contains(t, leaseReturnedEvent.Reason, "drain deadline")
+}
+
 func getActivePods(t *testing.T, clusterContext context.ClusterContext) []*v1.Pod {
 	t.Helper()
 	remainingActivePods, err := clusterContext.GetActiveBatchPods()
@@ -145,6 +196,18 @@ func makeTerminatingPod() *v1.Pod {
 	return pod
 }
 
+func makeRestartingPod() *v1.Pod {
+	return makeTestPod(v1.PodStatus{
+		Phase: "Running",
+		ContainerStatuses: []v1.ContainerStatus{
+			{
+				Name:         "container",
+				RestartCount: 5,
+			},
+		},
+	})
+}
+
 func makeUnretryableStuckPod() *v1.Pod {
 	return makeTestPod(v1.PodStatus{
 		Phase: "Pending",
@@ -203,10 +266,14 @@ func addPod(t *testing.T, fakeClusterContext context.ClusterContext, runningPod
 }
 
 func makejobManagerWithTestDoubles() (context.ClusterContext, *fake.MockLeaseService, *reporter_fake.FakeEventReporter, *JobManager) {
+	return makejobManagerWithDrainState(drain.NewState(prometheus.NewRegistry()))
+}
+
+func makejobManagerWithDrainState(drainState *drain.State) (context.ClusterContext, *fake.MockLeaseService, *reporter_fake.FakeEventReporter, *JobManager) {
 	fakeClusterContext := fake.NewSyncFakeClusterContext()
 	mockLeaseService := fake.NewMockLeaseService()
 	eventReporter := reporter_fake.NewFakeEventReporter()
-	jobContext := job.NewClusterJobContext(fakeClusterContext, makePodChecker(), time.Minute*3, 1)
+	jobContext := job.NewClusterJobContext(fakeClusterContext, makePodChecker(), makeRunningPodChecker(fakeClusterContext), drainState, time.Minute*3, 1)
 
 	jobManager := NewJobManager(
 		fakeClusterContext,
@@ -237,3 +304,22 @@ func makePodChecker() podchecks.PodChecker {
 
 	return checker
 }
+
+func makeRunningPodChecker(clusterContext context.ClusterContext) podchecks.RunningPodChecker {
+	cfg := podchecksConfig.RunningChecks{
+		RestartLoop: &podchecksConfig.RestartLoopCheck{MaxRestarts: 3, Action: podchecksConfig.ActionFail},
+	}
+
+	checker, err := podchecks.NewRunningPodChecks(cfg, clusterContext, &emptyPodUtilisationService{})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to make running pod checker: %v", err))
+	}
+
+	return checker
+}
+
+type emptyPodUtilisationService struct{}
+
+func (s *emptyPodUtilisationService) GetPodUtilisation(pod *v1.Pod) *domain.UtilisationData {
+	return domain.EmptyUtilisationData()
+}
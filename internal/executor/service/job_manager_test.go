@@ -4,12 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/domain"
+	"github.com/G-Research/armada/internal/executor/drain"
 	context2 "github.com/G-Research/armada/internal/executor/fake/context"
 	"github.com/G-Research/armada/internal/executor/job"
 	"github.com/G-Research/armada/internal/executor/podchecks"
@@ -84,7 +86,7 @@ func makePodWithCurrentStateReported(state v1.PodPhase, reportedDone bool) *v1.P
 func createManager(minimumPodAge, failedPodExpiry time.Duration) *JobManager {
 	fakeClusterContext := context2.NewFakeClusterContext(configuration.ApplicationConfiguration{ClusterId: "test", Pool: "pool"}, nil)
 	fakeEventReporter := &reporter_fake.FakeEventReporter{}
-	jobContext := job.NewClusterJobContext(fakeClusterContext, &mockPodChecks{}, time.Minute*3, 1)
+	jobContext := job.NewClusterJobContext(fakeClusterContext, &mockPodChecks{}, &mockRunningPodChecks{}, drain.NewState(prometheus.NewRegistry()), time.Minute*3, 1)
 
 	jobLeaseService := fake.NewMockLeaseService()
 
@@ -100,6 +102,16 @@ func createManager(minimumPodAge, failedPodExpiry time.Duration) *JobManager {
 type mockPodChecks struct {
 }
 
-func (pc *mockPodChecks) GetAction(pod *v1.Pod, podEvents []*v1.Event, timeInState time.Duration) (podchecks.Action, string) {
+func (pc *mockPodChecks) GetAction(pod *v1.Pod, node *v1.Node, podEvents []*v1.Event, timeInState time.Duration) (podchecks.Action, string) {
 	return podchecks.ActionWait, ""
 }
+
+type mockRunningPodChecks struct {
+}
+
+func (pc *mockRunningPodChecks) GetAction(pod *v1.Pod) (podchecks.Action, string) {
+	return podchecks.ActionWait, ""
+}
+
+func (pc *mockRunningPodChecks) RemoveInactivePods(activePods []*v1.Pod) {
+}
@@ -34,11 +34,15 @@ type mapPodCache struct {
 }
 
 func NewTimeExpiringPodCache(expiry time.Duration, cleanUpInterval time.Duration, metricName string) PodCache {
+	return NewTimeExpiringPodCacheWithRegisterer(expiry, cleanUpInterval, metricName, prometheus.DefaultRegisterer)
+}
+
+func NewTimeExpiringPodCacheWithRegisterer(expiry time.Duration, cleanUpInterval time.Duration, metricName string, registerer prometheus.Registerer) PodCache {
 	cache := &mapPodCache{
 		records:       map[string]cacheRecord{},
 		rwLock:        sync.RWMutex{},
 		defaultExpiry: expiry,
-		sizeGauge: promauto.NewGauge(
+		sizeGauge: promauto.With(registerer).NewGauge(
 			prometheus.GaugeOpts{
 				Name: metrics.ArmadaExecutorMetricsPrefix + metricName + "_cache_size",
 				Help: "Number of pods in the pod cache",
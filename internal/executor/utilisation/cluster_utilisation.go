@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/G-Research/armada/internal/common"
+	commonUtil "github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/executor/context"
 	"github.com/G-Research/armada/internal/executor/domain"
 	"github.com/G-Research/armada/internal/executor/node"
@@ -27,6 +28,7 @@ type ClusterUtilisationService struct {
 	nodeInfoService         node.NodeInfoService
 	usageClient             api.UsageClient
 	trackedNodeLabels       []string
+	reportCachedImages      bool
 }
 
 func NewClusterUtilisationService(
@@ -34,7 +36,8 @@ func NewClusterUtilisationService(
 	queueUtilisationService PodUtilisationService,
 	nodeInfoService node.NodeInfoService,
 	usageClient api.UsageClient,
-	trackedNodeLabels []string) *ClusterUtilisationService {
+	trackedNodeLabels []string,
+	reportCachedImages bool) *ClusterUtilisationService {
 
 	return &ClusterUtilisationService{
 		clusterContext:          clusterContext,
@@ -42,6 +45,7 @@ func NewClusterUtilisationService(
 		nodeInfoService:         nodeInfoService,
 		usageClient:             usageClient,
 		trackedNodeLabels:       trackedNodeLabels,
+		reportCachedImages:      reportCachedImages,
 	}
 }
 
@@ -127,13 +131,17 @@ func (clusterUtilisationService *ClusterUtilisationService) GetAvailableClusterC
 		available := allocatable.DeepCopy()
 		available.Sub(nodesUsage[n.Name])
 
-		nodes = append(nodes, api.NodeInfo{
+		nodeInfo := api.NodeInfo{
 			Name:                 n.Name,
 			Labels:               clusterUtilisationService.filterTrackedLabels(n.Labels),
 			Taints:               n.Spec.Taints,
 			AllocatableResources: allocatable,
 			AvailableResources:   available,
-		})
+		}
+		if clusterUtilisationService.reportCachedImages {
+			nodeInfo.CachedImages = getCachedImages(n)
+		}
+		nodes = append(nodes, nodeInfo)
 	}
 
 	return &ClusterAvailableCapacityReport{
@@ -338,6 +346,22 @@ func (clusterUtilisationService *ClusterUtilisationService) filterTrackedLabels(
 	return result
 }
 
+// getCachedImages returns the normalized names of the images present on the node, as far as the node status lists them.
+func getCachedImages(node *v1.Node) []string {
+	images := []string{}
+	seen := map[string]bool{}
+	for _, image := range node.Status.Images {
+		for _, name := range image.Names {
+			normalized := commonUtil.NormalizeImageName(name)
+			if !seen[normalized] {
+				seen[normalized] = true
+				images = append(images, normalized)
+			}
+		}
+	}
+	return images
+}
+
 func GetAllocationByQueue(pods []*v1.Pod) map[string]common.ComputeResources {
 	utilisationByQueue := make(map[string]common.ComputeResources)
 
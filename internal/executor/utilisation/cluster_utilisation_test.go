@@ -188,6 +188,24 @@ func TestGetAllocatedResourceByNodeName(t *testing.T) {
 	}, allocatedResource)
 }
 
+func TestGetCachedImages(t *testing.T) {
+	node := &v1.Node{
+		Status: v1.NodeStatus{
+			Images: []v1.ContainerImage{
+				{Names: []string{"docker.io/library/ubuntu@sha256:abc", "docker.io/library/ubuntu:20.04"}},
+				{Names: []string{"registry.example.com/model:1.0"}},
+				{Names: []string{"ubuntu:20.04"}},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"docker.io/library/ubuntu@sha256:abc",
+		"docker.io/library/ubuntu:20.04",
+		"registry.example.com/model:1.0",
+	}, getCachedImages(node))
+}
+
 func hasKey(value map[string]common.ComputeResources, key string) bool {
 	_, ok := value[key]
 	return ok
@@ -1,6 +1,7 @@
 package utilisation
 
 import (
+	"strings"
 	"sync"
 	"time"
 
@@ -27,6 +28,7 @@ type KubeletPodUtilisationService struct {
 	clusterContext     context.ClusterContext
 	nodeInfoService    node.NodeInfoService
 	podUtilisationData map[string]*domain.UtilisationData
+	podNetworkStats    map[string]*v1alpha1.NetworkStats
 	dataAccessMutex    sync.Mutex
 }
 
@@ -35,6 +37,7 @@ func NewMetricsServerQueueUtilisationService(clusterContext context.ClusterConte
 		clusterContext:     clusterContext,
 		nodeInfoService:    nodeInfoService,
 		podUtilisationData: map[string]*domain.UtilisationData{},
+		podNetworkStats:    map[string]*v1alpha1.NetworkStats{},
 		dataAccessMutex:    sync.Mutex{},
 	}
 }
@@ -66,6 +69,11 @@ func (q *KubeletPodUtilisationService) removeFinishedPods(podNames map[string]bo
 			delete(q.podUtilisationData, name)
 		}
 	}
+	for name := range q.podNetworkStats {
+		if !podNames[name] {
+			delete(q.podNetworkStats, name)
+		}
+	}
 }
 
 func (q *KubeletPodUtilisationService) RefreshUtilisationData() {
@@ -93,6 +101,10 @@ func (q *KubeletPodUtilisationService) RefreshUtilisationData() {
 	nodes = util.MergeNodeList(nodes, processingNodes)
 
 	podNames := commonUtil.StringListToSet(util.ExtractNames(pods))
+	podsByName := make(map[string]*v1.Pod, len(pods))
+	for _, pod := range pods {
+		podsByName[pod.Name] = pod
+	}
 
 	summaries := make(chan *v1alpha1.Summary, len(nodes))
 	wg := sync.WaitGroup{}
@@ -115,9 +127,9 @@ func (q *KubeletPodUtilisationService) RefreshUtilisationData() {
 	}()
 
 	for s := range summaries {
-		for _, pod := range s.Pods {
-			if podNames[pod.PodRef.Name] {
-				q.updatePodStats(&pod)
+		for _, podStats := range s.Pods {
+			if pod, present := podsByName[podStats.PodRef.Name]; present {
+				q.updatePodStats(pod, &podStats)
 			}
 		}
 	}
@@ -160,7 +172,7 @@ func getNodesHostingActiveManagedPods(pods []*v1.Pod, nodes []*v1.Node) []*v1.No
 	return nodesWithActiveManagedPods
 }
 
-func (q *KubeletPodUtilisationService) updatePodStats(podStats *v1alpha1.PodStats) {
+func (q *KubeletPodUtilisationService) updatePodStats(pod *v1.Pod, podStats *v1alpha1.PodStats) {
 	currentUsage := common.ComputeResources{}
 	cumulativeUsage := common.ComputeResources{}
 
@@ -198,9 +210,77 @@ func (q *KubeletPodUtilisationService) updatePodStats(podStats *v1alpha1.PodStat
 		currentUsage[domain.AcceleratorMemory] = *resource.NewScaledQuantity(acceleratorUsedMemory, -2)
 	}
 
+	// Extended resources are reported as requested by running pods, not as allocated by device plugins, which is only
+	// known to the pod resources socket on the node of the pod and can't be reached through the API server.
+	if pod.Status.Phase == v1.PodRunning {
+		currentUsage.Add(getExtendedResourceRequest(pod))
+	}
+
+	if podStats.Network != nil {
+		q.updateNetworkUsage(podStats.PodRef.Name, podStats.Network, currentUsage, cumulativeUsage)
+	}
+
 	utilisationData := &domain.UtilisationData{
 		CurrentUsage:    currentUsage,
 		CumulativeUsage: cumulativeUsage,
 	}
 	q.updatePodUtilisation(podStats.PodRef.Name, utilisationData)
 }
+
+func (q *KubeletPodUtilisationService) updateNetworkUsage(
+	podName string,
+	networkStats *v1alpha1.NetworkStats,
+	currentUsage common.ComputeResources,
+	cumulativeUsage common.ComputeResources) {
+
+	if networkStats.RxBytes != nil {
+		cumulativeUsage[domain.NetworkReceivedBytes] = *resource.NewQuantity(int64(*networkStats.RxBytes), resource.BinarySI)
+	}
+	if networkStats.TxBytes != nil {
+		cumulativeUsage[domain.NetworkTransmittedBytes] = *resource.NewQuantity(int64(*networkStats.TxBytes), resource.BinarySI)
+	}
+
+	q.dataAccessMutex.Lock()
+	previous, present := q.podNetworkStats[podName]
+	q.podNetworkStats[podName] = networkStats
+	q.dataAccessMutex.Unlock()
+
+	if !present {
+		return
+	}
+	interval := networkStats.Time.Sub(previous.Time.Time).Seconds()
+	if interval <= 0 {
+		return
+	}
+	if rate, ok := byteRate(previous.RxBytes, networkStats.RxBytes, interval); ok {
+		currentUsage[domain.NetworkReceiveRate] = rate
+	}
+	if rate, ok := byteRate(previous.TxBytes, networkStats.TxBytes, interval); ok {
+		currentUsage[domain.NetworkTransmitRate] = rate
+	}
+}
+
+func byteRate(previous *uint64, current *uint64, intervalSeconds float64) (resource.Quantity, bool) {
+	// Counters going backwards mean the interface was reset, there is no meaningful rate for this interval
+	if previous == nil || current == nil || *current < *previous {
+		return resource.Quantity{}, false
+	}
+	return *resource.NewQuantity(int64(float64(*current-*previous)/intervalSeconds), resource.BinarySI), true
+}
+
+// getExtendedResourceRequest returns the extended resources, such as nvidia.com/gpu, requested by the pod.
+func getExtendedResourceRequest(pod *v1.Pod) common.ComputeResources {
+	extendedResources := common.ComputeResources{}
+	for resourceName, quantity := range common.TotalPodResourceRequest(&pod.Spec) {
+		if isExtendedResourceName(resourceName) {
+			extendedResources[resourceName] = quantity
+		}
+	}
+	return extendedResources
+}
+
+func isExtendedResourceName(name string) bool {
+	return strings.Contains(name, "/") &&
+		!strings.HasPrefix(name, v1.ResourceDefaultNamespacePrefix) &&
+		!strings.HasPrefix(name, v1.DefaultResourceRequestsPrefix)
+}
@@ -6,10 +6,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/G-Research/armada/internal/common"
 	util2 "github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/internal/executor/configuration"
 	"github.com/G-Research/armada/internal/executor/domain"
+	fakeContext "github.com/G-Research/armada/internal/executor/fake/context"
+	"github.com/G-Research/armada/internal/executor/node"
 )
 
 func TestGetNodesHostingActiveManagedPods(t *testing.T) {
@@ -104,6 +109,82 @@ func TestGetNodesHostingActiveManagedPods_HandlesEmptyInputs(t *testing.T) {
 	assert.Equal(t, []*v1.Node{}, result)
 }
 
+func TestKubeletPodUtilisationService_RefreshUtilisationData(t *testing.T) {
+	clusterContext := fakeContext.NewFakeClusterContext(configuration.ApplicationConfiguration{ClusterId: "test"}, []*fakeContext.NodeSpec{{
+		Name:  "gpu",
+		Count: 1,
+		Allocatable: v1.ResourceList{
+			"cpu":               resource.MustParse("8"),
+			"memory":            resource.MustParse("64Gi"),
+			"ephemeral-storage": resource.MustParse("100Gi"),
+			"nvidia.com/gpu":    resource.MustParse("4"),
+		},
+	}})
+	pod := createPod(v1.PodPending, "", true)
+	pod.Spec.Containers = []v1.Container{{
+		Name:    "container",
+		Command: []string{"sh", "-c", "sleep $(( (RANDOM % 1) + 60 ))"},
+		Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			"cpu":               resource.MustParse("2"),
+			"memory":            resource.MustParse("1Gi"),
+			"ephemeral-storage": resource.MustParse("10Gi"),
+			"nvidia.com/gpu":    resource.MustParse("1"),
+		}},
+	}}
+	_, err := clusterContext.SubmitPod(pod, "user", []string{})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		pods, _ := clusterContext.GetActiveBatchPods()
+		return len(pods) == 1 && pods[0].Status.Phase == v1.PodRunning
+	}, 5*time.Second, 50*time.Millisecond)
+
+	service := NewMetricsServerQueueUtilisationService(clusterContext, node.NewKubernetesNodeInfoService(clusterContext, []string{}))
+	service.RefreshUtilisationData()
+	time.Sleep(100 * time.Millisecond)
+	service.RefreshUtilisationData()
+
+	pods, _ := clusterContext.GetActiveBatchPods()
+	utilisation := service.GetPodUtilisation(pods[0])
+
+	assert.True(t, resource.MustParse("2").Equal(utilisation.CurrentUsage["cpu"]))
+	assert.True(t, resource.MustParse("1Gi").Equal(utilisation.CurrentUsage["memory"]))
+	assert.True(t, resource.MustParse("10Gi").Equal(utilisation.CurrentUsage["ephemeral-storage"]))
+	assert.True(t, resource.MustParse("1").Equal(utilisation.CurrentUsage["nvidia.com/gpu"]))
+	assert.Contains(t, utilisation.CurrentUsage, domain.NetworkReceiveRate)
+	assert.Contains(t, utilisation.CurrentUsage, domain.NetworkTransmitRate)
+	assert.Contains(t, utilisation.CumulativeUsage, domain.NetworkReceivedBytes)
+	assert.Contains(t, utilisation.CumulativeUsage, domain.NetworkTransmittedBytes)
+}
+
+func TestByteRate(t *testing.T) {
+	previous := uint64(1000)
+	current := uint64(3000)
+
+	rate, ok := byteRate(&previous, &current, 2)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1000), rate.Value())
+
+	_, ok = byteRate(&current, &previous, 2)
+	assert.False(t, ok)
+
+	_, ok = byteRate(nil, &current, 2)
+	assert.False(t, ok)
+}
+
+func TestGetExtendedResourceRequest(t *testing.T) {
+	pod := createPod(v1.PodRunning, "node1", true)
+	pod.Spec.Containers = []v1.Container{{
+		Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			"cpu":                     resource.MustParse("1"),
+			"memory":                  resource.MustParse("1Gi"),
+			"nvidia.com/gpu":          resource.MustParse("2"),
+			"kubernetes.io/something": resource.MustParse("1"),
+		}},
+	}}
+
+	assert.Equal(t, common.ComputeResources{"nvidia.com/gpu": resource.MustParse("2")}, getExtendedResourceRequest(pod))
+}
+
 func createPod(phase v1.PodPhase, nodeName string, isManaged bool) *v1.Pod {
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
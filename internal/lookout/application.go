@@ -1,7 +1,9 @@
 package lookout
 
 import (
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -9,19 +11,27 @@ import (
 	"github.com/nats-io/jsm.go"
 	"github.com/nats-io/stan.go"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 
+	"github.com/G-Research/armada/internal/common/auth"
 	"github.com/G-Research/armada/internal/common/auth/authorization"
 	"github.com/G-Research/armada/internal/common/eventstream"
-	"github.com/G-Research/armada/internal/common/grpc"
+	grpcCommon "github.com/G-Research/armada/internal/common/grpc"
 	"github.com/G-Research/armada/internal/common/health"
+	"github.com/G-Research/armada/internal/common/task"
 	"github.com/G-Research/armada/internal/common/util"
 	"github.com/G-Research/armada/internal/lookout/configuration"
 	"github.com/G-Research/armada/internal/lookout/events"
+	"github.com/G-Research/armada/internal/lookout/export"
+	"github.com/G-Research/armada/internal/lookout/history"
 	"github.com/G-Research/armada/internal/lookout/metrics"
 	"github.com/G-Research/armada/internal/lookout/postgres"
+	"github.com/G-Research/armada/internal/lookout/queues"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/internal/lookout/server"
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
+	"github.com/G-Research/armada/pkg/client"
 )
 
 type LogRusLogger struct{}
@@ -30,12 +40,13 @@ func (l LogRusLogger) Printf(format string, v ...interface{}) {
 	log.Debugf(format, v...)
 }
 
-func StartUp(config configuration.LookoutConfiguration, healthChecks *health.MultiChecker) (func(), *sync.WaitGroup) {
+func StartUp(config configuration.LookoutConfiguration, healthChecks *health.MultiChecker, mux *http.ServeMux) (func(), *sync.WaitGroup) {
 
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 
-	grpcServer := grpc.CreateGrpcServer([]authorization.AuthService{&authorization.AnonymousAuthService{}})
+	authServices := auth.ConfigureAuth(config.Auth)
+	grpcServer := grpcCommon.CreateGrpcServer(authServices)
 
 	db, err := postgres.Open(config.Postgres)
 	if err != nil {
@@ -62,6 +73,14 @@ func StartUp(config configuration.LookoutConfiguration, healthChecks *health.Mul
 		}
 		eventStream = stream
 
+		healthChecks.Add(stream)
+	} else if len(config.Kafka.Brokers) > 0 {
+		stream, err := eventstream.NewKafkaEventStream(&config.Kafka)
+		if err != nil {
+			panic(err)
+		}
+		eventStream = stream
+
 		healthChecks.Add(stream)
 	} else {
 		stanClient, err := eventstream.NewStanClientConnection(
@@ -80,21 +99,58 @@ func StartUp(config configuration.LookoutConfiguration, healthChecks *health.Mul
 		healthChecks.Add(stanClient)
 	}
 
-	eventProcessor := events.NewEventProcessor(config.EventQueue, eventStream, jobStore)
+	eventBatcher := eventstream.NewTimedEventBatcher(config.ProcessorBatchSize, config.ProcessorMaxTimeBetweenBatches, config.ProcessorTimeout)
+	eventProcessor := events.NewEventProcessor(config.EventQueue, eventStream, jobStore, eventBatcher)
 	eventProcessor.Start()
 
 	dbMetricsProvider := metrics.NewLookoutSqlDbMetricsProvider(db, config.Postgres)
 	metrics.ExposeLookoutMetrics(dbMetricsProvider)
 
-	lookoutServer := server.NewLookoutServer(jobRepository)
+	taskManager := task.NewBackgroundTaskManager(metrics.MetricPrefix)
+	if config.QueueHistory.SampleInterval > 0 {
+		sampler := history.NewQueueSampler(jobStore, config.QueueHistory.SampleInterval, &repository.DefaultClock{})
+		taskManager.Register(sampler.Sample, config.QueueHistory.SampleInterval, "queue_history_sample")
+	}
+
+	var armadaConnection *grpc.ClientConn
+	if config.QueuePermissions.SyncInterval > 0 {
+		armadaConnection, err = client.CreateApiConnection(&config.ArmadaApiConnection)
+		if err != nil {
+			panic(err)
+		}
+		syncer := queues.NewPermissionSyncer(jobStore, api.NewSubmitClient(armadaConnection), &repository.DefaultClock{})
+		taskManager.Register(syncer.Sync, config.QueuePermissions.SyncInterval, "queue_permission_sync")
+	}
+
+	permissions := authorization.NewPrincipalPermissionChecker(
+		config.Auth.PermissionGroupMapping,
+		config.Auth.PermissionScopeMapping,
+		config.Auth.PermissionClaimMapping)
+	lookoutServer := server.NewLookoutServer(jobRepository, permissions, config.Accounting)
 	lookout.RegisterLookoutServer(grpcServer, lookoutServer)
 
+	mux.Handle("/api/v1/lookout/export", export.NewHandler(
+		jobRepository,
+		authorization.CreateMiddlewareAuthFunction(authServices),
+		lookoutServer.RestrictToWatchableQueues))
+
 	grpc_prometheus.Register(grpcServer)
 
-	grpc.Listen(config.GrpcPort, grpcServer, wg)
+	grpcCommon.Listen(config.GrpcPort, grpcServer, wg)
 
 	stop := func() {
-		err := eventStream.Close()
+		taskManager.StopAll(time.Second * 2)
+		if armadaConnection != nil {
+			err := armadaConnection.Close()
+			if err != nil {
+				log.Errorf("failed to close armada connection: %v", err)
+			}
+		}
+		err := eventBatcher.Stop()
+		if err != nil {
+			log.Errorf("failed to flush event processor buffer for lookout")
+		}
+		err = eventStream.Close()
 		if err != nil {
 			log.Errorf("failed to close nats connection: %v", err)
 		}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/G-Research/armada/internal/armada/configuration"
+	authConfiguration "github.com/G-Research/armada/internal/common/auth/configuration"
+	"github.com/G-Research/armada/pkg/client"
 )
 
 type NatsConfig struct {
@@ -33,18 +35,84 @@ type PostgresConfig struct {
 type PrunerConfig struct {
 	DaysToKeep int
 	BatchSize  int
+	// Rules are checked in order and the first rule matching a job decides how long it is kept.
+	// Jobs which don't match any rule are kept for DaysToKeep days.
+	Rules []RetentionRule
+	// DryRun only reports how many jobs each rule would delete.
+	DryRun bool
+	// PushgatewayUrl is the Prometheus Pushgateway the pruner pushes its metrics to when it finishes, if set.
+	PushgatewayUrl string
+}
+
+// RetentionRule keeps the jobs it matches for DaysToKeep days after they were submitted. A job matches if its queue
+// matches the Queue glob pattern, its state is one of States and it has all the user Annotations. Empty fields match
+// every job.
+type RetentionRule struct {
+	Name        string
+	Queue       string
+	States      []string
+	Annotations map[string]string
+	DaysToKeep  int
+}
+
+// QueueHistoryConfig configures how often the state of the queues is recorded, history is not recorded if
+// SampleInterval is zero.
+type QueueHistoryConfig struct {
+	SampleInterval time.Duration
+}
+
+// QueuePermissionsConfig configures how often the permissions of the queues are synced from Armada, permissions are
+// not synced if SyncInterval is zero.
+type QueuePermissionsConfig struct {
+	SyncInterval time.Duration
+}
+
+// RebuildConfig configures rebuilding the database from the retained events. The events are replayed from the
+// ArchiveDirectory of an Armada event archive if set, or from JetStream otherwise.
+type RebuildConfig struct {
+	// Schema the database is rebuilt in. An interrupted rebuild continues where it stopped, drop the schema to start
+	// again from the first event.
+	Schema           string
+	BatchSize        int
+	ArchiveDirectory string
+	ProgressInterval time.Duration
+	// Switch replaces the serving schema with the rebuilt schema once all events have been replayed. The serving
+	// schema is kept under a new name so it can be restored, and its queue history and queue permissions are copied
+	// to the rebuilt schema. Events published to JetStream during the rebuild are replayed after the switch, events
+	// archived during the rebuild are not.
+	Switch bool
+}
+
+// AccountingConfig gives the price of one hour of each resource, with memory and ephemeral storage priced per GiB.
+// Usage of resources without a price has no cost.
+type AccountingConfig struct {
+	UnitPrices map[string]float64
 }
 
 type LookoutConfiguration struct {
+	Auth authConfiguration.AuthConfig
+
 	HttpPort    uint16
 	GrpcPort    uint16
 	MetricsPort uint16
 
 	UIConfig LookoutUIConfig
 
-	EventQueue   string
+	EventQueue                     string
+	ProcessorBatchSize             int           // Maximum number of events recorded in one transaction
+	ProcessorMaxTimeBetweenBatches time.Duration // Maximum time between batches
+	ProcessorTimeout               time.Duration // Timeout for reporting event or stopping batcher before erroring out
+
 	Nats         NatsConfig
 	Jetstream    configuration.JetstreamConfig
+	Kafka        configuration.KafkaConfig
 	Postgres     PostgresConfig
 	PrunerConfig PrunerConfig
+	Accounting   AccountingConfig
+	QueueHistory QueueHistoryConfig
+	Rebuild      RebuildConfig
+
+	// ArmadaApiConnection is used to read the permissions of the queues from the Armada server.
+	ArmadaApiConnection client.ApiConnectionDetails
+	QueuePermissions    QueuePermissionsConfig
 }
@@ -1,9 +1,14 @@
 package events
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 
 	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/internal/lookout/metrics"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/pkg/api"
 )
@@ -12,10 +17,19 @@ type EventProcessor struct {
 	queue    string
 	stream   eventstream.EventStream
 	recorder repository.JobRecorder
+	batcher  eventstream.EventBatcher
+}
+
+func NewEventProcessor(queue string, stream eventstream.EventStream, repository repository.JobRecorder, batcher eventstream.EventBatcher) *EventProcessor {
+	processor := &EventProcessor{queue: queue, stream: stream, recorder: repository, batcher: batcher}
+	processor.batcher.Register(processor.handleBatch)
+	return processor
 }
 
-func NewEventProcessor(queue string, stream eventstream.EventStream, repository repository.JobRecorder) *EventProcessor {
-	return &EventProcessor{queue: queue, stream: stream, recorder: repository}
+// NewReplayProcessor returns an EventProcessor which records batches of events read by the caller with ProcessBatch,
+// instead of subscribing to the event stream.
+func NewReplayProcessor(repository repository.JobRecorder) *EventProcessor {
+	return &EventProcessor{recorder: repository}
 }
 
 func (p *EventProcessor) Start() {
@@ -26,80 +40,123 @@ func (p *EventProcessor) Start() {
 }
 
 func (p *EventProcessor) handleMessage(eventMessage *eventstream.Message) error {
-	// TODO: batching???
-	event, err := api.UnwrapEvent(eventMessage.EventMessage)
-	if err != nil {
-		return fmt.Errorf("error while unwrapping event message: %v", err)
-	}
-	err = p.processEvent(event)
-	if err != nil {
-		return fmt.Errorf("Error while reporting event from nats: %v (event: %v)", err, eventMessage)
-	}
-	err = eventMessage.Ack()
+	err := p.batcher.Report(eventMessage)
 	if err != nil {
-		return fmt.Errorf("error while attempting to acknowledge event: %v", err)
+		err = fmt.Errorf("error reporting event: %v", err)
+		log.Error(err)
+		return err
 	}
 	return nil
 }
 
-func (p *EventProcessor) processEvent(event api.Event) error {
-	switch typed := event.(type) {
-	case *api.JobSubmittedEvent:
-		return p.recorder.RecordJob(&typed.Job, typed.Created)
-
-	case *api.JobQueuedEvent:
-		// this event just attest saving job to redis
-
-	case *api.JobDuplicateFoundEvent:
-		return p.recorder.RecordJobDuplicate(typed)
-
-	case *api.JobPendingEvent:
-		return p.recorder.RecordJobPending(typed)
-
-	case *api.JobRunningEvent:
-		return p.recorder.RecordJobRunning(typed)
-
-	case *api.JobSucceededEvent:
-		return p.recorder.RecordJobSucceeded(typed)
-
-	case *api.JobFailedEvent:
-		return p.recorder.RecordJobFailed(typed)
-
-	case *api.JobLeasedEvent:
-	case *api.JobLeaseReturnedEvent:
-		return p.recorder.RecordJobUnableToSchedule(&api.JobUnableToScheduleEvent{
-			JobId:     typed.JobId,
-			JobSetId:  typed.JobSetId,
-			Queue:     typed.Queue,
-			Created:   typed.Created,
-			ClusterId: typed.ClusterId,
-			Reason:    typed.Reason,
-		})
-	case *api.JobLeaseExpiredEvent:
-		// TODO record leasing as messages?
+// handleBatch records all events of the batch in one transaction and only acknowledges them after it is committed.
+// Messages which can't be unwrapped are not acknowledged, the same as when they were processed one by one.
+// If the batch fails, its events are recorded one at a time, so a single invalid event doesn't stop ingestion.
+func (p *EventProcessor) handleBatch(batch []*eventstream.Message) error {
+	events := make([]api.Event, 0, len(batch))
+	messageIds := make([]string, 0, len(batch))
+	created := make([]time.Time, 0, len(batch))
+	messages := make([]*eventstream.Message, 0, len(batch))
+	// recorded holds the event recorded for each message, or nil if the event isn't recorded
+	recorded := make([]api.Event, 0, len(batch))
+	for _, eventMessage := range batch {
+		event, err := api.UnwrapEvent(eventMessage.EventMessage)
+		if err != nil {
+			log.Errorf("error while unwrapping event message: %v", err)
+			continue
+		}
+		created = append(created, event.GetCreated())
+		messages = append(messages, eventMessage)
+		toRecord := recordedEvent(event)
+		recorded = append(recorded, toRecord)
+		if toRecord != nil {
+			events = append(events, toRecord)
+			messageIds = append(messageIds, eventMessage.Id)
+		}
+	}
 
-	case *api.JobUnableToScheduleEvent:
-		return p.recorder.RecordJobUnableToSchedule(typed)
+	err := p.recorder.RecordStreamEvents(events, messageIds)
+	if err != nil {
+		metrics.RecordFailedEventBatch()
+		if !repository.IsInvalidEventError(err) {
+			return fmt.Errorf("error while recording %d events: %v", len(events), err)
+		}
+		log.Warnf("error while recording %d events, recording them one at a time: %v", len(events), err)
+		return p.handleEvents(messages, recorded, created)
+	}
+	metrics.RecordCommittedEvents(created)
 
-	case *api.JobReprioritizedEvent:
-		return p.recorder.RecordJobReprioritized(typed)
+	for _, eventMessage := range messages {
+		acknowledge(eventMessage)
+	}
+	return nil
+}
 
-	case *api.JobUpdatedEvent:
-		return p.recorder.RecordJob(&typed.Job, typed.Created)
+// handleEvents records events one at a time and acknowledges each of them once it is committed.
+// Events which fail because they are invalid are dead-lettered, as recording them again would fail the same way.
+func (p *EventProcessor) handleEvents(messages []*eventstream.Message, recorded []api.Event, created []time.Time) error {
+	for i, eventMessage := range messages {
+		if recorded[i] != nil {
+			err := p.recorder.RecordStreamEvents([]api.Event{recorded[i]}, []string{eventMessage.Id})
+			if repository.IsInvalidEventError(err) {
+				deadLetter(eventMessage, err)
+				acknowledge(eventMessage)
+				continue
+			} else if err != nil {
+				return fmt.Errorf("error while recording event: %v", err)
+			}
+		}
+		metrics.RecordCommittedEvents(created[i : i+1])
+		acknowledge(eventMessage)
+	}
+	return nil
+}
 
-	case *api.JobCancellingEvent: // noop
-	case *api.JobCancelledEvent:
-		// job marked for cancellation
-		return p.recorder.MarkCancelled(typed)
+func deadLetter(eventMessage *eventstream.Message, err error) {
+	metrics.RecordDeadLetteredEvent()
+	data, marshalErr := json.Marshal(eventMessage.EventMessage)
+	if marshalErr != nil {
+		log.Errorf("dead-lettering event which could not be recorded: %v", err)
+		return
+	}
+	log.WithField("event", string(data)).Errorf("dead-lettering event which could not be recorded: %v", err)
+}
 
-	case *api.JobTerminatedEvent:
-		return p.recorder.RecordJobTerminated(typed)
+func acknowledge(eventMessage *eventstream.Message) {
+	err := eventMessage.Ack()
+	if err != nil {
+		log.Errorf("error while attempting to acknowledge event: %v", err)
+	}
+}
 
-	case *api.JobUtilisationEvent:
-		// TODO
+// ProcessBatch records the events of batch in the same way as a batch read from the event stream.
+func (p *EventProcessor) ProcessBatch(batch []*eventstream.Message) error {
+	return p.handleBatch(batch)
+}
 
-	case *api.JobIngressInfoEvent: // noop
+// recordedEvent returns the event if it is part of the lifecycle of a job or reports the resource usage of a run
+// and has to be recorded, or nil otherwise.
+func recordedEvent(event api.Event) api.Event {
+	switch event.(type) {
+	case *api.JobSubmittedEvent,
+		*api.JobQueuedEvent,
+		*api.JobDuplicateFoundEvent,
+		*api.JobLeasedEvent,
+		*api.JobLeaseReturnedEvent,
+		*api.JobLeaseExpiredEvent,
+		*api.JobPendingEvent,
+		*api.JobRunningEvent,
+		*api.JobSucceededEvent,
+		*api.JobFailedEvent,
+		*api.JobUnableToScheduleEvent,
+		*api.JobReprioritizingEvent,
+		*api.JobReprioritizedEvent,
+		*api.JobUpdatedEvent,
+		*api.JobCancellingEvent,
+		*api.JobCancelledEvent,
+		*api.JobTerminatedEvent,
+		*api.JobUtilisationEvent:
+		return event
 	}
-
 	return nil
 }
@@ -0,0 +1,154 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestHandleBatch_RecordsBatchAndAcknowledgesAfterCommit(t *testing.T) {
+	recorder := &batchRecorder{}
+	processor := NewEventProcessor("test", &eventstream.JetstreamEventStream{}, recorder, &noopBatcher{})
+
+	acked := 0
+	ack := func() error {
+		acked++
+		return nil
+	}
+	batch := []*eventstream.Message{
+		newMessage(&api.JobPendingEvent{JobId: "job-1", Created: time.Now()}, ack),
+		newMessage(&api.JobLeaseReturnedEvent{JobId: "job-2", Reason: "no capacity"}, ack),
+		newMessage(&api.JobIngressInfoEvent{JobId: "job-1"}, ack),
+	}
+
+	err := processor.handleBatch(batch)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, acked)
+
+	assert.Len(t, recorder.batches, 1)
+	recorded := recorder.batches[0]
+	assert.Len(t, recorded, 2)
+	assert.IsType(t, &api.JobPendingEvent{}, recorded[0])
+	assert.Equal(t, &api.JobLeaseReturnedEvent{JobId: "job-2", Reason: "no capacity"}, recorded[1])
+	assert.Equal(t, [][]string{{batch[0].Id, batch[1].Id}}, recorder.messageIds)
+}
+
+func TestHandleBatch_DoesNotAcknowledgeFailedBatch(t *testing.T) {
+	recorder := &batchRecorder{err: errors.New("database unavailable")}
+	processor := NewEventProcessor("test", &eventstream.JetstreamEventStream{}, recorder, &noopBatcher{})
+
+	acked := false
+	batch := []*eventstream.Message{
+		newMessage(&api.JobRunningEvent{JobId: "job-1"}, func() error {
+			acked = true
+			return nil
+		}),
+	}
+
+	err := processor.handleBatch(batch)
+	assert.Error(t, err)
+	assert.False(t, acked)
+}
+
+func TestHandleBatch_DeadLettersInvalidEventAndRecordsTheOthers(t *testing.T) {
+	recorder := &batchRecorder{invalidJobId: "job-2"}
+	processor := NewEventProcessor("test", &eventstream.JetstreamEventStream{}, recorder, &noopBatcher{})
+
+	acked := []string{}
+	ack := func(jobId string) eventstream.AckFn {
+		return func() error {
+			acked = append(acked, jobId)
+			return nil
+		}
+	}
+	batch := []*eventstream.Message{
+		newMessage(&api.JobPendingEvent{JobId: "job-1"}, ack("job-1")),
+		newMessage(&api.JobRunningEvent{JobId: "job-2"}, ack("job-2")),
+		newMessage(&api.JobIngressInfoEvent{JobId: "job-3"}, ack("job-3")),
+		newMessage(&api.JobRunningEvent{JobId: "job-4"}, ack("job-4")),
+	}
+
+	err := processor.handleBatch(batch)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"job-1", "job-2", "job-3", "job-4"}, acked)
+
+	recordedJobIds := []string{}
+	for _, batch := range recorder.batches {
+		assert.Len(t, batch, 1)
+		recordedJobIds = append(recordedJobIds, batch[0].GetJobId())
+	}
+	assert.Equal(t, []string{"job-1", "job-4"}, recordedJobIds)
+}
+
+func TestHandleBatch_StopsRecordingEventsOneAtATimeWhenDatabaseFails(t *testing.T) {
+	recorder := &batchRecorder{invalidJobId: "job-1", err: errors.New("database unavailable")}
+	processor := NewEventProcessor("test", &eventstream.JetstreamEventStream{}, recorder, &noopBatcher{})
+	recorder.failAfter = 1
+
+	acked := false
+	batch := []*eventstream.Message{
+		newMessage(&api.JobPendingEvent{JobId: "job-1"}, func() error { return nil }),
+		newMessage(&api.JobRunningEvent{JobId: "job-2"}, func() error {
+			acked = true
+			return nil
+		}),
+	}
+
+	err := processor.handleBatch(batch)
+	assert.Error(t, err)
+	assert.False(t, acked)
+}
+
+func newMessage(event api.Event, ack eventstream.AckFn) *eventstream.Message {
+	message, _ := api.Wrap(event)
+	return &eventstream.Message{Id: "events:" + event.GetJobId(), EventMessage: message, Ack: ack}
+}
+
+type batchRecorder struct {
+	repository.JobRecorder
+	batches    [][]api.Event
+	messageIds [][]string
+	err        error
+	// invalidJobId fails every batch containing an event of the job with a constraint violation
+	invalidJobId string
+	// failAfter makes recording fail with err after this many calls, if invalidJobId is set
+	failAfter int
+	calls     int
+}
+
+func (r *batchRecorder) RecordStreamEvents(events []api.Event, messageIds []string) error {
+	r.calls++
+	if r.invalidJobId == "" && r.err != nil {
+		return r.err
+	}
+	if r.failAfter > 0 && r.calls > r.failAfter {
+		return r.err
+	}
+	for _, event := range events {
+		if event.GetJobId() == r.invalidJobId {
+			return &pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint"}
+		}
+	}
+	r.batches = append(r.batches, events)
+	r.messageIds = append(r.messageIds, messageIds)
+	return nil
+}
+
+type noopBatcher struct{}
+
+func (b *noopBatcher) Register(callback eventstream.EventBatchCallback) {}
+
+func (b *noopBatcher) Report(event *eventstream.Message) error {
+	return nil
+}
+
+func (b *noopBatcher) Stop() error {
+	return nil
+}
@@ -0,0 +1,259 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+
+	protoutil "github.com/G-Research/armada/internal/common/grpc/protoutils"
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const (
+	FormatCsv    = "csv"
+	FormatNdjson = "ndjson"
+)
+
+type column struct {
+	name  string
+	value func(row *repository.ExportRow) interface{}
+}
+
+var columns = []column{
+	{"job_id", func(row *repository.ExportRow) interface{} { return row.JobId }},
+	{"queue", func(row *repository.ExportRow) interface{} { return row.Queue }},
+	{"owner", func(row *repository.ExportRow) interface{} { return row.Owner }},
+	{"job_set", func(row *repository.ExportRow) interface{} { return row.JobSet }},
+	{"priority", func(row *repository.ExportRow) interface{} { return row.Priority }},
+	{"submitted", func(row *repository.ExportRow) interface{} { return row.Submitted }},
+	{"cancelled", func(row *repository.ExportRow) interface{} { return row.Cancelled }},
+	{"job_state", func(row *repository.ExportRow) interface{} { return row.JobState }},
+	{"run_id", func(row *repository.ExportRow) interface{} { return row.RunId }},
+	{"pod_number", func(row *repository.ExportRow) interface{} { return row.PodNumber }},
+	{"cluster", func(row *repository.ExportRow) interface{} { return row.Cluster }},
+	{"node", func(row *repository.ExportRow) interface{} { return row.Node }},
+	{"created", func(row *repository.ExportRow) interface{} { return row.Created }},
+	{"started", func(row *repository.ExportRow) interface{} { return row.Started }},
+	{"finished", func(row *repository.ExportRow) interface{} { return row.Finished }},
+	{"succeeded", func(row *repository.ExportRow) interface{} { return row.Succeeded }},
+	{"error", func(row *repository.ExportRow) interface{} { return row.Error }},
+	{"exit_codes", func(row *repository.ExportRow) interface{} { return row.ExitCodes }},
+}
+
+// Handler streams the jobs matching the GetJobsRequest in the request body as CSV or newline-delimited JSON, with one
+// row for each run. The format and the columns are selected with the "format" and "columns" query parameters.
+//
+// Requests are authenticated from their Authorization header in the same way as gRPC calls, and only jobs in the queues
+// returned by restrict are exported.
+type Handler struct {
+	exporter     repository.JobExporter
+	authenticate grpc_auth.AuthFunc
+	restrict     func(ctx context.Context) (context.Context, error)
+}
+
+func NewHandler(
+	exporter repository.JobExporter,
+	authenticate grpc_auth.AuthFunc,
+	restrict func(ctx context.Context) (context.Context, error)) *Handler {
+	return &Handler{exporter: exporter, authenticate: authenticate, restrict: restrict}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	md := metadata.MD{}
+	for _, value := range r.Header.Values("Authorization") {
+		md.Append("authorization", value)
+	}
+	ctx, err := h.authenticate(metadata.NewIncomingContext(r.Context(), md))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unauthenticated: %s", err), http.StatusUnauthorized)
+		return
+	}
+	ctx, err = h.restrict(ctx)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error checking permissions: %s", err), http.StatusServiceUnavailable)
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = FormatCsv
+	}
+	selected, err := selectColumns(query.Get("columns"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writer, err := newRowWriter(format, w, selected)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	opts := &lookout.GetJobsRequest{}
+	err = new(protoutil.JSONMarshaller).NewDecoder(r.Body).Decode(opts)
+	if err != nil && err != io.EOF {
+		http.Error(w, fmt.Sprintf("invalid job query: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Once rows have been written the status can't be changed, so later errors can only end the stream early.
+	started := false
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", writer.contentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"jobs.%s\"", format))
+		return writer.writeHeader()
+	}
+	err = h.exporter.ExportJobs(ctx, opts, func(row *repository.ExportRow) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return writer.write(row)
+	})
+	if err != nil && !started {
+		http.Error(w, fmt.Sprintf("failed to export jobs: %s", err), http.StatusInternalServerError)
+		return
+	}
+	if err == nil && !started {
+		err = start()
+	}
+	if err == nil {
+		err = writer.flush()
+	}
+	if err != nil {
+		log.Errorf("failed to export jobs: %v", err)
+	}
+}
+
+// selectColumns returns the columns named in the comma separated list, or all columns if the list is empty.
+func selectColumns(names string) ([]column, error) {
+	if names == "" {
+		return columns, nil
+	}
+	selected := []column{}
+	for _, name := range strings.Split(names, ",") {
+		c, ok := findColumn(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown column: %q", name)
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+func findColumn(name string) (column, bool) {
+	for _, c := range columns {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return column{}, false
+}
+
+type rowWriter interface {
+	contentType() string
+	writeHeader() error
+	write(row *repository.ExportRow) error
+	flush() error
+}
+
+func newRowWriter(format string, w io.Writer, columns []column) (rowWriter, error) {
+	switch format {
+	case FormatCsv:
+		return &csvWriter{writer: csv.NewWriter(w), columns: columns}, nil
+	case FormatNdjson:
+		return &ndjsonWriter{encoder: json.NewEncoder(w), columns: columns}, nil
+	}
+	return nil, fmt.Errorf("unknown export format: %q", format)
+}
+
+type csvWriter struct {
+	writer  *csv.Writer
+	columns []column
+}
+
+func (c *csvWriter) contentType() string {
+	return "text/csv"
+}
+
+func (c *csvWriter) writeHeader() error {
+	names := make([]string, 0, len(c.columns))
+	for _, col := range c.columns {
+		names = append(names, col.name)
+	}
+	return c.writer.Write(names)
+}
+
+func (c *csvWriter) write(row *repository.ExportRow) error {
+	values := make([]string, 0, len(c.columns))
+	for _, col := range c.columns {
+		values = append(values, formatCsvValue(col.value(row)))
+	}
+	return c.writer.Write(values)
+}
+
+func (c *csvWriter) flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func formatCsvValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339Nano)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type ndjsonWriter struct {
+	encoder *json.Encoder
+	columns []column
+}
+
+func (n *ndjsonWriter) contentType() string {
+	return "application/x-ndjson"
+}
+
+func (n *ndjsonWriter) writeHeader() error {
+	return nil
+}
+
+func (n *ndjsonWriter) write(row *repository.ExportRow) error {
+	values := make(map[string]interface{}, len(n.columns))
+	for _, col := range n.columns {
+		values[col.name] = col.value(row)
+	}
+	return n.encoder.Encode(values)
+}
+
+func (n *ndjsonWriter) flush() error {
+	return nil
+}
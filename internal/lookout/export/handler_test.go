@@ -0,0 +1,198 @@
+package export
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+var someTime = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+type fakeExporter struct {
+	rows    []*repository.ExportRow
+	err     error
+	request *lookout.GetJobsRequest
+	ctx     context.Context
+}
+
+func (f *fakeExporter) ExportJobs(ctx context.Context, opts *lookout.GetJobsRequest, handle func(row *repository.ExportRow) error) error {
+	f.request = opts
+	f.ctx = ctx
+	for _, row := range f.rows {
+		if err := handle(row); err != nil {
+			return err
+		}
+	}
+	return f.err
+}
+
+type contextKey string
+
+const userKey contextKey = "user"
+
+// authenticate accepts the bearer token "valid" as the user "alice".
+func authenticate(ctx context.Context) (context.Context, error) {
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil, err
+	}
+	if token != "valid" {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token")
+	}
+	return context.WithValue(ctx, userKey, "alice"), nil
+}
+
+func newTestHandler(exporter repository.JobExporter) *Handler {
+	return NewHandler(exporter, authenticate, func(ctx context.Context) (context.Context, error) {
+		return repository.RestrictToQueues(ctx, []string{"queue"}), nil
+	})
+}
+
+func newAuthenticatedRequest(method, target string, body io.Reader) *http.Request {
+	request := httptest.NewRequest(method, target, body)
+	request.Header.Set("Authorization", "Bearer valid")
+	return request
+}
+
+func exportRows() []*repository.ExportRow {
+	return []*repository.ExportRow{
+		{JobId: "job-1", Queue: "queue", Submitted: &someTime, JobState: "Failed", RunId: "run-1",
+			Error: "error, with comma", ExitCodes: "main:1"},
+		{JobId: "job-2", Queue: "queue", Submitted: &someTime, JobState: "Queued"},
+	}
+}
+
+func TestHandler_ExportsCsv(t *testing.T) {
+	exporter := &fakeExporter{rows: exportRows()}
+	request := newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export?columns=job_id,run_id,error,started",
+		strings.NewReader(`{"queue": "queue", "jobStates": ["Failed", "Queued"]}`))
+	response := httptest.NewRecorder()
+
+	newTestHandler(exporter).ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/csv", response.Header().Get("Content-Type"))
+	assert.Equal(t, "job_id,run_id,error,started\n"+
+		"job-1,run-1,\"error, with comma\",\n"+
+		"job-2,,,\n", response.Body.String())
+	assert.Equal(t, &lookout.GetJobsRequest{Queue: "queue", JobStates: []string{"Failed", "Queued"}}, exporter.request)
+}
+
+func TestHandler_ExportsNdjson(t *testing.T) {
+	exporter := &fakeExporter{rows: exportRows()}
+	request := newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export?format=ndjson&columns=job_id,submitted,started",
+		strings.NewReader(`{}`))
+	response := httptest.NewRecorder()
+
+	newTestHandler(exporter).ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/x-ndjson", response.Header().Get("Content-Type"))
+	assert.Equal(t,
+		`{"job_id":"job-1","started":null,"submitted":"2021-03-04T05:06:07Z"}`+"\n"+
+			`{"job_id":"job-2","started":null,"submitted":"2021-03-04T05:06:07Z"}`+"\n",
+		response.Body.String())
+}
+
+func TestHandler_ExportsAllColumnsByDefault(t *testing.T) {
+	exporter := &fakeExporter{}
+	request := newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export", nil)
+	response := httptest.NewRecorder()
+
+	newTestHandler(exporter).ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "job_id,queue,owner,job_set,priority,submitted,cancelled,job_state,run_id,pod_number,"+
+		"cluster,node,created,started,finished,succeeded,error,exit_codes\n", response.Body.String())
+}
+
+func TestHandler_RejectsInvalidRequests(t *testing.T) {
+	for name, request := range map[string]*http.Request{
+		"method":  newAuthenticatedRequest(http.MethodGet, "/api/v1/lookout/export", nil),
+		"format":  newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export?format=xml", nil),
+		"columns": newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export?columns=job_id,unknown", nil),
+		"query":   newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export", strings.NewReader(`{"queue": 1}`)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			exporter := &fakeExporter{}
+			response := httptest.NewRecorder()
+
+			newTestHandler(exporter).ServeHTTP(response, request)
+
+			assert.NotEqual(t, http.StatusOK, response.Code)
+			assert.Nil(t, exporter.request)
+		})
+	}
+}
+
+func TestHandler_ReturnsErrorIfExportFailsBeforeAnyRows(t *testing.T) {
+	exporter := &fakeExporter{err: assert.AnError}
+	request := newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export", nil)
+	response := httptest.NewRecorder()
+
+	newTestHandler(exporter).ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusInternalServerError, response.Code)
+}
+
+func TestHandler_RejectsUnauthenticatedRequests(t *testing.T) {
+	for name, header := range map[string]string{
+		"missing": "",
+		"invalid": "Bearer invalid",
+	} {
+		t.Run(name, func(t *testing.T) {
+			exporter := &fakeExporter{rows: exportRows()}
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/lookout/export", nil)
+			if header != "" {
+				request.Header.Set("Authorization", header)
+			}
+			response := httptest.NewRecorder()
+
+			newTestHandler(exporter).ServeHTTP(response, request)
+
+			assert.Equal(t, http.StatusUnauthorized, response.Code)
+			assert.Nil(t, exporter.request)
+		})
+	}
+}
+
+func TestHandler_ExportsWithAuthenticatedAndRestrictedContext(t *testing.T) {
+	exporter := &fakeExporter{rows: exportRows()}
+	request := newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export", nil)
+	response := httptest.NewRecorder()
+
+	var restricted context.Context
+	NewHandler(exporter, authenticate, func(ctx context.Context) (context.Context, error) {
+		restricted = repository.RestrictToQueues(ctx, []string{"queue"})
+		return restricted, nil
+	}).ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, restricted, exporter.ctx)
+	assert.Equal(t, "alice", exporter.ctx.Value(userKey))
+}
+
+func TestHandler_ReturnsErrorIfPermissionsCanNotBeChecked(t *testing.T) {
+	exporter := &fakeExporter{rows: exportRows()}
+	request := newAuthenticatedRequest(http.MethodPost, "/api/v1/lookout/export", nil)
+	response := httptest.NewRecorder()
+
+	NewHandler(exporter, authenticate, func(ctx context.Context) (context.Context, error) {
+		return nil, assert.AnError
+	}).ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusServiceUnavailable, response.Code)
+	assert.Nil(t, exporter.request)
+}
@@ -0,0 +1,42 @@
+package history
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+)
+
+// QueueSampler records the state of the queues once per interval, including the queues without any active jobs.
+// Samples are taken at multiples of the interval, so samples of several Lookout instances line up.
+type QueueSampler struct {
+	recorder    repository.QueueHistoryRecorder
+	interval    time.Duration
+	clock       repository.Clock
+	lastSampled time.Time
+}
+
+func NewQueueSampler(recorder repository.QueueHistoryRecorder, interval time.Duration, clock repository.Clock) *QueueSampler {
+	return &QueueSampler{recorder: recorder, interval: interval, clock: clock}
+}
+
+// Sample records the state of the queues if it hasn't been recorded for the current interval yet.
+// The throughput is counted since the previous sample, or over one interval for the first sample.
+func (s *QueueSampler) Sample() {
+	sampled := s.clock.Now().Truncate(s.interval)
+	if !sampled.After(s.lastSampled) {
+		return
+	}
+	since := sampled.Add(-s.interval)
+	if !s.lastSampled.IsZero() {
+		since = s.lastSampled
+	}
+
+	err := s.recorder.RecordQueueSample(sampled, since)
+	if err != nil {
+		log.Errorf("Failed to record queue history sample: %v", err)
+		return
+	}
+	s.lastSampled = sampled
+}
@@ -0,0 +1,75 @@
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var startTime = time.Date(2021, 1, 1, 12, 0, 30, 0, time.UTC)
+
+func TestQueueSampler_SamplesOncePerInterval(t *testing.T) {
+	recorder := &fakeRecorder{}
+	clock := &fakeClock{now: startTime}
+	sampler := NewQueueSampler(recorder, time.Minute, clock)
+
+	sampler.Sample()
+	sampler.Sample()
+	clock.now = startTime.Add(time.Minute)
+	sampler.Sample()
+
+	assert.Equal(t, []sample{
+		{sampled: startTime.Truncate(time.Minute), since: startTime.Truncate(time.Minute).Add(-time.Minute)},
+		{sampled: startTime.Truncate(time.Minute).Add(time.Minute), since: startTime.Truncate(time.Minute)},
+	}, recorder.samples)
+}
+
+func TestQueueSampler_CountsThroughputSincePreviousSample(t *testing.T) {
+	recorder := &fakeRecorder{}
+	clock := &fakeClock{now: startTime}
+	sampler := NewQueueSampler(recorder, time.Minute, clock)
+
+	sampler.Sample()
+	clock.now = startTime.Add(3 * time.Minute)
+	sampler.Sample()
+
+	assert.Len(t, recorder.samples, 2)
+	assert.Equal(t, startTime.Truncate(time.Minute), recorder.samples[1].since)
+}
+
+func TestQueueSampler_RetriesFailedSample(t *testing.T) {
+	recorder := &fakeRecorder{err: errors.New("database unavailable")}
+	clock := &fakeClock{now: startTime}
+	sampler := NewQueueSampler(recorder, time.Minute, clock)
+
+	sampler.Sample()
+	recorder.err = nil
+	sampler.Sample()
+
+	assert.Len(t, recorder.samples, 2)
+}
+
+type sample struct {
+	sampled time.Time
+	since   time.Time
+}
+
+type fakeRecorder struct {
+	samples []sample
+	err     error
+}
+
+func (r *fakeRecorder) RecordQueueSample(sampled time.Time, since time.Time) error {
+	r.samples = append(r.samples, sample{sampled: sampled, since: since})
+	return r.err
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
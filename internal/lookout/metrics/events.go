@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var eventLagHistogram = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    MetricPrefix + "event_lag_seconds",
+		Help:    "Time between the creation of an event and the commit of its batch to the database",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 16),
+	})
+
+var lastEventLagGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: MetricPrefix + "last_event_lag_seconds",
+		Help: "Time between the creation of the most recently committed event and its commit to the database",
+	})
+
+var eventBatchSizeHistogram = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    MetricPrefix + "event_batch_size",
+		Help:    "Number of events committed to the database in one transaction",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+	})
+
+var failedEventBatchesCounter = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricPrefix + "failed_event_batches_total",
+		Help: "Number of event batches which failed to be committed to the database",
+	})
+
+var deadLetteredEventsCounter = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: MetricPrefix + "dead_lettered_events_total",
+		Help: "Number of invalid events which could not be recorded and were acknowledged without being recorded",
+	})
+
+// RecordCommittedEvents records the lag of events created at the given times, which have just been committed.
+func RecordCommittedEvents(created []time.Time) {
+	now := time.Now()
+	var newest time.Time
+	for _, c := range created {
+		eventLagHistogram.Observe(now.Sub(c).Seconds())
+		if c.After(newest) {
+			newest = c
+		}
+	}
+	if len(created) > 0 {
+		lastEventLagGauge.Set(now.Sub(newest).Seconds())
+	}
+	eventBatchSizeHistogram.Observe(float64(len(created)))
+}
+
+func RecordFailedEventBatch() {
+	failedEventBatchesCounter.Inc()
+}
+
+func RecordDeadLetteredEvent() {
+	deadLetteredEventsCounter.Inc()
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+var prunedJobsGauge = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricPrefix + "pruner_deleted_jobs",
+		Help: "Number of jobs deleted by each retention rule in the last run of the pruner",
+	},
+	[]string{"rule"})
+
+var prunableJobsGauge = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricPrefix + "pruner_dry_run_jobs",
+		Help: "Number of jobs each retention rule would have deleted in the last dry run of the pruner",
+	},
+	[]string{"rule"})
+
+// RecordPrunedJobs records the number of jobs deleted by a retention rule, or which it would delete in a dry run.
+func RecordPrunedJobs(rule string, jobs int, dryRun bool) {
+	if dryRun {
+		prunableJobsGauge.WithLabelValues(rule).Set(float64(jobs))
+	} else {
+		prunedJobsGauge.WithLabelValues(rule).Set(float64(jobs))
+	}
+}
+
+// PushPrunerMetrics pushes the metrics of the pruner to a Prometheus Pushgateway, as the pruner exits before it can
+// be scraped.
+func PushPrunerMetrics(url string) error {
+	return push.New(url, MetricPrefix+"pruner").
+		Collector(prunedJobsGauge).
+		Collector(prunableJobsGauge).
+		Push()
+}
@@ -0,0 +1,103 @@
+package pruner
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/metrics"
+	"github.com/G-Research/armada/internal/lookout/repository"
+)
+
+const defaultRuleName = "default"
+
+// Run deletes the jobs selected by the retention rules of config, followed by the queue history older than
+// DaysToKeep. In a dry run nothing is deleted and the number of jobs each rule would delete is reported instead.
+func Run(db *sql.DB, config configuration.PrunerConfig, now time.Time) error {
+	rules, err := RetentionRules(config, now)
+	if err != nil {
+		return err
+	}
+
+	counts, err := repository.PruneJobs(db, config.BatchSize, rules, config.DryRun)
+	for i, count := range counts {
+		metrics.RecordPrunedJobs(rules[i].Name, count, config.DryRun)
+	}
+	if err == nil && !config.DryRun {
+		err = repository.DeleteOldQueueHistory(db, now.AddDate(0, 0, -config.DaysToKeep))
+	}
+
+	if config.PushgatewayUrl != "" {
+		if pushErr := metrics.PushPrunerMetrics(config.PushgatewayUrl); pushErr != nil {
+			log.Errorf("failed to push pruner metrics: %v", pushErr)
+		}
+	}
+	return err
+}
+
+// RetentionRules returns the rules of config with their cutoffs relative to now, followed by a rule keeping all other
+// jobs for DaysToKeep days.
+func RetentionRules(config configuration.PrunerConfig, now time.Time) ([]repository.JobRetentionRule, error) {
+	if config.DaysToKeep <= 0 {
+		return nil, fmt.Errorf("invalid PrunerConfig.DaysToKeep [%v]: must be greater than 0", config.DaysToKeep)
+	}
+	if config.BatchSize <= 0 {
+		return nil, fmt.Errorf("invalid PrunerConfig.BatchSize [%v]: must be greater than 0", config.BatchSize)
+	}
+
+	names := map[string]bool{defaultRuleName: true}
+	rules := make([]repository.JobRetentionRule, 0, len(config.Rules)+1)
+	for i, ruleConfig := range config.Rules {
+		if ruleConfig.Name == "" {
+			return nil, fmt.Errorf("invalid PrunerConfig.Rules[%d]: name is required", i)
+		}
+		if names[ruleConfig.Name] {
+			return nil, fmt.Errorf("invalid PrunerConfig.Rules[%d]: name %q is not unique", i, ruleConfig.Name)
+		}
+		names[ruleConfig.Name] = true
+		if ruleConfig.DaysToKeep <= 0 {
+			return nil, fmt.Errorf("invalid PrunerConfig.Rules[%d].DaysToKeep [%v]: must be greater than 0", i, ruleConfig.DaysToKeep)
+		}
+		states, err := jobStates(ruleConfig.States)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PrunerConfig.Rules[%d].States: %v", i, err)
+		}
+
+		rules = append(rules, repository.JobRetentionRule{
+			Name:        ruleConfig.Name,
+			Queue:       ruleConfig.Queue,
+			States:      states,
+			Annotations: ruleConfig.Annotations,
+			Cutoff:      now.AddDate(0, 0, -ruleConfig.DaysToKeep),
+		})
+	}
+
+	rules = append(rules, repository.JobRetentionRule{
+		Name:   defaultRuleName,
+		Cutoff: now.AddDate(0, 0, -config.DaysToKeep),
+	})
+	return rules, nil
+}
+
+func jobStates(names []string) ([]repository.JobState, error) {
+	states := make([]repository.JobState, 0, len(names))
+	for _, name := range names {
+		if !isJobState(name) {
+			return nil, fmt.Errorf("unknown job state: %q", name)
+		}
+		states = append(states, repository.JobState(name))
+	}
+	return states, nil
+}
+
+func isJobState(name string) bool {
+	for _, state := range repository.AllJobStates {
+		if repository.JobState(name) == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package pruner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/repository"
+)
+
+var now = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestRetentionRules_AddsDefaultRule(t *testing.T) {
+	rules, err := RetentionRules(configuration.PrunerConfig{
+		DaysToKeep: 42,
+		BatchSize:  1000,
+		Rules: []configuration.RetentionRule{
+			{Name: "load-tests", Queue: "load-test-*", DaysToKeep: 1},
+			{Name: "failed", States: []string{"FAILED"}, Annotations: map[string]string{"env": "prod"}, DaysToKeep: 365},
+		},
+	}, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.JobRetentionRule{
+		{Name: "load-tests", Queue: "load-test-*", States: []repository.JobState{}, Cutoff: now.AddDate(0, 0, -1)},
+		{Name: "failed", States: []repository.JobState{repository.JobFailed}, Annotations: map[string]string{"env": "prod"},
+			Cutoff: now.AddDate(-1, 0, 0)},
+		{Name: "default", Cutoff: now.AddDate(0, 0, -42)},
+	}, rules)
+}
+
+func TestRetentionRules_RejectsInvalidConfig(t *testing.T) {
+	valid := configuration.RetentionRule{Name: "rule", DaysToKeep: 1}
+	for name, config := range map[string]configuration.PrunerConfig{
+		"days to keep":   {DaysToKeep: 0, BatchSize: 1},
+		"batch size":     {DaysToKeep: 1, BatchSize: 0},
+		"missing name":   {DaysToKeep: 1, BatchSize: 1, Rules: []configuration.RetentionRule{{DaysToKeep: 1}}},
+		"duplicate name": {DaysToKeep: 1, BatchSize: 1, Rules: []configuration.RetentionRule{valid, valid}},
+		"default name":   {DaysToKeep: 1, BatchSize: 1, Rules: []configuration.RetentionRule{{Name: "default", DaysToKeep: 1}}},
+		"rule days":      {DaysToKeep: 1, BatchSize: 1, Rules: []configuration.RetentionRule{{Name: "rule"}}},
+		"unknown state":  {DaysToKeep: 1, BatchSize: 1, Rules: []configuration.RetentionRule{{Name: "rule", DaysToKeep: 1, States: []string{"Done"}}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := RetentionRules(config, now)
+			assert.Error(t, err)
+		})
+	}
+}
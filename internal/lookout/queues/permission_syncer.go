@@ -0,0 +1,68 @@
+package queues
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const getQueueTimeout = 10 * time.Second
+
+// PermissionSyncer copies the owners and permissions of the queues in Lookout from the Armada queue resources, so
+// Lookout shows each principal the queues Armada lets them watch.
+type PermissionSyncer struct {
+	store  repository.QueuePermissionStore
+	client api.SubmitClient
+	clock  repository.Clock
+}
+
+func NewPermissionSyncer(store repository.QueuePermissionStore, client api.SubmitClient, clock repository.Clock) *PermissionSyncer {
+	return &PermissionSyncer{store: store, client: client, clock: clock}
+}
+
+// Sync records the permissions of every queue in Lookout and deletes the permissions of queues deleted in Armada.
+// Queues which can't be read keep their previous permissions until the next sync.
+func (s *PermissionSyncer) Sync() {
+	names, err := s.store.GetQueueNames()
+	if err != nil {
+		log.Errorf("Failed to list queues to sync permissions: %v", err)
+		return
+	}
+
+	synced := s.clock.Now()
+	queues := make([]*api.Queue, 0, len(names))
+	deleted := []string{}
+	for _, name := range names {
+		q, err := s.getQueue(name)
+		if status.Code(err) == codes.NotFound {
+			deleted = append(deleted, name)
+			continue
+		}
+		if err != nil {
+			log.Errorf("Failed to get queue %s from Armada: %v", name, err)
+			continue
+		}
+		queues = append(queues, q)
+	}
+
+	err = s.store.RecordQueuePermissions(queues, synced)
+	if err != nil {
+		log.Errorf("Failed to record queue permissions: %v", err)
+	}
+	err = s.store.DeleteQueuePermissions(deleted)
+	if err != nil {
+		log.Errorf("Failed to delete permissions of deleted queues: %v", err)
+	}
+}
+
+func (s *PermissionSyncer) getQueue(name string) (*api.Queue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), getQueueTimeout)
+	defer cancel()
+	return s.client.GetQueue(ctx, &api.QueueGetRequest{Name: name})
+}
@@ -0,0 +1,77 @@
+package queues
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var syncTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeClock struct{}
+
+func (fakeClock) Now() time.Time {
+	return syncTime
+}
+
+type fakeStore struct {
+	names    []string
+	recorded []*api.Queue
+	synced   time.Time
+	deleted  []string
+}
+
+func (s *fakeStore) GetQueueNames() ([]string, error) {
+	return s.names, nil
+}
+
+func (s *fakeStore) RecordQueuePermissions(queues []*api.Queue, synced time.Time) error {
+	s.recorded = queues
+	s.synced = synced
+	return nil
+}
+
+func (s *fakeStore) DeleteQueuePermissions(names []string) error {
+	s.deleted = names
+	return nil
+}
+
+type fakeSubmitClient struct {
+	api.SubmitClient
+	queues map[string]*api.Queue
+	errors map[string]error
+}
+
+func (c *fakeSubmitClient) GetQueue(_ context.Context, in *api.QueueGetRequest, _ ...grpc.CallOption) (*api.Queue, error) {
+	if err, ok := c.errors[in.Name]; ok {
+		return nil, err
+	}
+	if q, ok := c.queues[in.Name]; ok {
+		return q, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "queue %s does not exist", in.Name)
+}
+
+func TestPermissionSyncer_RecordsPermissionsOfExistingQueues(t *testing.T) {
+	store := &fakeStore{names: []string{"a", "b", "deleted", "unavailable"}}
+	a := &api.Queue{Name: "a", UserOwners: []string{"user"}}
+	b := &api.Queue{Name: "b", GroupOwners: []string{"group"}}
+	client := &fakeSubmitClient{
+		queues: map[string]*api.Queue{"a": a, "b": b},
+		errors: map[string]error{"unavailable": fmt.Errorf("connection refused")},
+	}
+
+	NewPermissionSyncer(store, client, fakeClock{}).Sync()
+
+	assert.Equal(t, []*api.Queue{a, b}, store.recorded)
+	assert.Equal(t, syncTime, store.synced)
+	assert.Equal(t, []string{"deleted"}, store.deleted)
+}
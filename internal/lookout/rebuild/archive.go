@@ -0,0 +1,133 @@
+package rebuild
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// ArchiveSource replays the events of an Armada event archive one job set at a time, in the order the events of
+// each job set were archived. Positions are the escaped queue and job set followed by the id of the event.
+//
+// Events archived after a replay to job sets before its position aren't replayed when continuing from the position, so
+// the archive can't be used to catch up with events published during a rebuild.
+type ArchiveSource struct {
+	archive   *repository.FileEventArchive
+	batchSize int
+}
+
+func NewArchiveSource(directory string, batchSize int) (*ArchiveSource, error) {
+	// NewFileEventArchive creates missing directories, which would replay an empty archive.
+	if _, err := os.Stat(directory); err != nil {
+		return nil, fmt.Errorf("error opening event archive: %v", err)
+	}
+	archive, err := repository.NewFileEventArchive(directory)
+	if err != nil {
+		return nil, err
+	}
+	return &ArchiveSource{archive: archive, batchSize: batchSize}, nil
+}
+
+func (s *ArchiveSource) Name() string {
+	return "archive"
+}
+
+func (s *ArchiveSource) Replay(position string, handle func(batch *Batch) error) error {
+	start, lastId, err := parseArchivePosition(position)
+	if err != nil {
+		return err
+	}
+	jobSets, err := s.archive.ListJobSets()
+	if err != nil {
+		return err
+	}
+
+	for _, jobSet := range jobSets {
+		if position != "" && archivedJobSetBefore(jobSet, start) {
+			continue
+		}
+		if jobSet != start {
+			lastId = ""
+		}
+		err = s.replayJobSet(jobSet, lastId, handle)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayJobSet reads the archive of the job set once, handling its events after lastId in batches.
+func (s *ArchiveSource) replayJobSet(jobSet repository.ArchivedJobSet, lastId string, handle func(batch *Batch) error) error {
+	messages := make([]*eventstream.Message, 0, s.batchSize)
+	flush := func() error {
+		if len(messages) == 0 {
+			return nil
+		}
+		batch := &Batch{Messages: messages, Position: archivePosition(jobSet, lastId), Remaining: -1}
+		messages = make([]*eventstream.Message, 0, s.batchSize)
+		return handle(batch)
+	}
+
+	err := s.archive.ScanEvents(jobSet.Queue, jobSet.JobSetId, lastId, func(m *api.EventStreamMessage) error {
+		messages = append(messages, &eventstream.Message{
+			Id:           m.Id,
+			EventMessage: m.Message,
+			Ack: func() error {
+				return nil
+			},
+		})
+		lastId = m.Id
+		if len(messages) < s.batchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+func (s *ArchiveSource) Sequential() bool {
+	return false
+}
+
+func (s *ArchiveSource) Close() error {
+	return nil
+}
+
+func archivePosition(jobSet repository.ArchivedJobSet, lastId string) string {
+	return url.PathEscape(jobSet.Queue) + "/" + url.PathEscape(jobSet.JobSetId) + "/" + lastId
+}
+
+func parseArchivePosition(position string) (repository.ArchivedJobSet, string, error) {
+	if position == "" {
+		return repository.ArchivedJobSet{}, "", nil
+	}
+	parts := strings.Split(position, "/")
+	if len(parts) != 3 {
+		return repository.ArchivedJobSet{}, "", fmt.Errorf("invalid archive position %q", position)
+	}
+	queue, err := url.PathUnescape(parts[0])
+	if err != nil {
+		return repository.ArchivedJobSet{}, "", fmt.Errorf("invalid archive position %q: %v", position, err)
+	}
+	jobSetId, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return repository.ArchivedJobSet{}, "", fmt.Errorf("invalid archive position %q: %v", position, err)
+	}
+	return repository.ArchivedJobSet{Queue: queue, JobSetId: jobSetId}, parts[2], nil
+}
+
+func archivedJobSetBefore(a, b repository.ArchivedJobSet) bool {
+	if a.Queue != b.Queue {
+		return a.Queue < b.Queue
+	}
+	return a.JobSetId < b.JobSetId
+}
@@ -0,0 +1,182 @@
+package rebuild
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nats-io/nats.go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const jetstreamFetchWait = 5 * time.Second
+
+// JetstreamSource replays the events of the subject of a JetStream stream from its first retained sequence, positions
+// are stream sequences.
+type JetstreamSource struct {
+	conn      *nats.Conn
+	jetstream nats.JetStreamContext
+	stream    string
+	subject   string
+	batchSize int
+}
+
+func NewJetstreamSource(config *configuration.JetstreamConfig, batchSize int) (*JetstreamSource, error) {
+	conn, err := nats.Connect(strings.Join(config.Servers, ","))
+	if err != nil {
+		return nil, err
+	}
+	var opts []nats.JSOpt
+	if config.ConnTimeout > 0 {
+		opts = append(opts, nats.MaxWait(config.ConnTimeout))
+	}
+	jetstream, err := conn.JetStream(opts...)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &JetstreamSource{
+		conn:      conn,
+		jetstream: jetstream,
+		stream:    config.StreamName,
+		subject:   config.Subject,
+		batchSize: batchSize,
+	}, nil
+}
+
+// lastMessageGetter is implemented by the JetStream client, but isn't part of JetStreamContext in this version.
+type lastMessageGetter interface {
+	GetLastMsg(name, subject string, opts ...nats.JSOpt) (*nats.RawStreamMsg, error)
+}
+
+func (s *JetstreamSource) Name() string {
+	return "jetstream"
+}
+
+func (s *JetstreamSource) Replay(position string, handle func(batch *Batch) error) error {
+	start := uint64(1)
+	if position != "" {
+		sequence, err := strconv.ParseUint(position, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid JetStream position %q: %v", position, err)
+		}
+		start = sequence + 1
+	}
+
+	info, err := s.jetstream.StreamInfo(s.stream)
+	if err != nil {
+		return fmt.Errorf("error reading stream %s: %v", s.stream, err)
+	}
+	// The stream may hold other subjects, so the replay ends with the last event of the subject rather than of the stream.
+	lastMessages, ok := s.jetstream.(lastMessageGetter)
+	if !ok {
+		return fmt.Errorf("JetStream client can't read the last event of subject %s", s.subject)
+	}
+	last, err := lastMessages.GetLastMsg(s.stream, s.subject)
+	if errors.Is(err, nats.ErrMsgNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading last event of subject %s: %v", s.subject, err)
+	}
+	end := last.Sequence
+	if start > end {
+		return nil
+	}
+	if start < info.State.FirstSeq {
+		log.Warnf("Events %d to %d of stream %s are no longer retained", start, info.State.FirstSeq-1, s.stream)
+	}
+
+	// Pull consumers need a durable name, it is deleted again when the replay ends.
+	durable := "lookout-rebuild-" + util.NewULID()
+	subscription, err := s.jetstream.PullSubscribe(s.subject, durable,
+		nats.BindStream(s.stream),
+		nats.StartSequence(start),
+		nats.AckExplicit())
+	if err != nil {
+		return fmt.Errorf("error subscribing to stream %s: %v", s.stream, err)
+	}
+	defer func() {
+		if err := subscription.Unsubscribe(); err != nil {
+			log.Warnf("Failed to unsubscribe from stream %s: %v", s.stream, err)
+		}
+		if err := s.jetstream.DeleteConsumer(s.stream, durable); err != nil && !errors.Is(err, nats.ErrConsumerNotFound) {
+			log.Warnf("Failed to delete consumer %s of stream %s: %v", durable, s.stream, err)
+		}
+	}()
+
+	for {
+		msgs, err := subscription.Fetch(s.batchSize, nats.MaxWait(jetstreamFetchWait))
+		if errors.Is(err, nats.ErrTimeout) {
+			return fmt.Errorf("timed out waiting for events of stream %s before sequence %d", s.stream, end)
+		}
+		if err != nil {
+			return fmt.Errorf("error reading stream %s: %v", s.stream, err)
+		}
+
+		batch := &Batch{Messages: make([]*eventstream.Message, 0, len(msgs))}
+		var last uint64
+		for _, msg := range msgs {
+			metadata, err := msg.Metadata()
+			if err != nil {
+				return fmt.Errorf("error reading metadata of event: %v", err)
+			}
+			last = metadata.Sequence.Stream
+			message, err := jetstreamMessage(msg, metadata)
+			if err != nil {
+				return fmt.Errorf("error unmarshalling event %d of stream %s: %v", last, s.stream, err)
+			}
+			batch.Messages = append(batch.Messages, message)
+			// The position of the replay is recorded with the rebuilt database, so events are acknowledged as soon
+			// as they are read and aren't redelivered while a large batch is recorded.
+			if err := msg.Ack(); err != nil {
+				log.Warnf("Failed to acknowledge event %d: %v", last, err)
+			}
+		}
+		batch.Position = strconv.FormatUint(last, 10)
+		batch.Remaining = 0
+		if last < end {
+			batch.Remaining = int64(end - last)
+		}
+
+		err = handle(batch)
+		if err != nil {
+			return err
+		}
+		if last >= end {
+			return nil
+		}
+	}
+}
+
+func jetstreamMessage(msg *nats.Msg, metadata *nats.MsgMetadata) (*eventstream.Message, error) {
+	event := &api.EventMessage{}
+	err := proto.Unmarshal(msg.Data, event)
+	if err != nil {
+		return nil, err
+	}
+	return &eventstream.Message{
+		Id:           eventstream.JetstreamMessageId(metadata.Stream, metadata.Sequence.Stream),
+		EventMessage: event,
+		Ack: func() error {
+			return nil
+		},
+	}, nil
+}
+
+func (s *JetstreamSource) Sequential() bool {
+	return true
+}
+
+func (s *JetstreamSource) Close() error {
+	s.conn.Close()
+	return nil
+}
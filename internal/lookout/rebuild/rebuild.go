@@ -0,0 +1,289 @@
+package rebuild
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/events"
+	"github.com/G-Research/armada/internal/lookout/postgres"
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/internal/lookout/repository/schema"
+)
+
+const defaultSchema = "public"
+
+// retainedTables hold data which isn't recorded from events, so they are copied from the serving schema into the
+// rebuilt schema when switching.
+var retainedTables = []string{"queue_history", "queue"}
+
+var schemaNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// Run rebuilds the Lookout database in a fresh schema by replaying the retained events through the EventProcessor,
+// recording the position of the replay after every batch so an interrupted rebuild continues where it stopped.
+// If config.Rebuild.Switch is set, the serving schema is then replaced with the rebuilt schema in one transaction,
+// and if the source is sequential the events published during the rebuild are replayed into it.
+func Run(config configuration.LookoutConfiguration, now time.Time) error {
+	rebuildConfig := config.Rebuild
+	servingSchema := config.Postgres.Connection["search_path"]
+	if servingSchema == "" {
+		servingSchema = defaultSchema
+	}
+	err := validate(rebuildConfig, servingSchema)
+	if err != nil {
+		return err
+	}
+
+	source, err := newEventSource(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := source.Close(); err != nil {
+			log.Warnf("Failed to close %s event source: %v", source.Name(), err)
+		}
+	}()
+
+	db, err := postgres.Open(config.Postgres)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	_, err = db.Exec("CREATE SCHEMA IF NOT EXISTS " + pq.QuoteIdentifier(rebuildConfig.Schema))
+	if err != nil {
+		return err
+	}
+
+	rebuildDb, err := postgres.Open(withSearchPath(config.Postgres, rebuildConfig.Schema))
+	if err != nil {
+		return err
+	}
+	defer rebuildDb.Close()
+	err = schema.UpdateDatabase(rebuildDb)
+	if err != nil {
+		return err
+	}
+	_, err = rebuildDb.Exec(`CREATE TABLE IF NOT EXISTS rebuild_progress (
+		source   varchar(64) NOT NULL PRIMARY KEY,
+		position text        NOT NULL,
+		updated  timestamp   NOT NULL)`)
+	if err != nil {
+		return err
+	}
+
+	position, err := readPosition(rebuildDb, source.Name())
+	if err != nil {
+		return err
+	}
+	if position == "" {
+		log.Infof("Rebuilding database in schema %s from %s", rebuildConfig.Schema, source.Name())
+	} else {
+		log.Infof("Continuing rebuild of database in schema %s from %s position %s", rebuildConfig.Schema, source.Name(), position)
+	}
+
+	clock := &repository.DefaultClock{}
+	jobStore := repository.NewSQLJobStore(goqu.New("postgres", rebuildDb), config.UIConfig.UserAnnotationPrefix)
+	progress := newProgressReporter(rebuildConfig.ProgressInterval, clock)
+	position, err = replay(source, position, events.NewReplayProcessor(jobStore), progress, func(position string) error {
+		return writePosition(rebuildDb, source.Name(), position, clock.Now())
+	})
+	if err != nil {
+		return err
+	}
+	progress.finish()
+
+	if !rebuildConfig.Switch {
+		log.Infof("Rebuilt database in schema %s, enable rebuild.switch to replace schema %s with it",
+			rebuildConfig.Schema, servingSchema)
+		return nil
+	}
+
+	backupSchema := fmt.Sprintf("%s_backup_%s", servingSchema, now.UTC().Format("20060102150405"))
+	err = switchSchema(db, rebuildConfig.Schema, servingSchema, backupSchema)
+	if err != nil {
+		return err
+	}
+	log.Infof("Replaced schema %s with the rebuilt database, the previous database was kept in schema %s",
+		servingSchema, backupSchema)
+
+	if !source.Sequential() {
+		log.Warnf("Events published to %s during the rebuild can't be replayed, they were only recorded in schema %s",
+			source.Name(), backupSchema)
+		return nil
+	}
+
+	// Events published during the rebuild may have been recorded in the previous schema only. Replaying them is
+	// safe as recording events is idempotent.
+	servingStore := repository.NewSQLJobStore(goqu.New("postgres", db), config.UIConfig.UserAnnotationPrefix)
+	catchUp := newProgressReporter(rebuildConfig.ProgressInterval, clock)
+	_, err = replay(source, position, events.NewReplayProcessor(servingStore), catchUp, nil)
+	if err != nil {
+		return fmt.Errorf("error replaying events published during the rebuild: %v", err)
+	}
+	catchUp.finish()
+	return nil
+}
+
+func validate(config configuration.RebuildConfig, servingSchema string) error {
+	if !schemaNamePattern.MatchString(config.Schema) {
+		return fmt.Errorf("invalid Rebuild.Schema [%v]: must be a lower case identifier", config.Schema)
+	}
+	if config.Schema == servingSchema {
+		return fmt.Errorf("invalid Rebuild.Schema [%v]: must not be the serving schema", config.Schema)
+	}
+	if !schemaNamePattern.MatchString(servingSchema) {
+		return fmt.Errorf("invalid search_path [%v]: must be a single lower case schema", servingSchema)
+	}
+	if config.BatchSize <= 0 {
+		return fmt.Errorf("invalid Rebuild.BatchSize [%v]: must be greater than 0", config.BatchSize)
+	}
+	return nil
+}
+
+func newEventSource(config configuration.LookoutConfiguration) (EventSource, error) {
+	if config.Rebuild.ArchiveDirectory != "" {
+		return NewArchiveSource(config.Rebuild.ArchiveDirectory, config.Rebuild.BatchSize)
+	}
+	if len(config.Jetstream.Servers) > 0 {
+		return NewJetstreamSource(&config.Jetstream, config.Rebuild.BatchSize)
+	}
+	return nil, fmt.Errorf("rebuilding the database requires JetStream or an event archive")
+}
+
+func withSearchPath(config configuration.PostgresConfig, searchPath string) configuration.PostgresConfig {
+	connection := make(map[string]string, len(config.Connection)+1)
+	for key, value := range config.Connection {
+		connection[key] = value
+	}
+	connection["search_path"] = searchPath
+	config.Connection = connection
+	return config
+}
+
+// replay records the events of source after position and returns the position of the last recorded event.
+func replay(
+	source EventSource,
+	position string,
+	processor *events.EventProcessor,
+	progress *progressReporter,
+	recordPosition func(position string) error) (string, error) {
+
+	err := source.Replay(position, func(batch *Batch) error {
+		err := processor.ProcessBatch(batch.Messages)
+		if err != nil {
+			return err
+		}
+		position = batch.Position
+		progress.report(batch)
+		if recordPosition != nil {
+			return recordPosition(position)
+		}
+		return nil
+	})
+	return position, err
+}
+
+func readPosition(db *sql.DB, source string) (string, error) {
+	var position string
+	err := db.QueryRow("SELECT position FROM rebuild_progress WHERE source = $1", source).Scan(&position)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return position, err
+}
+
+func writePosition(db *sql.DB, source string, position string, updated time.Time) error {
+	_, err := db.Exec(`INSERT INTO rebuild_progress (source, position, updated) VALUES ($1, $2, $3)
+		ON CONFLICT (source) DO UPDATE SET position = EXCLUDED.position, updated = EXCLUDED.updated`,
+		source, position, repository.ToUTC(updated))
+	return err
+}
+
+// switchSchema renames the serving schema to backup and the rebuilt schema to serving in one transaction, so the
+// Lookout servers switch from one database to the other without seeing a partial database. The retained tables of the
+// serving schema replace those of the rebuilt schema first.
+func switchSchema(db *sql.DB, rebuilt string, serving string, backup string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var servingExists bool
+	err = tx.QueryRow("SELECT EXISTS (SELECT 1 FROM information_schema.schemata WHERE schema_name = $1)", serving).
+		Scan(&servingExists)
+	if err != nil {
+		return err
+	}
+
+	statements := []string{fmt.Sprintf("DROP TABLE %s.rebuild_progress", pq.QuoteIdentifier(rebuilt))}
+	if servingExists {
+		for _, table := range retainedTables {
+			rebuiltTable := pq.QuoteIdentifier(rebuilt) + "." + pq.QuoteIdentifier(table)
+			servingTable := pq.QuoteIdentifier(serving) + "." + pq.QuoteIdentifier(table)
+			statements = append(statements,
+				"DELETE FROM "+rebuiltTable,
+				fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", rebuiltTable, servingTable))
+		}
+		statements = append(statements,
+			fmt.Sprintf("ALTER SCHEMA %s RENAME TO %s", pq.QuoteIdentifier(serving), pq.QuoteIdentifier(backup)))
+	}
+	statements = append(statements,
+		fmt.Sprintf("ALTER SCHEMA %s RENAME TO %s", pq.QuoteIdentifier(rebuilt), pq.QuoteIdentifier(serving)))
+	for _, statement := range statements {
+		_, err = tx.Exec(statement)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// progressReporter logs the number of replayed events at most once per interval.
+type progressReporter struct {
+	interval   time.Duration
+	clock      repository.Clock
+	started    time.Time
+	lastReport time.Time
+	events     int
+	remaining  int64
+}
+
+func newProgressReporter(interval time.Duration, clock repository.Clock) *progressReporter {
+	now := clock.Now()
+	return &progressReporter{interval: interval, clock: clock, started: now, lastReport: now, remaining: -1}
+}
+
+func (p *progressReporter) report(batch *Batch) {
+	p.events += len(batch.Messages)
+	p.remaining = batch.Remaining
+	now := p.clock.Now()
+	if now.Sub(p.lastReport) < p.interval {
+		return
+	}
+	p.lastReport = now
+	log.Infof("Replayed %s, position %s", p.summary(now), batch.Position)
+}
+
+func (p *progressReporter) finish() {
+	log.Infof("Finished replay, replayed %s", p.summary(p.clock.Now()))
+}
+
+func (p *progressReporter) summary(now time.Time) string {
+	summary := fmt.Sprintf("%d events", p.events)
+	if elapsed := now.Sub(p.started); elapsed > 0 {
+		summary += fmt.Sprintf(" in %s (%.0f events/s)", elapsed.Round(time.Second), float64(p.events)/elapsed.Seconds())
+	}
+	if p.remaining >= 0 {
+		summary += fmt.Sprintf(", %d remaining", p.remaining)
+	}
+	return summary
+}
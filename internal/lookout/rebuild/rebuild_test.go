@@ -0,0 +1,411 @@
+package rebuild
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/proto"
+	"github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats-streaming-server/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/armada/internal/armada/configuration"
+	"github.com/G-Research/armada/internal/armada/repository"
+	"github.com/G-Research/armada/internal/common/eventstream"
+	"github.com/G-Research/armada/internal/common/util"
+	lookoutConfiguration "github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/events"
+	lookoutRepository "github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/internal/lookout/testutil"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+var someTime = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+func TestArchiveSource_ReplaysJobSetsAndContinuesFromPosition(t *testing.T) {
+	directory, err := ioutil.TempDir("", "event-archive")
+	assert.NoError(t, err)
+	defer os.RemoveAll(directory)
+
+	archive, err := repository.NewFileEventArchive(directory)
+	assert.NoError(t, err)
+	err = archive.Archive("queue", "job/set", []*api.EventStreamMessage{
+		{Id: "1-0", Message: createEvent("a")},
+		{Id: "2-0", Message: createEvent("b")},
+		{Id: "3-0", Message: createEvent("c")},
+	})
+	assert.NoError(t, err)
+	err = archive.Archive("other-queue", "job-set", []*api.EventStreamMessage{
+		{Id: "1-0", Message: createEvent("d")},
+	})
+	assert.NoError(t, err)
+
+	source, err := NewArchiveSource(directory, 2)
+	assert.NoError(t, err)
+
+	jobIds, positions := replayAll(t, source, "")
+	assert.Equal(t, []string{"d", "a", "b", "c"}, jobIds)
+	assert.Equal(t, []string{"other-queue/job-set/1-0", "queue/job%2Fset/2-0", "queue/job%2Fset/3-0"}, positions)
+
+	jobIds, _ = replayAll(t, source, "queue/job%2Fset/2-0")
+	assert.Equal(t, []string{"c"}, jobIds)
+}
+
+func TestArchiveSource_ErrorsIfArchiveIsMissing(t *testing.T) {
+	_, err := NewArchiveSource("/missing/event-archive", 10)
+	assert.Error(t, err)
+}
+
+func TestJetstreamSource_ReplaysRetainedEventsAndContinuesFromPosition(t *testing.T) {
+	port := 8372
+	opts := server.DefaultNatsServerOptions
+	opts.Port = port
+	opts.JetStream = true
+	natsServer := test.RunServer(&opts)
+	defer natsServer.Shutdown()
+
+	jetstreamConfig := &configuration.JetstreamConfig{
+		Servers:     []string{fmt.Sprintf("nats://127.0.0.1:%d", port)},
+		StreamName:  "EVENTS",
+		Replicas:    1,
+		Subject:     "EVENTS",
+		MaxAgeDays:  1,
+		ConnTimeout: 10 * time.Second,
+		InMemory:    true,
+	}
+	stream, err := eventstream.NewJetstreamEventStream(jetstreamConfig)
+	assert.NoError(t, err)
+	defer stream.Close()
+	errs := stream.Publish([]*api.EventMessage{createEvent("a"), createEvent("b"), createEvent("c"), createEvent("d"), createEvent("e")})
+	assert.Empty(t, errs)
+
+	source, err := NewJetstreamSource(jetstreamConfig, 2)
+	assert.NoError(t, err)
+	defer source.Close()
+
+	// Publishing is asynchronous, so wait until all events are in the stream.
+	assert.Eventually(t, func() bool {
+		info, err := source.jetstream.StreamInfo("EVENTS")
+		return err == nil && info.State.LastSeq == 5
+	}, 5*time.Second, 10*time.Millisecond)
+
+	jobIds, positions := replayAll(t, source, "")
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, jobIds)
+	assert.Equal(t, []string{"2", "4", "5"}, positions)
+
+	jobIds, _ = replayAll(t, source, "3")
+	assert.Equal(t, []string{"d", "e"}, jobIds)
+
+	jobIds, _ = replayAll(t, source, "5")
+	assert.Empty(t, jobIds)
+}
+
+func TestJetstreamSource_EndsWithLastEventOfSubject(t *testing.T) {
+	source, jetstream, shutdown := newMixedJetstreamSource(t, 8373)
+	defer shutdown()
+
+	publishEvents(t, jetstream, "events", createEvent("a"), createEvent("b"))
+	publishEvents(t, jetstream, "other", createEvent("c"))
+
+	jobIds, positions := replayAll(t, source, "")
+	assert.Equal(t, []string{"a", "b"}, jobIds)
+	assert.Equal(t, []string{"2"}, positions)
+}
+
+func TestJetstreamSource_ErrorsIfEventCanNotBeUnmarshalled(t *testing.T) {
+	source, jetstream, shutdown := newMixedJetstreamSource(t, 8374)
+	defer shutdown()
+
+	publishEvents(t, jetstream, "events", createEvent("a"))
+	_, err := jetstream.Publish("events", []byte{0xff})
+	assert.NoError(t, err)
+
+	replayed := 0
+	err = source.Replay("", func(batch *Batch) error {
+		replayed += len(batch.Messages)
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 0, replayed)
+}
+
+// newMixedJetstreamSource returns a source replaying the subject "events" of a stream which also holds the subject
+// "other".
+func newMixedJetstreamSource(t *testing.T, port int) (*JetstreamSource, nats.JetStreamContext, func()) {
+	opts := server.DefaultNatsServerOptions
+	opts.Port = port
+	opts.JetStream = true
+	natsServer := test.RunServer(&opts)
+
+	source, err := NewJetstreamSource(&configuration.JetstreamConfig{
+		Servers:     []string{fmt.Sprintf("nats://127.0.0.1:%d", port)},
+		StreamName:  "MIXED",
+		Subject:     "events",
+		ConnTimeout: 10 * time.Second,
+	}, 10)
+	require.NoError(t, err)
+	_, err = source.jetstream.AddStream(&nats.StreamConfig{
+		Name:     "MIXED",
+		Subjects: []string{"events", "other"},
+		Storage:  nats.MemoryStorage,
+	})
+	require.NoError(t, err)
+
+	return source, source.jetstream, func() {
+		_ = source.Close()
+		natsServer.Shutdown()
+	}
+}
+
+func publishEvents(t *testing.T, jetstream nats.JetStreamContext, subject string, events ...*api.EventMessage) {
+	for _, event := range events {
+		data, err := proto.Marshal(event)
+		require.NoError(t, err)
+		_, err = jetstream.Publish(subject, data)
+		require.NoError(t, err)
+	}
+}
+
+func TestRun_RebuildsDatabaseAndSwitchesSchema(t *testing.T) {
+	testutil.WithDatabase(t, func(db *sql.DB) {
+		servingStore := lookoutRepository.NewSQLJobStore(goqu.New("postgres", db), "")
+		require.NoError(t, servingStore.RecordEvents([]api.Event{submittedEvent("old")}))
+		_, err := db.Exec(`INSERT INTO queue_history (queue, sampled, queued, pending, running, succeeded, failed)
+			VALUES ('queue', $1, 1, 0, 0, 0, 0)`, someTime)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO queue (name, permissions, synced) VALUES ('queue', '{}', $1)`, someTime)
+		require.NoError(t, err)
+
+		directory := archiveEvents(t, []*api.EventStreamMessage{
+			{Id: "1-0", Message: wrap(t, submittedEvent("new"))},
+			{Id: "2-0", Message: wrap(t, succeededEvent("new"))},
+		})
+		defer os.RemoveAll(directory)
+
+		config := rebuildConfiguration(t, db, directory)
+		config.Rebuild.Switch = true
+		err = Run(config, someTime)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"new"}, selectStrings(t, db, "SELECT job_id FROM public.job"))
+		assert.Equal(t, []string{"old"}, selectStrings(t, db, "SELECT job_id FROM public_backup_20210304050607.job"))
+		assert.Equal(t, []string{"queue"}, selectStrings(t, db, "SELECT queue FROM public.queue_history"))
+		assert.Equal(t, []string{"queue"}, selectStrings(t, db, "SELECT name FROM public.queue"))
+		assert.Empty(t, selectStrings(t, db,
+			"SELECT schema_name FROM information_schema.schemata WHERE schema_name = 'lookout_rebuild'"))
+	})
+}
+
+func TestRun_ContinuesFromRecordedPosition(t *testing.T) {
+	testutil.WithDatabase(t, func(db *sql.DB) {
+		directory := archiveEvents(t, []*api.EventStreamMessage{
+			{Id: "1-0", Message: wrap(t, submittedEvent("a"))},
+		})
+		defer os.RemoveAll(directory)
+		config := rebuildConfiguration(t, db, directory)
+
+		require.NoError(t, Run(config, someTime))
+
+		archive, err := repository.NewFileEventArchive(directory)
+		require.NoError(t, err)
+		require.NoError(t, archive.Archive("queue", "job-set", []*api.EventStreamMessage{
+			{Id: "2-0", Message: wrap(t, submittedEvent("b"))},
+		}))
+		require.NoError(t, Run(config, someTime))
+
+		assert.Equal(t, []string{"a", "b"}, selectStrings(t, db, "SELECT job_id FROM lookout_rebuild.job ORDER BY job_id"))
+		assert.Equal(t, []string{"queue/job-set/2-0"}, selectStrings(t, db, "SELECT position FROM lookout_rebuild.rebuild_progress"))
+		assert.Empty(t, selectStrings(t, db, "SELECT job_id FROM public.job"))
+	})
+}
+
+func TestSwitchSchema_RenamesRebuiltSchemaIfServingSchemaIsMissing(t *testing.T) {
+	testutil.WithDatabase(t, func(db *sql.DB) {
+		_, err := db.Exec("CREATE SCHEMA lookout_rebuild; CREATE TABLE lookout_rebuild.rebuild_progress (source text)")
+		require.NoError(t, err)
+
+		err = switchSchema(db, "lookout_rebuild", "lookout", "lookout_backup")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"lookout"}, selectStrings(t, db,
+			"SELECT schema_name FROM information_schema.schemata WHERE schema_name LIKE 'lookout%'"))
+		assert.Empty(t, selectStrings(t, db,
+			"SELECT table_name FROM information_schema.tables WHERE table_schema = 'lookout'"))
+	})
+}
+
+func TestReplay_RecordsBatchReplayedTwiceOnce(t *testing.T) {
+	testutil.WithDatabase(t, func(db *sql.DB) {
+		jobStore := lookoutRepository.NewSQLJobStore(goqu.New("postgres", db), "")
+		batch := &Batch{Position: "1", Messages: []*eventstream.Message{
+			replayedMessage(t, submittedEvent("job")),
+			replayedMessage(t, runningEvent("job")),
+			replayedMessage(t, succeededEvent("job")),
+		}}
+		source := &repeatingSource{batch: batch, times: 2}
+		clock := &lookoutRepository.DefaultClock{}
+
+		position, err := replay(source, "", events.NewReplayProcessor(jobStore), newProgressReporter(time.Minute, clock), nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "1", position)
+		assert.Equal(t, []string{"job"}, selectStrings(t, db, "SELECT job_id FROM job"))
+		assert.Equal(t, []string{"job"}, selectStrings(t, db, "SELECT job_id FROM job_run WHERE succeeded"))
+	})
+}
+
+// repeatingSource replays the same batch several times, like a replay which is continued from before a batch whose
+// position wasn't recorded.
+type repeatingSource struct {
+	batch *Batch
+	times int
+}
+
+func (s *repeatingSource) Name() string {
+	return "repeating"
+}
+
+func (s *repeatingSource) Replay(position string, handle func(batch *Batch) error) error {
+	for i := 0; i < s.times; i++ {
+		if err := handle(s.batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *repeatingSource) Sequential() bool {
+	return true
+}
+
+func (s *repeatingSource) Close() error {
+	return nil
+}
+
+func rebuildConfiguration(t *testing.T, db *sql.DB, archiveDirectory string) lookoutConfiguration.LookoutConfiguration {
+	var dbName string
+	require.NoError(t, db.QueryRow("SELECT current_database()").Scan(&dbName))
+	return lookoutConfiguration.LookoutConfiguration{
+		Postgres: lookoutConfiguration.PostgresConfig{
+			MaxOpenConns: 2,
+			Connection: map[string]string{
+				"host":     "localhost",
+				"port":     "5432",
+				"user":     "postgres",
+				"password": "psw",
+				"dbname":   dbName,
+				"sslmode":  "disable",
+			},
+		},
+		Rebuild: lookoutConfiguration.RebuildConfig{
+			Schema:           "lookout_rebuild",
+			BatchSize:        10,
+			ArchiveDirectory: archiveDirectory,
+			ProgressInterval: time.Minute,
+		},
+	}
+}
+
+func archiveEvents(t *testing.T, messages []*api.EventStreamMessage) string {
+	directory, err := ioutil.TempDir("", "event-archive")
+	require.NoError(t, err)
+	archive, err := repository.NewFileEventArchive(directory)
+	require.NoError(t, err)
+	require.NoError(t, archive.Archive("queue", "job-set", messages))
+	return directory
+}
+
+func selectStrings(t *testing.T, db *sql.DB, query string) []string {
+	rows, err := db.Query(query)
+	require.NoError(t, err)
+	defer rows.Close()
+	values := []string{}
+	for rows.Next() {
+		var value string
+		require.NoError(t, rows.Scan(&value))
+		values = append(values, value)
+	}
+	require.NoError(t, rows.Err())
+	return values
+}
+
+func replayedMessage(t *testing.T, event api.Event) *eventstream.Message {
+	return &eventstream.Message{
+		Id:           util.NewULID(),
+		EventMessage: wrap(t, event),
+		Ack: func() error {
+			return nil
+		},
+	}
+}
+
+func wrap(t *testing.T, event api.Event) *api.EventMessage {
+	message, err := api.Wrap(event)
+	require.NoError(t, err)
+	return message
+}
+
+func submittedEvent(jobId string) *api.JobSubmittedEvent {
+	return &api.JobSubmittedEvent{JobId: jobId, Queue: "queue", JobSetId: "job-set", Created: someTime, Job: api.Job{
+		Id:       jobId,
+		Queue:    "queue",
+		JobSetId: "job-set",
+		Owner:    "user",
+		Created:  someTime,
+	}}
+}
+
+func runningEvent(jobId string) *api.JobRunningEvent {
+	return &api.JobRunningEvent{JobId: jobId, Queue: "queue", JobSetId: "job-set", Created: someTime.Add(time.Minute),
+		ClusterId: "cluster", KubernetesId: jobId + "-run", NodeName: "node"}
+}
+
+func succeededEvent(jobId string) *api.JobSucceededEvent {
+	return &api.JobSucceededEvent{JobId: jobId, Queue: "queue", JobSetId: "job-set", Created: someTime.Add(2 * time.Minute),
+		ClusterId: "cluster", KubernetesId: jobId + "-run", NodeName: "node"}
+}
+
+func TestValidate(t *testing.T) {
+	valid := lookoutConfiguration.RebuildConfig{Schema: "lookout_rebuild", BatchSize: 100}
+	assert.NoError(t, validate(valid, "public"))
+
+	invalidSchema := valid
+	invalidSchema.Schema = "lookout; DROP TABLE job"
+	assert.Error(t, validate(invalidSchema, "public"))
+
+	assert.Error(t, validate(valid, "lookout_rebuild"))
+	assert.Error(t, validate(valid, "lookout, public"))
+
+	invalidBatchSize := valid
+	invalidBatchSize.BatchSize = 0
+	assert.Error(t, validate(invalidBatchSize, "public"))
+}
+
+func replayAll(t *testing.T, source EventSource, position string) ([]string, []string) {
+	jobIds := []string{}
+	positions := []string{}
+	err := source.Replay(position, func(batch *Batch) error {
+		for _, m := range batch.Messages {
+			jobIds = append(jobIds, m.EventMessage.GetSubmitted().JobId)
+		}
+		positions = append(positions, batch.Position)
+		return nil
+	})
+	assert.NoError(t, err)
+	return jobIds, positions
+}
+
+func createEvent(jobId string) *api.EventMessage {
+	return &api.EventMessage{
+		Events: &api.EventMessage_Submitted{
+			Submitted: &api.JobSubmittedEvent{JobId: jobId, Queue: "queue", JobSetId: "job-set"},
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package rebuild
+
+import (
+	"github.com/G-Research/armada/internal/common/eventstream"
+)
+
+// Batch is a batch of replayed events. Position identifies the last event of the batch, replaying from it continues
+// with the event after the batch.
+type Batch struct {
+	Messages []*eventstream.Message
+	Position string
+	// Remaining is the approximate number of events left to replay, or -1 if it isn't known.
+	Remaining int64
+}
+
+// EventSource replays retained events in order, starting after position or with the first retained event if position
+// is empty. Replay stops after the events which were retained when it started, or when handle returns an error.
+type EventSource interface {
+	Name() string
+	Replay(position string, handle func(batch *Batch) error) error
+	// Sequential reports whether events published after a replay come after its position, so replaying from the
+	// position again returns them.
+	Sequential() bool
+	Close() error
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const (
+	exportCursorName = "lookout_job_export"
+	exportFetchSize  = 1000
+)
+
+type JobExporter interface {
+	ExportJobs(ctx context.Context, opts *lookout.GetJobsRequest, handle func(row *ExportRow) error) error
+}
+
+// ExportRow is a run of a job flattened with the job it belongs to. Jobs without runs are exported as a single row
+// with empty run fields.
+type ExportRow struct {
+	JobId     string
+	Queue     string
+	Owner     string
+	JobSet    string
+	Priority  float64
+	Submitted *time.Time
+	Cancelled *time.Time
+	JobState  string
+	RunId     string
+	PodNumber int32
+	Cluster   string
+	Node      string
+	Created   *time.Time
+	Started   *time.Time
+	Finished  *time.Time
+	Succeeded bool
+	Error     string
+	// ExitCodes of the containers of the run, formatted as name:code and separated by semicolons.
+	ExitCodes string
+}
+
+type exportRow struct {
+	JobId     sql.NullString  `db:"job_id"`
+	Queue     sql.NullString  `db:"queue"`
+	Owner     sql.NullString  `db:"owner"`
+	JobSet    sql.NullString  `db:"jobset"`
+	Priority  sql.NullFloat64 `db:"priority"`
+	Submitted pq.NullTime     `db:"submitted"`
+	Cancelled pq.NullTime     `db:"cancelled"`
+	State     sql.NullInt64   `db:"state"`
+	RunId     sql.NullString  `db:"run_id"`
+	PodNumber sql.NullInt64   `db:"pod_number"`
+	Cluster   sql.NullString  `db:"cluster"`
+	Node      sql.NullString  `db:"node"`
+	Created   pq.NullTime     `db:"created"`
+	Started   pq.NullTime     `db:"started"`
+	Finished  pq.NullTime     `db:"finished"`
+	Succeeded sql.NullBool    `db:"succeeded"`
+	Error     sql.NullString  `db:"error"`
+	ExitCodes sql.NullString  `db:"exit_codes"`
+}
+
+// ExportJobs calls handle with every run of the jobs matching opts, in the requested order of the jobs and then in
+// the order the runs were created. Take and skip are ignored so all matching jobs are exported.
+// Rows are read in batches from a server-side cursor, so only one batch is held in memory at a time.
+func (r *SQLJobRepository) ExportJobs(ctx context.Context, opts *lookout.GetJobsRequest, handle func(row *ExportRow) error) error {
+	if valid, jobState := validateJobStates(opts.JobStates); !valid {
+		return fmt.Errorf("unknown job state: %q", jobState)
+	}
+	ordering, err := getJobOrdering(opts.OrderBy)
+	if err != nil {
+		return err
+	}
+	cursor, err := decodeJobCursor(opts)
+	if err != nil {
+		return err
+	}
+
+	filters := r.createWhereFilters(ctx, opts)
+	if cursor != nil {
+		filters = append(filters, ordering.after(cursor, opts.NewestFirst))
+	}
+
+	query, args, err := r.goquDb.
+		From(jobTable).
+		LeftJoin(jobRunTable, goqu.On(job_jobId.Eq(jobRun_jobId))).
+		Select(job_jobId,
+			job_queue,
+			job_owner,
+			job_jobset,
+			job_priority,
+			job_submitted,
+			job_cancelled,
+			job_state,
+			jobRun_runId,
+			jobRun_podNumber,
+			jobRun_cluster,
+			jobRun_node,
+			jobRun_created,
+			jobRun_started,
+			jobRun_finished,
+			jobRun_succeeded,
+			jobRun_error,
+			goqu.L("(SELECT string_agg(job_run_container.container_name || ':' || job_run_container.exit_code, ';' "+
+				"ORDER BY job_run_container.container_name) FROM job_run_container "+
+				"WHERE job_run_container.run_id = job_run.run_id)").As("exit_codes")).
+		Where(goqu.And(filters...)).
+		Order(append(ordering.orderBy(opts.NewestFirst), jobRun_created.Asc(), jobRun_runId.Asc())...).
+		Prepared(true).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.goquDb.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	// The transaction only reads, so rolling it back just closes the cursor.
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", exportCursorName, query), args...)
+	if err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", exportFetchSize, exportCursorName)
+	for {
+		rows := make([]*exportRow, 0, exportFetchSize)
+		err = tx.ScanStructsContext(ctx, &rows, fetch)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := handle(makeExportRow(row)); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportFetchSize {
+			return nil
+		}
+	}
+}
+
+func makeExportRow(row *exportRow) *ExportRow {
+	state := ""
+	if row.State.Valid {
+		state = string(IntToJobStateMap[int(row.State.Int64)])
+	}
+	return &ExportRow{
+		JobId:     ParseNullString(row.JobId),
+		Queue:     ParseNullString(row.Queue),
+		Owner:     ParseNullString(row.Owner),
+		JobSet:    ParseNullString(row.JobSet),
+		Priority:  ParseNullFloat(row.Priority),
+		Submitted: ParseNullTime(row.Submitted),
+		Cancelled: ParseNullTime(row.Cancelled),
+		JobState:  state,
+		RunId:     ParseNullString(row.RunId),
+		PodNumber: int32(ParseNullInt(row.PodNumber)),
+		Cluster:   ParseNullString(row.Cluster),
+		Node:      ParseNullString(row.Node),
+		Created:   ParseNullTime(row.Created),
+		Started:   ParseNullTime(row.Started),
+		Finished:  ParseNullTime(row.Finished),
+		Succeeded: ParseNullBool(row.Succeeded),
+		Error:     ParseNullString(row.Error),
+		ExitCodes: ParseNullString(row.ExitCodes),
+	}
+}
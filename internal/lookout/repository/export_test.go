@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestExportJobs_FlattensRunsOfMatchingJobs(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		retried := NewJobSimulator(t, jobStore).
+			CreateJobWithId(queue, util.NewULID()).
+			Pending(cluster, k8sId1).
+			Failed(cluster, k8sId1, node, "some error").
+			Pending(cluster, k8sId2).
+			Running(cluster, k8sId2, node)
+		queued := NewJobSimulator(t, jobStore).
+			CreateJobWithId(queue, util.NewULID())
+		NewJobSimulator(t, jobStore).
+			CreateJobWithId("other-queue", util.NewULID())
+
+		rows := []*ExportRow{}
+		err := jobRepo.ExportJobs(ctx, &lookout.GetJobsRequest{Queue: queue, Take: 1}, func(row *ExportRow) error {
+			rows = append(rows, row)
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.Len(t, rows, 3)
+		assert.Equal(t, retried.job.Id, rows[0].JobId)
+		assert.Equal(t, k8sId1, rows[0].RunId)
+		assert.Equal(t, "some error", rows[0].Error)
+		assert.Equal(t, retried.job.Id, rows[1].JobId)
+		assert.Equal(t, k8sId2, rows[1].RunId)
+		assert.Equal(t, string(JobRunning), rows[1].JobState)
+		assert.Equal(t, queued.job.Id, rows[2].JobId)
+		assert.Equal(t, "", rows[2].RunId)
+		assert.Equal(t, string(JobQueued), rows[2].JobState)
+	})
+}
+
+func TestExportJobs_StopsOnHandlerError(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+
+		handled := 0
+		err := jobRepo.ExportJobs(ctx, &lookout.GetJobsRequest{}, func(row *ExportRow) error {
+			handled++
+			return assert.AnError
+		})
+		assert.Equal(t, assert.AnError, err)
+		assert.Equal(t, 1, handled)
+	})
+}
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const failureClusterExamples = 5
+
+// Patterns of the parts of errors which differ between failures with the same cause, in the order they are replaced.
+var errorSignaturePatterns = []struct {
+	pattern     *regexp.Regexp
+	placeholder string
+}{
+	{regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`), "<id>"},
+	{regexp.MustCompile(`(?i)\b[0-9a-hjkmnp-tv-z]{26}\b`), "<id>"},
+	{regexp.MustCompile(`\barmada-<id>-\d+\b`), "<pod>"},
+	{regexp.MustCompile(`\b[a-z0-9]([-a-z0-9]*[a-z0-9])?-[0-9a-f]{8,10}-[a-z0-9]{5}\b`), "<pod>"},
+	{regexp.MustCompile(`(?i)\b[0-9a-f]{12,}\b`), "<id>"},
+	{regexp.MustCompile(`\d+`), "<n>"},
+	{regexp.MustCompile(`\s+`), " "},
+}
+
+type failedRunRow struct {
+	JobId    sql.NullString
+	JobSet   sql.NullString
+	Cluster  sql.NullString
+	Node     sql.NullString
+	Error    sql.NullString
+	Cause    sql.NullString
+	ExitCode sql.NullInt64
+}
+
+type failureClusterKey struct {
+	signature string
+	exitCode  int32
+	cause     string
+}
+
+type failureClusterBuilder struct {
+	cluster  *lookout.FailureCluster
+	jobSets  map[string]bool
+	clusters map[string]bool
+	nodes    map[string]bool
+}
+
+// GetFailureClusters groups the runs which failed in the time range of opts by their error signature, exit code and
+// cause. The exit code of a run is the highest non-zero exit code of its containers.
+// Failed runs are read one at a time, so only the clusters are held in memory.
+func (r *SQLJobRepository) GetFailureClusters(ctx context.Context, opts *lookout.GetFailureClustersRequest) (*lookout.GetFailureClustersResponse, error) {
+	if !opts.From.Before(opts.To) {
+		return nil, fmt.Errorf("invalid time range: %s is not before %s", opts.From, opts.To)
+	}
+
+	filters := []goqu.Expression{
+		jobRun_succeeded.IsFalse(),
+		jobRun_finished.Gte(ToUTC(opts.From)),
+		jobRun_finished.Lt(ToUTC(opts.To)),
+	}
+	if opts.Queue != "" {
+		filters = append(filters, job_queue.Eq(opts.Queue))
+	}
+	if queueFilter, ok := createQueueRestrictionFilter(ctx, job_queue); ok {
+		filters = append(filters, queueFilter)
+	}
+
+	query, args, err := r.goquDb.
+		From(jobRunTable).
+		Join(jobTable, goqu.On(job_jobId.Eq(jobRun_jobId))).
+		Select(
+			job_jobId,
+			job_jobset,
+			jobRun_cluster,
+			jobRun_node,
+			jobRun_error,
+			jobRun_cause,
+			goqu.L("(SELECT MAX(job_run_container.exit_code) FROM job_run_container "+
+				"WHERE job_run_container.run_id = job_run.run_id AND job_run_container.exit_code <> 0)")).
+		Where(filters...).
+		Order(jobRun_finished.Desc()).
+		Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.goquDb.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	builders := map[failureClusterKey]*failureClusterBuilder{}
+	var total uint32
+	for rows.Next() {
+		row := &failedRunRow{}
+		err := rows.Scan(&row.JobId, &row.JobSet, &row.Cluster, &row.Node, &row.Error, &row.Cause, &row.ExitCode)
+		if err != nil {
+			return nil, err
+		}
+		addFailedRun(builders, row)
+		total++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &lookout.GetFailureClustersResponse{
+		Clusters:      failureClusters(builders, total, opts.Take),
+		TotalFailures: total,
+	}, nil
+}
+
+// errorSignature replaces the ids, numbers and pod names in the error of a run, so failures with the same cause have
+// the same signature.
+func errorSignature(err string) string {
+	for _, p := range errorSignaturePatterns {
+		err = p.pattern.ReplaceAllString(err, p.placeholder)
+	}
+	return strings.TrimSpace(err)
+}
+
+func addFailedRun(builders map[failureClusterKey]*failureClusterBuilder, row *failedRunRow) {
+	key := failureClusterKey{
+		signature: errorSignature(ParseNullString(row.Error)),
+		exitCode:  int32(ParseNullInt(row.ExitCode)),
+		cause:     ParseNullString(row.Cause),
+	}
+	builder, ok := builders[key]
+	if !ok {
+		builder = &failureClusterBuilder{
+			cluster: &lookout.FailureCluster{
+				Signature:     key.signature,
+				ExitCode:      key.exitCode,
+				Cause:         key.cause,
+				ExampleError:  ParseNullString(row.Error),
+				ExampleJobIds: []string{},
+			},
+			jobSets:  map[string]bool{},
+			clusters: map[string]bool{},
+			nodes:    map[string]bool{},
+		}
+		builders[key] = builder
+	}
+
+	cluster := builder.cluster
+	cluster.Count++
+	jobId := ParseNullString(row.JobId)
+	if len(cluster.ExampleJobIds) < failureClusterExamples && !util.ContainsString(cluster.ExampleJobIds, jobId) {
+		cluster.ExampleJobIds = append(cluster.ExampleJobIds, jobId)
+	}
+	addIfNotEmpty(builder.jobSets, ParseNullString(row.JobSet))
+	addIfNotEmpty(builder.clusters, ParseNullString(row.Cluster))
+	addIfNotEmpty(builder.nodes, ParseNullString(row.Node))
+}
+
+func failureClusters(builders map[failureClusterKey]*failureClusterBuilder, total uint32, take uint32) []*lookout.FailureCluster {
+	clusters := make([]*lookout.FailureCluster, 0, len(builders))
+	for _, builder := range builders {
+		cluster := builder.cluster
+		cluster.Share = float64(cluster.Count) / float64(total)
+		cluster.JobSets = sortedKeys(builder.jobSets)
+		cluster.Clusters = sortedKeys(builder.clusters)
+		cluster.Nodes = sortedKeys(builder.nodes)
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Count != clusters[j].Count {
+			return clusters[i].Count > clusters[j].Count
+		}
+		if clusters[i].Signature != clusters[j].Signature {
+			return clusters[i].Signature < clusters[j].Signature
+		}
+		if clusters[i].ExitCode != clusters[j].ExitCode {
+			return clusters[i].ExitCode < clusters[j].ExitCode
+		}
+		return clusters[i].Cause < clusters[j].Cause
+	})
+	if take > 0 && len(clusters) > int(take) {
+		clusters = clusters[:take]
+	}
+	return clusters
+}
+
+func addIfNotEmpty(set map[string]bool, value string) {
+	if value != "" {
+		set[value] = true
+	}
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
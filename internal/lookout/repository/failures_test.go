@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestErrorSignature(t *testing.T) {
+	for input, expected := range map[string]string{
+		"Back-off pulling image \"registry/app:1.2.3\"":   "Back-off pulling image \"registry/app:<n>.<n>.<n>\"",
+		"pod armada-01f3j0g1md4qx7z5wb9v9h7aq1-0 failed":  "pod <pod> failed",
+		"pod web-7d9c8b6f5d-x2x9k evicted":                "pod <pod> evicted",
+		"container 3f4e2a1b9c8d7e6f5a4b exited":           "container <id> exited",
+		"job 123e4567-e89b-12d3-a456-426614174000 failed": "job <id> failed",
+		"OOMKilled after   512Mi\n":                       "OOMKilled after <n>Mi",
+	} {
+		assert.Equal(t, expected, errorSignature(input), input)
+	}
+}
+
+func TestErrorSignature_GroupsVariationsOfSameError(t *testing.T) {
+	assert.Equal(t,
+		errorSignature("Failed to pull image for armada-01f3j0g1md4qx7z5wb9v9h7aq1-0: timeout after 30s"),
+		errorSignature("Failed to pull image for armada-01f3j0g2np8yq2a7bc3d4e5f6g-1: timeout after 45s"))
+}
+
+func TestFailureClusters_LargestFirst(t *testing.T) {
+	builders := map[failureClusterKey]*failureClusterBuilder{}
+	for _, row := range []*failedRunRow{
+		failedRun("job-1", "set-a", "cluster-1", "node-1", "ImagePullBackOff for pod 1", "Error", 0),
+		failedRun("job-2", "set-b", "cluster-1", "node-2", "ImagePullBackOff for pod 2", "Error", 0),
+		failedRun("job-2", "set-b", "cluster-1", "node-2", "ImagePullBackOff for pod 3", "Error", 0),
+		failedRun("job-3", "set-a", "cluster-2", "node-3", "OOMKilled", "OOM", 137),
+	} {
+		addFailedRun(builders, row)
+	}
+
+	clusters := failureClusters(builders, 4, 0)
+
+	assert.Equal(t, []*lookout.FailureCluster{
+		{
+			Signature:     "ImagePullBackOff for pod <n>",
+			Cause:         "Error",
+			Count:         3,
+			Share:         0.75,
+			ExampleError:  "ImagePullBackOff for pod 1",
+			ExampleJobIds: []string{"job-1", "job-2"},
+			JobSets:       []string{"set-a", "set-b"},
+			Clusters:      []string{"cluster-1"},
+			Nodes:         []string{"node-1", "node-2"},
+		},
+		{
+			Signature:     "OOMKilled",
+			ExitCode:      137,
+			Cause:         "OOM",
+			Count:         1,
+			Share:         0.25,
+			ExampleError:  "OOMKilled",
+			ExampleJobIds: []string{"job-3"},
+			JobSets:       []string{"set-a"},
+			Clusters:      []string{"cluster-2"},
+			Nodes:         []string{"node-3"},
+		},
+	}, clusters)
+
+	assert.Len(t, failureClusters(builders, 4, 1), 1)
+}
+
+func TestGetFailureClusters(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		fail := func(queue string, jobSetId string, reason string, exitCode int32, cause api.Cause, failed time.Time) {
+			jobId := util.NewULID()
+			k8sId := util.NewULID()
+			job := api.Job{Id: jobId, Queue: queue, JobSetId: jobSetId, Created: someTime}
+			assert.NoError(t, jobStore.RecordEvents([]api.Event{
+				&api.JobSubmittedEvent{JobId: jobId, Queue: queue, JobSetId: jobSetId, Created: someTime, Job: job},
+				&api.JobFailedEvent{JobId: jobId, Queue: queue, JobSetId: jobSetId, Created: failed,
+					ClusterId: cluster, KubernetesId: k8sId, NodeName: node, Reason: reason, Cause: cause,
+					ExitCodes: map[string]int32{"container": exitCode}},
+			}))
+		}
+		fail(queue, "set-a", "Back-off pulling image \"app:1\"", 1, api.Cause_Error, someTime.Add(time.Minute))
+		fail(queue, "set-b", "Back-off pulling image \"app:2\"", 1, api.Cause_Error, someTime.Add(2*time.Minute))
+		fail(queue, "set-a", "OOMKilled", 137, api.Cause_OOM, someTime.Add(3*time.Minute))
+		fail(queue, "set-a", "OOMKilled", 137, api.Cause_OOM, someTime.Add(2*time.Hour))
+		fail("other-queue", "set-a", "OOMKilled", 137, api.Cause_OOM, someTime.Add(time.Minute))
+
+		response, err := jobRepo.GetFailureClusters(ctx, &lookout.GetFailureClustersRequest{
+			Queue: queue,
+			From:  someTime,
+			To:    someTime.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(3), response.TotalFailures)
+		require.Len(t, response.Clusters, 2)
+
+		imagePull := response.Clusters[0]
+		assert.Equal(t, "Back-off pulling image \"app:<n>\"", imagePull.Signature)
+		assert.Equal(t, int32(1), imagePull.ExitCode)
+		assert.Equal(t, "Error", imagePull.Cause)
+		assert.Equal(t, uint32(2), imagePull.Count)
+		assert.Equal(t, []string{"set-a", "set-b"}, imagePull.JobSets)
+		assert.Equal(t, []string{cluster}, imagePull.Clusters)
+		assert.Equal(t, []string{node}, imagePull.Nodes)
+		assert.Len(t, imagePull.ExampleJobIds, 2)
+
+		oom := response.Clusters[1]
+		assert.Equal(t, "OOMKilled", oom.Signature)
+		assert.Equal(t, int32(137), oom.ExitCode)
+		assert.Equal(t, "OOM", oom.Cause)
+		assert.Equal(t, uint32(1), oom.Count)
+	})
+}
+
+func failedRun(jobId string, jobSet string, cluster string, node string, err string, cause string, exitCode int64) *failedRunRow {
+	return &failedRunRow{
+		JobId:    NewNullString(jobId),
+		JobSet:   NewNullString(jobSet),
+		Cluster:  NewNullString(cluster),
+		Node:     NewNullString(node),
+		Error:    NewNullString(err),
+		Cause:    NewNullString(cause),
+		ExitCode: sql.NullInt64{Int64: exitCode, Valid: exitCode != 0},
+	}
+}
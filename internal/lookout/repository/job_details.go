@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+var ErrJobNotFound = errors.New("job not found")
+
+type containerRow struct {
+	RunId      sql.NullString `db:"run_id"`
+	Name       sql.NullString `db:"container_name"`
+	ExitCode   sql.NullInt64  `db:"exit_code"`
+	LogExcerpt sql.NullString `db:"log_excerpt"`
+}
+
+type jobEventRow struct {
+	Created   pq.NullTime    `db:"created"`
+	EventType sql.NullString `db:"event_type"`
+	RunId     sql.NullString `db:"run_id"`
+	Cluster   sql.NullString `db:"cluster"`
+	Node      sql.NullString `db:"node"`
+	PodNumber sql.NullInt64  `db:"pod_number"`
+	Reason    sql.NullString `db:"reason"`
+	Requestor sql.NullString `db:"requestor"`
+}
+
+// GetJobDetails returns the job with all its runs, the exit codes of their containers and the history of the job
+// ordered by time. Returns ErrJobNotFound if there is no job with the given id.
+func (r *SQLJobRepository) GetJobDetails(ctx context.Context, jobId string) (*lookout.GetJobDetailsResponse, error) {
+	jobRows := make([]*JobRow, 0)
+	err := r.goquDb.
+		From(jobTable).
+		LeftJoin(jobRunTable, goqu.On(job_jobId.Eq(jobRun_jobId))).
+		Select(job_jobId,
+			job_queue,
+			job_owner,
+			job_jobset,
+			job_priority,
+			job_submitted,
+			job_cancelled,
+			job_job,
+			job_state,
+			jobRun_runId,
+			jobRun_podNumber,
+			jobRun_cluster,
+			jobRun_node,
+			jobRun_created,
+			jobRun_started,
+			jobRun_finished,
+			jobRun_succeeded,
+			jobRun_error).
+		Where(job_jobId.Eq(jobId)).
+		Prepared(true).ScanStructsContext(ctx, &jobRows)
+	if err != nil {
+		return nil, err
+	}
+
+	jobInfos, err := rowsToJobs(jobRows)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobInfos) == 0 {
+		return nil, ErrJobNotFound
+	}
+
+	containers, err := r.getContainers(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+	events, err := r.getJobEvents(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lookout.GetJobDetailsResponse{
+		JobInfo:    jobInfos[0],
+		Containers: containers,
+		Events:     events,
+	}, nil
+}
+
+func (r *SQLJobRepository) getContainers(ctx context.Context, jobId string) ([]*lookout.ContainerInfo, error) {
+	rows := make([]*containerRow, 0)
+	err := r.goquDb.
+		From(jobRunContainerTable).
+		Join(jobRunTable, goqu.On(jobRunContainer_runId.Eq(jobRun_runId))).
+		Select(jobRunContainer_runId,
+			jobRunContainer_containerName,
+			jobRunContainer_exitCode,
+			jobRunContainer_logExcerpt).
+		Where(jobRun_jobId.Eq(jobId)).
+		Order(jobRunContainer_runId.Asc(), jobRunContainer_containerName.Asc()).
+		Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]*lookout.ContainerInfo, 0, len(rows))
+	for _, row := range rows {
+		containers = append(containers, &lookout.ContainerInfo{
+			RunId:      ParseNullString(row.RunId),
+			Name:       ParseNullString(row.Name),
+			ExitCode:   int32(ParseNullInt(row.ExitCode)),
+			LogExcerpt: ParseNullString(row.LogExcerpt),
+		})
+	}
+	return containers, nil
+}
+
+func (r *SQLJobRepository) getJobEvents(ctx context.Context, jobId string) ([]*lookout.JobEventInfo, error) {
+	rows := make([]*jobEventRow, 0)
+	err := r.goquDb.
+		From(jobEventTable).
+		Select(jobEvent_created,
+			jobEvent_eventType,
+			jobEvent_runId,
+			jobEvent_cluster,
+			jobEvent_node,
+			jobEvent_podNumber,
+			jobEvent_reason,
+			jobEvent_requestor).
+		Where(jobEvent_jobId.Eq(jobId)).
+		Order(jobEvent_created.Asc(), jobEvent_id.Asc()).
+		Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*lookout.JobEventInfo, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, &lookout.JobEventInfo{
+			Created:      ParseNullTimeDefault(row.Created),
+			Type:         ParseNullString(row.EventType),
+			Cluster:      ParseNullString(row.Cluster),
+			KubernetesId: ParseNullString(row.RunId),
+			PodNumber:    int32(ParseNullInt(row.PodNumber)),
+			Node:         ParseNullString(row.Node),
+			Reason:       ParseNullString(row.Reason),
+			Requestor:    ParseNullString(row.Requestor),
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestGetJobDetails_ReturnsRunsContainersAndTimeline(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+		jobId := util.NewULID()
+		job := api.Job{Id: jobId, Queue: queue, JobSetId: "job-set", Priority: 1, Created: someTime}
+
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime, Job: job},
+			&api.JobQueuedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime},
+			&api.JobLeasedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(time.Second), ClusterId: cluster},
+			&api.JobLeaseReturnedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(2 * time.Second),
+				ClusterId: cluster, Reason: "no capacity"},
+			&api.JobPendingEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(3 * time.Second),
+				ClusterId: cluster, KubernetesId: k8sId1},
+			&api.JobRunningEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(4 * time.Second),
+				ClusterId: cluster, KubernetesId: k8sId1, NodeName: node},
+			&api.JobFailedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(5 * time.Second),
+				ClusterId: cluster, KubernetesId: k8sId1, NodeName: node, Reason: "OOMKilled",
+				ExitCodes:         map[string]int32{"container": 137},
+				ContainerStatuses: []*api.ContainerStatus{{Name: "container", ExitCode: 137, LogExcerpt: "out of memory"}}},
+		})
+		assert.NoError(t, err)
+
+		details, err := jobRepo.GetJobDetails(ctx, jobId)
+		require.NoError(t, err)
+
+		AssertJobsAreEquivalent(t, &job, details.JobInfo.Job)
+		assert.Equal(t, string(JobFailed), details.JobInfo.JobState)
+		assert.Len(t, details.JobInfo.Runs, 2)
+
+		assert.Equal(t, []*lookout.ContainerInfo{
+			{RunId: k8sId1, Name: "container", ExitCode: 137, LogExcerpt: "out of memory"},
+		}, details.Containers)
+
+		types := []string{}
+		for _, event := range details.Events {
+			types = append(types, event.Type)
+		}
+		require.Equal(t, []string{"submitted", "queued", "leased", "lease_returned", "pending", "running", "failed"}, types)
+
+		leaseReturned := details.Events[3]
+		assert.Equal(t, cluster, leaseReturned.Cluster)
+		assert.Equal(t, "no capacity", leaseReturned.Reason)
+		AssertTimesApproxEqual(t, timePointer(someTime.Add(2*time.Second)), &leaseReturned.Created)
+
+		failed := details.Events[6]
+		assert.Equal(t, k8sId1, failed.KubernetesId)
+		assert.Equal(t, node, failed.Node)
+		assert.Equal(t, "OOMKilled", failed.Reason)
+	})
+}
+
+func TestGetJobDetails_RecordsRedeliveredEventsOnce(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+		jobId := util.NewULID()
+
+		cancelled := &api.JobCancelledEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime, Requestor: "user"}
+		assert.NoError(t, jobStore.RecordStreamEvents([]api.Event{cancelled}, []string{"events:1"}))
+		assert.NoError(t, jobStore.RecordStreamEvents([]api.Event{cancelled}, []string{"events:1"}))
+
+		details, err := jobRepo.GetJobDetails(ctx, jobId)
+		require.NoError(t, err)
+		require.Len(t, details.Events, 1)
+		assert.Equal(t, "cancelled", details.Events[0].Type)
+		assert.Equal(t, "user", details.Events[0].Requestor)
+	})
+}
+
+func TestGetJobDetails_RecordsDistinctEventsOfSameTypeAndTime(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+		jobId := util.NewULID()
+
+		job := api.Job{Id: jobId, Queue: queue, JobSetId: "job-set", Created: someTime}
+		submitted := &api.JobSubmittedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime, Job: job}
+		returned := &api.JobLeaseReturnedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(time.Second),
+			ClusterId: cluster, Reason: "first"}
+		returnedAgain := &api.JobLeaseReturnedEvent{JobId: jobId, Queue: queue, JobSetId: "job-set", Created: someTime.Add(time.Second),
+			ClusterId: cluster, Reason: "second"}
+		assert.NoError(t, jobStore.RecordStreamEvents([]api.Event{submitted, returned, returnedAgain}, []string{"events:1", "events:2", "events:3"}))
+		assert.NoError(t, jobStore.RecordStreamEvents([]api.Event{returnedAgain}, []string{"events:3"}))
+
+		details, err := jobRepo.GetJobDetails(ctx, jobId)
+		require.NoError(t, err)
+		require.Len(t, details.Events, 3)
+		assert.Equal(t, "first", details.Events[1].Reason)
+		assert.Equal(t, "second", details.Events[2].Reason)
+	})
+}
+
+func TestGetJobDetails_ErrorsIfJobDoesNotExist(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		_, err := jobRepo.GetJobDetails(ctx, util.NewULID())
+		assert.Equal(t, ErrJobNotFound, err)
+	})
+}
+
+func timePointer(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// insertJobEvents records the lifecycle events of jobs in the job_event history table.
+// Events are identified by the id of the stream message they were delivered in, so redelivered events are only
+// recorded once, while distinct events of the same type and time are all recorded.
+func insertJobEvents(tx *goqu.TxDatabase, events []api.Event, messageIds []string) error {
+	var rows []interface{}
+	for i, event := range events {
+		record, ok := jobEventRecord(event)
+		if !ok {
+			continue
+		}
+		record["event_id"] = sql.NullString{}
+		if i < len(messageIds) {
+			record["event_id"] = NewNullString(messageIds[i])
+		}
+		rows = append(rows, record)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err := tx.Insert(jobEventTable).
+		Rows(rows...).
+		OnConflict(goqu.DoNothing()).
+		Prepared(true).Executor().Exec()
+	return err
+}
+
+func jobEventRecord(event api.Event) (goqu.Record, bool) {
+	eventType := jobEventType(event)
+	if eventType == "" {
+		return nil, false
+	}
+
+	// All records have the same columns, so they can be inserted together
+	record := goqu.Record{
+		"job_id":     event.GetJobId(),
+		"created":    ToUTC(event.GetCreated()),
+		"event_type": eventType,
+		"run_id":     "",
+		"pod_number": sql.NullInt32{},
+		"cluster":    sql.NullString{},
+		"node":       sql.NullString{},
+		"requestor":  sql.NullString{},
+	}
+	if kubernetesEvent, ok := event.(api.KubernetesEvent); ok {
+		record["run_id"] = kubernetesEvent.GetKubernetesId()
+		record["pod_number"] = kubernetesEvent.GetPodNumber()
+	}
+	if clusterEvent, ok := event.(interface{ GetClusterId() string }); ok {
+		record["cluster"] = NewNullString(clusterEvent.GetClusterId())
+	}
+	if nodeEvent, ok := event.(interface{ GetNodeName() string }); ok {
+		record["node"] = NewNullString(nodeEvent.GetNodeName())
+	}
+	if requestorEvent, ok := event.(interface{ GetRequestor() string }); ok {
+		record["requestor"] = NewNullString(requestorEvent.GetRequestor())
+	}
+
+	reason := ""
+	switch typed := event.(type) {
+	case interface{ GetReason() string }:
+		reason = typed.GetReason()
+	case *api.JobDuplicateFoundEvent:
+		reason = fmt.Sprintf("duplicate of job %s", typed.OriginalJobId)
+	case *api.JobReprioritizingEvent:
+		reason = fmt.Sprintf("new priority %v", typed.NewPriority)
+	case *api.JobReprioritizedEvent:
+		reason = fmt.Sprintf("new priority %v", typed.NewPriority)
+	}
+	record["reason"] = NewNullString(reason)
+	return record, true
+}
+
+// jobEventType returns the name the event is recorded with in the job history, the same as the name of its
+// event variant, or an empty string if the event is not part of the lifecycle of the job.
+func jobEventType(event api.Event) string {
+	switch event.(type) {
+	case *api.JobSubmittedEvent:
+		return "submitted"
+	case *api.JobQueuedEvent:
+		return "queued"
+	case *api.JobDuplicateFoundEvent:
+		return "duplicate_found"
+	case *api.JobLeasedEvent:
+		return "leased"
+	case *api.JobLeaseReturnedEvent:
+		return "lease_returned"
+	case *api.JobLeaseExpiredEvent:
+		return "lease_expired"
+	case *api.JobPendingEvent:
+		return "pending"
+	case *api.JobRunningEvent:
+		return "running"
+	case *api.JobUnableToScheduleEvent:
+		return "unable_to_schedule"
+	case *api.JobFailedEvent:
+		return "failed"
+	case *api.JobSucceededEvent:
+		return "succeeded"
+	case *api.JobReprioritizingEvent:
+		return "reprioritizing"
+	case *api.JobReprioritizedEvent:
+		return "reprioritized"
+	case *api.JobCancellingEvent:
+		return "cancelling"
+	case *api.JobCancelledEvent:
+		return "cancelled"
+	case *api.JobTerminatedEvent:
+		return "terminated"
+	case *api.JobUpdatedEvent:
+		return "updated"
+	}
+	return ""
+}
+
+// jobStateEvent returns the event which determines the state of the job and its runs for event.
+// A returned lease is recorded as a run which was unable to schedule.
+func jobStateEvent(event api.Event) api.Event {
+	if typed, ok := event.(*api.JobLeaseReturnedEvent); ok {
+		return &api.JobUnableToScheduleEvent{
+			JobId:     typed.JobId,
+			JobSetId:  typed.JobSetId,
+			Queue:     typed.Queue,
+			Created:   typed.Created,
+			ClusterId: typed.ClusterId,
+			Reason:    typed.Reason,
+		}
+	}
+	return event
+}
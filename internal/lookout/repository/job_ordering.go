@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const defaultJobOrder = "job_id"
+
+// jobOrdering sorts jobs by a key, with the job id breaking ties so the order is total and pages can continue after
+// the last job of the previous page. Keys of missing values are replaced so they are never null, and sort first.
+// The keys of job columns match the expressions of the indexes created for them.
+// Only orderings by job columns which never change are stable. The priority of a job changes when it is reprioritized
+// and the keys of the orderings by runs change as runs are updated, so a cursor could skip or repeat jobs. Those
+// orderings can only be paged with skip.
+type jobOrdering struct {
+	key     exp.LiteralExpression
+	sqlType string
+	stable  bool
+}
+
+var jobOrderings = map[string]jobOrdering{
+	"job_id": {
+		key:     goqu.L("job.job_id"),
+		sqlType: "varchar",
+		stable:  true,
+	},
+	"submitted": {
+		key:     goqu.L("COALESCE(job.submitted, '-infinity'::timestamp)"),
+		sqlType: "timestamp",
+		stable:  true,
+	},
+	"priority": {
+		key:     goqu.L("COALESCE(job.priority, 0)"),
+		sqlType: "float",
+	},
+	"started": {
+		key:     goqu.L("COALESCE((SELECT MAX(job_run.started) FROM job_run WHERE job_run.job_id = job.job_id), '-infinity'::timestamp)"),
+		sqlType: "timestamp",
+	},
+	"finished": {
+		key:     goqu.L("COALESCE((SELECT MAX(job_run.finished) FROM job_run WHERE job_run.job_id = job.job_id), '-infinity'::timestamp)"),
+		sqlType: "timestamp",
+	},
+	"cluster": {
+		key:     goqu.L("COALESCE((SELECT MAX(job_run.cluster) FROM job_run WHERE job_run.job_id = job.job_id), '')"),
+		sqlType: "varchar",
+	},
+	"node": {
+		key:     goqu.L("COALESCE((SELECT MAX(job_run.node) FROM job_run WHERE job_run.job_id = job.job_id), '')"),
+		sqlType: "varchar",
+	},
+	"exit_code": {
+		key: goqu.L("COALESCE((SELECT MAX(job_run_container.exit_code) FROM job_run_container " +
+			"JOIN job_run ON job_run_container.run_id = job_run.run_id WHERE job_run.job_id = job.job_id), -2147483648)"),
+		sqlType: "int",
+	},
+	"error": {
+		key:     goqu.L("COALESCE((SELECT MAX(job_run.error) FROM job_run WHERE job_run.job_id = job.job_id), '')"),
+		sqlType: "varchar",
+	},
+}
+
+func getJobOrdering(orderBy string) (jobOrdering, error) {
+	ordering, ok := jobOrderings[orderByOrDefault(orderBy)]
+	if !ok {
+		return jobOrdering{}, fmt.Errorf("unknown job ordering: %q", orderBy)
+	}
+	return ordering, nil
+}
+
+func (o jobOrdering) orderBy(descending bool) []exp.OrderedExpression {
+	if descending {
+		return []exp.OrderedExpression{o.key.Desc(), job_jobId.Desc()}
+	}
+	return []exp.OrderedExpression{o.key.Asc(), job_jobId.Asc()}
+}
+
+// after matches the jobs which come after the job of the cursor.
+func (o jobOrdering) after(cursor *jobCursor, descending bool) goqu.Expression {
+	operator := ">"
+	if descending {
+		operator = "<"
+	}
+	return goqu.L(fmt.Sprintf("(?, ?) %s (CAST(? AS %s), ?)", operator, o.sqlType), o.key, job_jobId, cursor.Key, cursor.JobId)
+}
+
+type jobKey struct {
+	JobId   string         `db:"job_id"`
+	SortKey sql.NullString `db:"sort_key"`
+}
+
+// jobCursor identifies the last job of a page. It is only valid for the ordering it was created with.
+type jobCursor struct {
+	OrderBy     string `json:"orderBy"`
+	NewestFirst bool   `json:"newestFirst"`
+	Key         string `json:"key"`
+	JobId       string `json:"jobId"`
+}
+
+func encodeJobCursor(opts *lookout.GetJobsRequest, last *jobKey) (string, error) {
+	data, err := json.Marshal(&jobCursor{
+		OrderBy:     orderByOrDefault(opts.OrderBy),
+		NewestFirst: opts.NewestFirst,
+		Key:         last.SortKey.String,
+		JobId:       last.JobId,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeJobCursor returns the cursor of the request, or nil if the request is for the first page.
+func decodeJobCursor(opts *lookout.GetJobsRequest) (*jobCursor, error) {
+	if opts.Cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(opts.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+	cursor := &jobCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %v", err)
+	}
+	if cursor.OrderBy != orderByOrDefault(opts.OrderBy) || cursor.NewestFirst != opts.NewestFirst {
+		return nil, fmt.Errorf("invalid cursor: cursor was created for a different ordering")
+	}
+	return cursor, nil
+}
+
+func orderByOrDefault(orderBy string) string {
+	if orderBy == "" {
+		return defaultJobOrder
+	}
+	return orderBy
+}
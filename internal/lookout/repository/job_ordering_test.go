@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestJobCursor_RoundTrip(t *testing.T) {
+	opts := &lookout.GetJobsRequest{OrderBy: "submitted", NewestFirst: true}
+	encoded, err := encodeJobCursor(opts, &jobKey{JobId: "job-id", SortKey: sql.NullString{String: "2021-01-01 00:00:00", Valid: true}})
+	assert.NoError(t, err)
+
+	opts.Cursor = encoded
+	cursor, err := decodeJobCursor(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, &jobCursor{OrderBy: "submitted", NewestFirst: true, Key: "2021-01-01 00:00:00", JobId: "job-id"}, cursor)
+}
+
+func TestJobCursor_RejectsCursorOfDifferentOrdering(t *testing.T) {
+	encoded, err := encodeJobCursor(&lookout.GetJobsRequest{}, &jobKey{JobId: "job-id"})
+	assert.NoError(t, err)
+
+	_, err = decodeJobCursor(&lookout.GetJobsRequest{Cursor: encoded, OrderBy: "priority"})
+	assert.Error(t, err)
+	_, err = decodeJobCursor(&lookout.GetJobsRequest{Cursor: encoded, NewestFirst: true})
+	assert.Error(t, err)
+	cursor, err := decodeJobCursor(&lookout.GetJobsRequest{Cursor: encoded, OrderBy: "job_id"})
+	assert.NoError(t, err)
+	assert.Equal(t, "job-id", cursor.JobId)
+}
+
+func TestJobCursor_RejectsInvalidCursor(t *testing.T) {
+	_, err := decodeJobCursor(&lookout.GetJobsRequest{Cursor: "not a cursor"})
+	assert.Error(t, err)
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	assert.Equal(t, `100\% \_ \\`, escapeLikePattern(`100% _ \`))
+}
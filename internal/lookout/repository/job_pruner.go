@@ -4,17 +4,32 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	log "github.com/sirupsen/logrus"
 )
 
 const postgresFormat = "2006-01-02 15:04:05.000000"
 
+// JobRetentionRule selects the jobs submitted before Cutoff whose queue matches the Queue glob pattern, whose state
+// is one of States and which have all the user Annotations. Empty fields match every job.
+type JobRetentionRule struct {
+	Name        string
+	Queue       string
+	States      []JobState
+	Annotations map[string]string
+	Cutoff      time.Time
+}
+
 // DeleteOldJobs deletes jobs from the database that were submitted before cutoff.
 // The jobs are deleted in batches with the maximum number of jobs being deleted in
 // each batch being given by batchSizeLimit. The tables from which the jobs wil be deleted are:
 // * user_annotation_lookup
+// * job_event
+// * job_resource
+// * job_run_resource
 // * job_run_container
 // * job_run
 // * job
@@ -22,14 +37,127 @@ const postgresFormat = "2006-01-02 15:04:05.000000"
 // For performance reasons we don't use a transaction here and so an error may indicate that
 // Some jobs were deleted.
 func DeleteOldJobs(db *sql.DB, batchSizeLimit int, cutoff time.Time) error {
+	_, err := PruneJobs(db, batchSizeLimit, []JobRetentionRule{{Name: "default", Cutoff: cutoff}}, false)
+	return err
+}
+
+// PruneJobs deletes the jobs selected by the rules in the same way as DeleteOldJobs. Only the first rule matching a
+// job decides whether it is deleted, so jobs matching a rule but submitted after its cutoff are kept even if a later
+// rule would select them. Returns the number of jobs deleted by each rule, or the number which would be deleted if
+// dryRun is set.
+func PruneJobs(db *sql.DB, batchSizeLimit int, rules []JobRetentionRule, dryRun bool) ([]int, error) {
+	dialect := goqu.Dialect("postgres")
+	counts := make([]int, 0, len(rules))
+	previousRules := []goqu.Expression{}
+
+	for _, rule := range rules {
+		match := retentionRuleFilter(dialect, rule)
+		filters := []goqu.Expression{match, job_submitted.Lt(ToUTC(rule.Cutoff))}
+		if len(previousRules) > 0 {
+			// Jobs without a state are unknown to state filters, so earlier rules which can't be evaluated don't match.
+			filters = append(filters, goqu.L("NOT COALESCE(?, false)", goqu.Or(previousRules...)))
+		}
+		ds := dialect.From(jobTable).Where(filters...)
+		previousRules = append(previousRules, match)
+
+		var count int
+		var err error
+		if dryRun {
+			count, err = countJobs(db, ds)
+			if err == nil {
+				log.Infof("Dry run: rule %s would delete %d jobs submitted before %v", rule.Name, count, rule.Cutoff.Format(postgresFormat))
+			}
+		} else {
+			log.Infof("Deleting jobs of rule %s submitted before cutoff=%v, batch size=%v", rule.Name, rule.Cutoff.Format(postgresFormat), batchSizeLimit)
+			count, err = deleteJobs(db, batchSizeLimit, ds)
+			if err == nil {
+				log.Infof("Deleting %d jobs of rule %s finished successfully", count, rule.Name)
+			} else {
+				log.Warnf("Deleting jobs of rule %s failed", rule.Name)
+			}
+		}
+		if err != nil {
+			return counts, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
+
+func retentionRuleFilter(dialect goqu.DialectWrapper, rule JobRetentionRule) goqu.Expression {
+	filters := []goqu.Expression{}
+	if rule.Queue != "" {
+		filters = append(filters, job_queue.Like(globToLikePattern(rule.Queue)))
+	}
+	if len(rule.States) > 0 {
+		filters = append(filters, createJobStateFilter(rule.States))
+	}
+	keys := make([]string, 0, len(rule.Annotations))
+	for key := range rule.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		filters = append(filters, goqu.L("EXISTS ?", dialect.From(userAnnotationLookupTable).
+			Select(goqu.L("1")).
+			Where(
+				annotation_jobId.Eq(job_jobId),
+				annotation_key.Eq(key),
+				annotation_value.Eq(rule.Annotations[key]))))
+	}
+	if len(filters) == 0 {
+		return goqu.L("true")
+	}
+	return goqu.And(filters...)
+}
+
+func countJobs(db *sql.DB, ds *goqu.SelectDataset) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
 
+	query, _, err := ds.Select(goqu.COUNT("*")).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	err = db.QueryRowContext(ctx, query).Scan(&count)
+	return count, err
+}
+
+// deleteJobs deletes the jobs selected by ds in batches and returns how many were selected.
+func deleteJobs(db *sql.DB, batchSizeLimit int, ds *goqu.SelectDataset) (int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
 	defer cancel()
 
 	// This would be much better done as a proper statement with parameters, but postgres doesn't support
-	// parameters if there are multiple statements.
+	// parameters if there are multiple statements, so the selection is interpolated.
+	selection, _, err := ds.Select(job_jobId).ToSQL()
+	if err != nil {
+		return 0, err
+	}
+
+	// Temporary tables only exist in the session which created them, so all statements use the same connection.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	// The connection goes back to the pool, so its temporary tables must not be left behind if any statement fails.
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "DROP TABLE IF EXISTS rows_to_delete; DROP TABLE IF EXISTS batch;")
+	}()
+
+	_, err = conn.ExecContext(ctx, fmt.Sprintf("CREATE TEMP TABLE rows_to_delete AS (%s)", selection))
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	err = conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM rows_to_delete").Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
 	queryText := fmt.Sprintf(`
-				CREATE TEMP TABLE rows_to_delete AS (SELECT job_id FROM job WHERE submitted < '%v');
 				CREATE TEMP TABLE batch (job_id varchar(32));
 				
 				DO
@@ -42,6 +170,9 @@ func DeleteOldJobs(db *sql.DB, batchSizeLimit int, cutoff time.Time) error {
 							GET DIAGNOSTICS rows_in_batch := ROW_COUNT;
 							IF rows_in_batch > 0 THEN
 								DELETE FROM user_annotation_lookup WHERE job_id in (SELECT job_id from batch);
+								DELETE FROM job_event WHERE job_id in (SELECT job_id from batch);
+								DELETE FROM job_resource WHERE job_id in (SELECT job_id from batch);
+								DELETE FROM job_run_resource WHERE run_id in (SELECT run_id from job_run where job_id in (SELECT job_id from batch));
 								DELETE FROM job_run_container WHERE run_id in (SELECT run_id from job_run where job_id in (SELECT job_id from batch));
 								DELETE FROM job_run WHERE job_id in (SELECT job_id from batch);
 								DELETE FROM job WHERE job_id in (SELECT job_id from batch);
@@ -56,14 +187,24 @@ func DeleteOldJobs(db *sql.DB, batchSizeLimit int, cutoff time.Time) error {
 				
 				DROP TABLE rows_to_delete;
 				DROP TABLE batch;
-				`, cutoff.Format(postgresFormat), batchSizeLimit)
-
-	log.Infof("Deleting jobs which haven't changed since cutoff=%v, batch size=%v", cutoff.Format(postgresFormat), batchSizeLimit)
-	_, err := db.ExecContext(ctx, queryText)
-	if err == nil {
-		log.Infof("Deleting jobs finished sucessfully")
-	} else {
-		log.Warnf("Deleting jobs failed")
+				`, batchSizeLimit)
+
+	_, err = conn.ExecContext(ctx, queryText)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteOldQueueHistory deletes the queue history samples taken before cutoff.
+func DeleteOldQueueHistory(db *sql.DB, cutoff time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	log.Infof("Deleting queue history sampled before cutoff=%v", cutoff.Format(postgresFormat))
+	_, err := db.ExecContext(ctx, "DELETE FROM queue_history WHERE sampled < $1", ToUTC(cutoff))
+	if err != nil {
+		log.Warnf("Deleting queue history failed")
 	}
 	return err
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/G-Research/armada/internal/lookout/testutil"
@@ -65,6 +66,22 @@ func Test_BatchSize(t *testing.T) {
 	})
 }
 
+func Test_DropsTemporaryTablesIfDeletionFails(t *testing.T) {
+	withPopulatedDatabase(t, func(db *sql.DB) {
+		// Every statement uses the same connection, so tables left behind would break the next deletion
+		db.SetMaxOpenConns(1)
+		jobs := goqu.Dialect("postgres").From(jobTable).Where(job_submitted.Lt(startDate.AddDate(0, 0, 5)))
+
+		// A negative batch size fails the deletion after the temporary tables were created
+		_, err := deleteJobs(db, -1, jobs)
+		assert.Error(t, err)
+
+		count, err := deleteJobs(db, 10, jobs)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, count)
+	})
+}
+
 func queryForIds(t *testing.T, db *sql.DB, table string) []string {
 	var ids []string
 	rows, err := db.Query(fmt.Sprintf("SELECT job_id from %v", table))
@@ -112,3 +129,60 @@ func withPopulatedDatabase(t *testing.T, action func(db *sql.DB)) {
 		action(db)
 	})
 }
+
+func Test_RetentionRules(t *testing.T) {
+	rules := []JobRetentionRule{
+		{Name: "load-tests", Queue: "load-test-*", Cutoff: startDate.AddDate(0, 0, 9)},
+		{Name: "production", Annotations: map[string]string{"env": "prod"}, Cutoff: startDate.AddDate(0, 0, -1)},
+		{Name: "succeeded", States: []JobState{JobSucceeded}, Cutoff: startDate.AddDate(0, 0, 5)},
+		{Name: "default", Cutoff: startDate.AddDate(0, 0, 2)},
+	}
+
+	t.Run("prune", func(t *testing.T) {
+		withRetentionTestDatabase(t, func(db *sql.DB) {
+			counts, err := PruneJobs(db, 2, rules, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []int{2, 0, 2, 1}, counts)
+			assert.ElementsMatch(t, []string{"failed-3", "failed-4", "prod-1", "prod-4"}, queryForIds(t, db, "job"))
+			assert.ElementsMatch(t, []string{"prod-1", "prod-4"}, queryForIds(t, db, "user_annotation_lookup"))
+		})
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		withRetentionTestDatabase(t, func(db *sql.DB) {
+			counts, err := PruneJobs(db, 2, rules, true)
+			assert.NoError(t, err)
+			assert.Equal(t, []int{2, 0, 2, 1}, counts)
+			assert.Len(t, queryForIds(t, db, "job"), 9)
+		})
+	})
+}
+
+// withRetentionTestDatabase creates jobs submitted on consecutive days in a load test queue, with a production
+// annotation, and which succeeded or failed.
+func withRetentionTestDatabase(t *testing.T, action func(db *sql.DB)) {
+	testutil.WithDatabase(t, func(db *sql.DB) {
+		insert := func(jobId string, queue string, state JobState, day int, annotations map[string]string) {
+			_, err := db.Exec(
+				"INSERT INTO job (job_id, queue, jobset, submitted, state) VALUES ($1, $2, $3, $4, $5)",
+				jobId, queue, "jobset", startDate.AddDate(0, 0, day), JobStateToIntMap[state])
+			assert.NoError(t, err)
+			for key, value := range annotations {
+				_, err := db.Exec(
+					"INSERT INTO user_annotation_lookup (job_id, key, value) VALUES ($1, $2, $3)",
+					jobId, key, value)
+				assert.NoError(t, err)
+			}
+		}
+		insert("load-test-1", "load-test-queue", JobSucceeded, 1, nil)
+		insert("load-test-8", "load-test-queue", JobFailed, 8, nil)
+		insert("prod-1", "queue", JobSucceeded, 1, map[string]string{"env": "prod"})
+		insert("prod-4", "queue", JobFailed, 4, map[string]string{"env": "prod"})
+		insert("succeeded-1", "queue", JobSucceeded, 1, nil)
+		insert("succeeded-4", "queue", JobSucceeded, 4, nil)
+		insert("failed-1", "queue", JobFailed, 1, nil)
+		insert("failed-3", "queue", JobFailed, 3, nil)
+		insert("failed-4", "queue", JobFailed, 4, nil)
+		action(db)
+	})
+}
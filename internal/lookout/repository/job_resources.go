@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"sort"
+
+	"github.com/doug-martin/goqu/v9"
+
+	"github.com/G-Research/armada/internal/common"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// upsertJobResources replaces the resources recorded as requested by the pods of the job.
+func upsertJobResources(tx *goqu.TxDatabase, job *api.Job) error {
+	_, err := tx.Delete(jobResourceTable).Where(jobResource_jobId.Eq(job.Id)).Prepared(true).Executor().Exec()
+	if err != nil {
+		return err
+	}
+	return upsert(tx, jobResourceTable, []string{"job_id", "pod_number", "resource"}, jobResourceRecords(job))
+}
+
+// jobResourceRecords returns the resources requested by each pod of the job, as each pod is a run of its own.
+func jobResourceRecords(job *api.Job) []goqu.Record {
+	var records []goqu.Record
+	for podNumber, podSpec := range job.GetAllPodSpecs() {
+		if podSpec == nil {
+			continue
+		}
+		requests := common.TotalPodResourceRequest(podSpec).AsFloat()
+		resources := make([]string, 0, len(requests))
+		for resource := range requests {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+
+		for _, resource := range resources {
+			records = append(records, goqu.Record{
+				"job_id":     job.Id,
+				"pod_number": podNumber,
+				"resource":   resource,
+				"requested":  requests[resource],
+			})
+		}
+	}
+	return records
+}
+
+// upsertRunResources records the peak usage reported by the utilisation events of runs, keeping the highest usage
+// of each resource.
+func upsertRunResources(tx *goqu.TxDatabase, events []api.Event) error {
+	peaks := map[string]map[string]float64{}
+	var runIds []string
+	for _, event := range events {
+		utilisation, ok := event.(*api.JobUtilisationEvent)
+		if !ok || utilisation.KubernetesId == "" {
+			continue
+		}
+		runPeaks, ok := peaks[utilisation.KubernetesId]
+		if !ok {
+			runPeaks = map[string]float64{}
+			peaks[utilisation.KubernetesId] = runPeaks
+			runIds = append(runIds, utilisation.KubernetesId)
+		}
+		for resource, quantity := range utilisation.MaxResourcesForPeriod {
+			value := common.QuantityAsFloat64(quantity)
+			if current, ok := runPeaks[resource]; !ok || value > current {
+				runPeaks[resource] = value
+			}
+		}
+	}
+
+	var rows []interface{}
+	for _, runId := range runIds {
+		resources := make([]string, 0, len(peaks[runId]))
+		for resource := range peaks[runId] {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+		for _, resource := range resources {
+			rows = append(rows, goqu.Record{
+				"run_id":   runId,
+				"resource": resource,
+				"max_used": peaks[runId][resource],
+			})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err := tx.Insert(jobRunResourceTable).
+		Rows(rows...).
+		OnConflict(goqu.DoUpdate("run_id, resource", goqu.Record{
+			"max_used": goqu.L("GREATEST(job_run_resource.max_used, EXCLUDED.max_used)"),
+		})).
+		Prepared(true).Executor().Exec()
+	return err
+}
@@ -0,0 +1,436 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+const (
+	defaultHistogramBuckets = 10
+	maxHistogramBuckets     = 100
+)
+
+type jobSetAnalyticsRow struct {
+	JobId            sql.NullString
+	JobSet           sql.NullString
+	State            sql.NullInt64
+	Submitted        pq.NullTime
+	Cancelled        pq.NullTime
+	RunId            sql.NullString
+	PodNumber        sql.NullInt64
+	Cluster          sql.NullString
+	Started          pq.NullTime
+	Finished         pq.NullTime
+	Succeeded        sql.NullBool
+	UnableToSchedule sql.NullBool
+}
+
+// jobSetAccumulator collects the statistics of a job set while its jobs are read.
+type jobSetAccumulator struct {
+	analytics    *lookout.JobSetAnalytics
+	waitTimes    []time.Duration
+	runTimes     []time.Duration
+	clusters     map[string]*clusterAccumulator
+	clusterNames []string
+	outcomes     map[time.Time]*lookout.OutcomePoint
+}
+
+type clusterAccumulator struct {
+	analytics *lookout.ClusterAnalytics
+	runTimes  []time.Duration
+}
+
+// jobAccumulator collects the runs of a job, which are read one row at a time.
+// Each pod of a job has runs of its own, so retries are counted per pod.
+type jobAccumulator struct {
+	jobSet       string
+	state        JobState
+	submitted    *time.Time
+	cancelled    *time.Time
+	podRuns      map[int64]uint32
+	firstStarted *time.Time
+	lastFinished *time.Time
+}
+
+// GetJobSetAnalytics returns the outcomes, wait times, run times, retries and cluster breakdown of the job sets of
+// opts, and compares them to the first job set if requested. Durations are held in memory to calculate percentiles,
+// one for each run of the job sets.
+func (r *SQLJobRepository) GetJobSetAnalytics(ctx context.Context, opts *lookout.GetJobSetAnalyticsRequest) (*lookout.GetJobSetAnalyticsResponse, error) {
+	if opts.Queue == "" {
+		return nil, fmt.Errorf("queue is required")
+	}
+	if len(opts.JobSetIds) == 0 {
+		return nil, fmt.Errorf("at least one job set is required")
+	}
+	if opts.Compare && len(opts.JobSetIds) < 2 {
+		return nil, fmt.Errorf("at least two job sets are required to compare job sets")
+	}
+	buckets := int(opts.HistogramBuckets)
+	if buckets == 0 {
+		buckets = defaultHistogramBuckets
+	}
+	if buckets > maxHistogramBuckets {
+		return nil, fmt.Errorf("invalid number of histogram buckets %d: must be at most %d", buckets, maxHistogramBuckets)
+	}
+	var resolution time.Duration
+	if opts.Resolution != nil {
+		var err error
+		resolution, err = types.DurationFromProto(opts.Resolution)
+		if err != nil {
+			return nil, err
+		}
+		if resolution < time.Second {
+			return nil, fmt.Errorf("invalid resolution %s: must be at least one second", resolution)
+		}
+	}
+
+	jobSets := make(map[string]*jobSetAccumulator, len(opts.JobSetIds))
+	jobSetIds := make([]interface{}, 0, len(opts.JobSetIds))
+	for _, jobSetId := range opts.JobSetIds {
+		if _, ok := jobSets[jobSetId]; ok {
+			continue
+		}
+		jobSets[jobSetId] = newJobSetAccumulator(opts.Queue, jobSetId)
+		jobSetIds = append(jobSetIds, jobSetId)
+	}
+
+	query, args, err := r.goquDb.
+		From(jobTable).
+		LeftJoin(jobRunTable, goqu.On(job_jobId.Eq(jobRun_jobId))).
+		Select(
+			job_jobId,
+			job_jobset,
+			job_state,
+			job_submitted,
+			job_cancelled,
+			jobRun_runId,
+			jobRun_podNumber,
+			jobRun_cluster,
+			jobRun_started,
+			jobRun_finished,
+			jobRun_succeeded,
+			jobRun_unableToSchedule).
+		Where(
+			job_queue.Eq(opts.Queue),
+			job_jobset.In(jobSetIds...),
+			goqu.L("? IS DISTINCT FROM ?", job_state, JobStateToIntMap[JobDuplicate])).
+		Order(job_jobId.Asc(), jobRun_created.Asc()).
+		Prepared(true).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.goquDb.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var job *jobAccumulator
+	var jobId string
+	for rows.Next() {
+		row := &jobSetAnalyticsRow{}
+		err := rows.Scan(&row.JobId, &row.JobSet, &row.State, &row.Submitted, &row.Cancelled,
+			&row.RunId, &row.PodNumber, &row.Cluster, &row.Started, &row.Finished, &row.Succeeded, &row.UnableToSchedule)
+		if err != nil {
+			return nil, err
+		}
+		if job == nil || ParseNullString(row.JobId) != jobId {
+			if job != nil {
+				jobSets[job.jobSet].addJob(job, resolution)
+			}
+			jobId = ParseNullString(row.JobId)
+			job = newJobAccumulator(row)
+		}
+		jobSets[job.jobSet].addRun(job, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if job != nil {
+		jobSets[job.jobSet].addJob(job, resolution)
+	}
+
+	response := &lookout.GetJobSetAnalyticsResponse{}
+	for _, jobSetId := range jobSetIds {
+		response.JobSets = append(response.JobSets, jobSets[jobSetId.(string)].result(buckets))
+	}
+	if opts.Compare {
+		baseline := response.JobSets[0]
+		response.Baseline = baseline.JobSet
+		for _, jobSet := range response.JobSets[1:] {
+			response.Comparisons = append(response.Comparisons, compareJobSets(baseline, jobSet))
+		}
+	}
+	return response, nil
+}
+
+func newJobSetAccumulator(queue string, jobSet string) *jobSetAccumulator {
+	return &jobSetAccumulator{
+		analytics: &lookout.JobSetAnalytics{Queue: queue, JobSet: jobSet},
+		clusters:  map[string]*clusterAccumulator{},
+		outcomes:  map[time.Time]*lookout.OutcomePoint{},
+	}
+}
+
+func newJobAccumulator(row *jobSetAnalyticsRow) *jobAccumulator {
+	state := JobState("")
+	if row.State.Valid {
+		state = IntToJobStateMap[int(row.State.Int64)]
+	}
+	return &jobAccumulator{
+		jobSet:    ParseNullString(row.JobSet),
+		state:     state,
+		submitted: ParseNullTime(row.Submitted),
+		cancelled: ParseNullTime(row.Cancelled),
+		podRuns:   map[int64]uint32{},
+	}
+}
+
+func (a *jobSetAccumulator) addRun(job *jobAccumulator, row *jobSetAnalyticsRow) {
+	if !row.RunId.Valid || ParseNullBool(row.UnableToSchedule) {
+		return
+	}
+	job.podRuns[row.PodNumber.Int64]++
+
+	started := ParseNullTime(row.Started)
+	finished := ParseNullTime(row.Finished)
+	if started != nil && (job.firstStarted == nil || started.Before(*job.firstStarted)) {
+		job.firstStarted = started
+	}
+	if finished != nil && (job.lastFinished == nil || finished.After(*job.lastFinished)) {
+		job.lastFinished = finished
+	}
+
+	name := ParseNullString(row.Cluster)
+	cluster, ok := a.clusters[name]
+	if !ok {
+		cluster = &clusterAccumulator{analytics: &lookout.ClusterAnalytics{Cluster: name}}
+		a.clusters[name] = cluster
+		a.clusterNames = append(a.clusterNames, name)
+	}
+	cluster.analytics.Runs++
+	if finished != nil && row.Succeeded.Valid {
+		if row.Succeeded.Bool {
+			cluster.analytics.Succeeded++
+		} else {
+			cluster.analytics.Failed++
+		}
+	}
+	if started != nil && finished != nil {
+		runTime := finished.Sub(*started)
+		a.runTimes = append(a.runTimes, runTime)
+		cluster.runTimes = append(cluster.runTimes, runTime)
+	}
+}
+
+func (a *jobSetAccumulator) addJob(job *jobAccumulator, resolution time.Duration) {
+	analytics := a.analytics
+	analytics.Jobs++
+	retried := false
+	for _, runs := range job.podRuns {
+		if runs > 1 {
+			analytics.Retries += runs - 1
+			retried = true
+		}
+	}
+	if retried {
+		analytics.JobsRetried++
+	}
+	if job.submitted != nil && job.firstStarted != nil {
+		a.waitTimes = append(a.waitTimes, job.firstStarted.Sub(*job.submitted))
+	}
+
+	var finished *time.Time
+	switch job.state {
+	case JobSucceeded:
+		analytics.Succeeded++
+		finished = job.lastFinished
+	case JobFailed:
+		analytics.Failed++
+		finished = job.lastFinished
+	case JobCancelled:
+		analytics.Cancelled++
+		finished = job.cancelled
+	default:
+		return
+	}
+
+	if resolution == 0 || finished == nil {
+		return
+	}
+	bucket := finished.UTC().Truncate(resolution)
+	point, ok := a.outcomes[bucket]
+	if !ok {
+		point = &lookout.OutcomePoint{Time: bucket}
+		a.outcomes[bucket] = point
+	}
+	switch job.state {
+	case JobSucceeded:
+		point.Succeeded++
+	case JobFailed:
+		point.Failed++
+	case JobCancelled:
+		point.Cancelled++
+	}
+}
+
+func (a *jobSetAccumulator) result(buckets int) *lookout.JobSetAnalytics {
+	analytics := a.analytics
+	if finished := analytics.Succeeded + analytics.Failed + analytics.Cancelled; finished > 0 {
+		analytics.SuccessRatio = float64(analytics.Succeeded) / float64(finished)
+		analytics.FailureRatio = float64(analytics.Failed) / float64(finished)
+		analytics.CancelledRatio = float64(analytics.Cancelled) / float64(finished)
+	}
+	analytics.WaitTime = durationDistribution(a.waitTimes, buckets)
+	analytics.RunTime = durationDistribution(a.runTimes, buckets)
+
+	sort.Strings(a.clusterNames)
+	analytics.Clusters = make([]*lookout.ClusterAnalytics, 0, len(a.clusterNames))
+	for _, name := range a.clusterNames {
+		cluster := a.clusters[name]
+		cluster.analytics.RunTime = durationDistribution(cluster.runTimes, buckets)
+		analytics.Clusters = append(analytics.Clusters, cluster.analytics)
+	}
+
+	analytics.Outcomes = make([]*lookout.OutcomePoint, 0, len(a.outcomes))
+	for _, point := range a.outcomes {
+		analytics.Outcomes = append(analytics.Outcomes, point)
+	}
+	sort.Slice(analytics.Outcomes, func(i, j int) bool {
+		return analytics.Outcomes[i].Time.Before(analytics.Outcomes[j].Time)
+	})
+	return analytics
+}
+
+// durationDistribution returns the percentiles of durations, interpolated in the same way as percentile_cont, and a
+// histogram of equally wide buckets from the shortest to the longest duration. Returns nil if there are no durations.
+func durationDistribution(durations []time.Duration, buckets int) *lookout.DurationDistribution {
+	if len(durations) == 0 {
+		return nil
+	}
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var total float64
+	for _, d := range sorted {
+		total += float64(d)
+	}
+	min := sorted[0]
+	max := sorted[len(sorted)-1]
+
+	return &lookout.DurationDistribution{
+		Count:     uint32(len(sorted)),
+		Min:       types.DurationProto(min),
+		Max:       types.DurationProto(max),
+		Mean:      types.DurationProto(time.Duration(total / float64(len(sorted)))),
+		P50:       types.DurationProto(percentile(sorted, 0.5)),
+		P90:       types.DurationProto(percentile(sorted, 0.9)),
+		P99:       types.DurationProto(percentile(sorted, 0.99)),
+		Histogram: histogram(sorted, buckets),
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := p * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+	return sorted[lower] + time.Duration(math.Round(fraction*float64(sorted[upper]-sorted[lower])))
+}
+
+func histogram(sorted []time.Duration, buckets int) []*lookout.HistogramBucket {
+	min := sorted[0]
+	max := sorted[len(sorted)-1]
+	if min == max {
+		return []*lookout.HistogramBucket{{
+			Lower: types.DurationProto(min),
+			Upper: types.DurationProto(max),
+			Count: uint32(len(sorted)),
+		}}
+	}
+
+	width := float64(max-min) / float64(buckets)
+	histogram := make([]*lookout.HistogramBucket, buckets)
+	for i := range histogram {
+		upper := min + time.Duration(float64(i+1)*width)
+		if i == buckets-1 {
+			upper = max
+		}
+		histogram[i] = &lookout.HistogramBucket{
+			Lower: types.DurationProto(min + time.Duration(float64(i)*width)),
+			Upper: types.DurationProto(upper),
+		}
+	}
+	for _, d := range sorted {
+		i := int(float64(d-min) / width)
+		if i >= buckets {
+			i = buckets - 1
+		}
+		histogram[i].Count++
+	}
+	return histogram
+}
+
+func compareJobSets(baseline *lookout.JobSetAnalytics, jobSet *lookout.JobSetAnalytics) *lookout.JobSetComparison {
+	return &lookout.JobSetComparison{
+		JobSet:              jobSet.JobSet,
+		SuccessRatioDelta:   jobSet.SuccessRatio - baseline.SuccessRatio,
+		FailureRatioDelta:   jobSet.FailureRatio - baseline.FailureRatio,
+		CancelledRatioDelta: jobSet.CancelledRatio - baseline.CancelledRatio,
+		RetriesPerJobDelta:  retriesPerJob(jobSet) - retriesPerJob(baseline),
+		WaitTimeP50Delta:    durationDelta(baseline.WaitTime, jobSet.WaitTime, p50),
+		WaitTimeP90Delta:    durationDelta(baseline.WaitTime, jobSet.WaitTime, p90),
+		RunTimeP50Delta:     durationDelta(baseline.RunTime, jobSet.RunTime, p50),
+		RunTimeP90Delta:     durationDelta(baseline.RunTime, jobSet.RunTime, p90),
+		RunTimeP99Delta:     durationDelta(baseline.RunTime, jobSet.RunTime, p99),
+	}
+}
+
+func retriesPerJob(jobSet *lookout.JobSetAnalytics) float64 {
+	if jobSet.Jobs == 0 {
+		return 0
+	}
+	return float64(jobSet.Retries) / float64(jobSet.Jobs)
+}
+
+func p50(d *lookout.DurationDistribution) *types.Duration {
+	return d.P50
+}
+
+func p90(d *lookout.DurationDistribution) *types.Duration {
+	return d.P90
+}
+
+func p99(d *lookout.DurationDistribution) *types.Duration {
+	return d.P99
+}
+
+func durationDelta(baseline *lookout.DurationDistribution, other *lookout.DurationDistribution,
+	value func(*lookout.DurationDistribution) *types.Duration) *types.Duration {
+	if baseline == nil || other == nil {
+		return nil
+	}
+	baselineDuration, err := types.DurationFromProto(value(baseline))
+	if err != nil {
+		return nil
+	}
+	otherDuration, err := types.DurationFromProto(value(other))
+	if err != nil {
+		return nil
+	}
+	return types.DurationProto(otherDuration - baselineDuration)
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestDurationDistribution(t *testing.T) {
+	durations := []time.Duration{}
+	for i := 10; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Second)
+	}
+
+	distribution := durationDistribution(durations, 3)
+
+	assert.Equal(t, uint32(10), distribution.Count)
+	assert.Equal(t, types.DurationProto(time.Second), distribution.Min)
+	assert.Equal(t, types.DurationProto(10*time.Second), distribution.Max)
+	assert.Equal(t, types.DurationProto(5500*time.Millisecond), distribution.Mean)
+	assert.Equal(t, types.DurationProto(5500*time.Millisecond), distribution.P50)
+	assert.Equal(t, types.DurationProto(9100*time.Millisecond), distribution.P90)
+	assert.Equal(t, types.DurationProto(9910*time.Millisecond), distribution.P99)
+	assert.Equal(t, []*lookout.HistogramBucket{
+		{Lower: types.DurationProto(time.Second), Upper: types.DurationProto(4 * time.Second), Count: 3},
+		{Lower: types.DurationProto(4 * time.Second), Upper: types.DurationProto(7 * time.Second), Count: 3},
+		{Lower: types.DurationProto(7 * time.Second), Upper: types.DurationProto(10 * time.Second), Count: 4},
+	}, distribution.Histogram)
+}
+
+func TestDurationDistribution_SingleValue(t *testing.T) {
+	distribution := durationDistribution([]time.Duration{time.Minute, time.Minute}, 10)
+
+	assert.Equal(t, types.DurationProto(time.Minute), distribution.P99)
+	assert.Equal(t, []*lookout.HistogramBucket{
+		{Lower: types.DurationProto(time.Minute), Upper: types.DurationProto(time.Minute), Count: 2},
+	}, distribution.Histogram)
+}
+
+func TestDurationDistribution_Empty(t *testing.T) {
+	assert.Nil(t, durationDistribution(nil, 10))
+}
+
+func TestJobSetAccumulator(t *testing.T) {
+	accumulator := newJobSetAccumulator(queue, "job-set")
+	add := func(state JobState, runs ...*jobSetAnalyticsRow) {
+		job := &jobAccumulator{jobSet: "job-set", state: state, submitted: &someTime, podRuns: map[int64]uint32{}}
+		for _, run := range runs {
+			accumulator.addRun(job, run)
+		}
+		accumulator.addJob(job, time.Hour)
+	}
+	run := func(cluster string, started time.Duration, finished time.Duration, succeeded bool) *jobSetAnalyticsRow {
+		return &jobSetAnalyticsRow{
+			RunId:     NewNullString(cluster + started.String()),
+			Cluster:   NewNullString(cluster),
+			Started:   nullTime(someTime.Add(started)),
+			Finished:  nullTime(someTime.Add(finished)),
+			Succeeded: boolToNullBool(succeeded),
+		}
+	}
+
+	add(JobSucceeded, run("a", time.Minute, 11*time.Minute, true))
+	add(JobFailed,
+		run("a", 2*time.Minute, 3*time.Minute, false),
+		run("b", 4*time.Minute, 5*time.Minute, false))
+	add(JobQueued)
+	// Every pod of a job has a run of its own
+	firstPod := run("b", time.Minute, 2*time.Minute, true)
+	secondPod := run("b", time.Minute, 2*time.Minute, true)
+	secondPod.PodNumber = sql.NullInt64{Int64: 1, Valid: true}
+	add(JobSucceeded, firstPod, secondPod)
+
+	analytics := accumulator.result(10)
+
+	assert.Equal(t, uint32(4), analytics.Jobs)
+	assert.Equal(t, uint32(2), analytics.Succeeded)
+	assert.Equal(t, uint32(1), analytics.Failed)
+	assert.Equal(t, 2.0/3.0, analytics.SuccessRatio)
+	assert.Equal(t, 1.0/3.0, analytics.FailureRatio)
+	assert.Equal(t, uint32(1), analytics.Retries)
+	assert.Equal(t, uint32(1), analytics.JobsRetried)
+	assert.Equal(t, uint32(3), analytics.WaitTime.Count)
+	assert.Equal(t, types.DurationProto(time.Minute), analytics.WaitTime.Min)
+	assert.Equal(t, uint32(5), analytics.RunTime.Count)
+	assert.Equal(t, types.DurationProto(10*time.Minute), analytics.RunTime.Max)
+
+	require.Len(t, analytics.Clusters, 2)
+	assert.Equal(t, "a", analytics.Clusters[0].Cluster)
+	assert.Equal(t, uint32(2), analytics.Clusters[0].Runs)
+	assert.Equal(t, uint32(1), analytics.Clusters[0].Succeeded)
+	assert.Equal(t, uint32(1), analytics.Clusters[0].Failed)
+	assert.Equal(t, "b", analytics.Clusters[1].Cluster)
+	assert.Equal(t, uint32(3), analytics.Clusters[1].Runs)
+
+	assert.Equal(t, []*lookout.OutcomePoint{
+		{Time: someTime.UTC().Truncate(time.Hour), Succeeded: 2, Failed: 1},
+	}, analytics.Outcomes)
+}
+
+func TestCompareJobSets(t *testing.T) {
+	baseline := &lookout.JobSetAnalytics{
+		JobSet:       "baseline",
+		Jobs:         10,
+		Retries:      2,
+		SuccessRatio: 0.9,
+		FailureRatio: 0.1,
+		RunTime:      &lookout.DurationDistribution{P50: types.DurationProto(time.Minute)},
+	}
+	other := &lookout.JobSetAnalytics{
+		JobSet:       "other",
+		Jobs:         10,
+		Retries:      5,
+		SuccessRatio: 0.5,
+		FailureRatio: 0.5,
+		RunTime:      &lookout.DurationDistribution{P50: types.DurationProto(3 * time.Minute)},
+	}
+
+	comparison := compareJobSets(baseline, other)
+
+	assert.Equal(t, "other", comparison.JobSet)
+	assert.InDelta(t, -0.4, comparison.SuccessRatioDelta, 1e-9)
+	assert.InDelta(t, 0.4, comparison.FailureRatioDelta, 1e-9)
+	assert.InDelta(t, 0.3, comparison.RetriesPerJobDelta, 1e-9)
+	assert.Equal(t, types.DurationProto(2*time.Minute), comparison.RunTimeP50Delta)
+	assert.Nil(t, comparison.WaitTimeP50Delta)
+}
+
+func TestGetJobSetAnalytics(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).
+			CreateJobWithJobSet(queue, "job-set-1").
+			Pending(cluster, k8sId1).
+			Running(cluster, k8sId1, node).
+			Succeeded(cluster, k8sId1, node)
+		NewJobSimulator(t, jobStore).
+			CreateJobWithJobSet(queue, "job-set-1").
+			Cancelled()
+		NewJobSimulator(t, jobStore).
+			CreateJobWithJobSet(queue, "job-set-2").
+			Pending(cluster, k8sId2).
+			Running(cluster, k8sId2, node).
+			Failed(cluster, k8sId2, node, "error").
+			Pending(cluster, k8sId3).
+			Running(cluster, k8sId3, node).
+			Failed(cluster, k8sId3, node, "error")
+		NewJobSimulator(t, jobStore).
+			CreateJobWithJobSet("other-queue", "job-set-2").
+			Pending(cluster, k8sId4)
+
+		response, err := jobRepo.GetJobSetAnalytics(ctx, &lookout.GetJobSetAnalyticsRequest{
+			Queue:     queue,
+			JobSetIds: []string{"job-set-1", "job-set-2"},
+			Compare:   true,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, response.JobSets, 2)
+		jobSet1 := response.JobSets[0]
+		assert.Equal(t, "job-set-1", jobSet1.JobSet)
+		assert.Equal(t, uint32(2), jobSet1.Jobs)
+		assert.Equal(t, uint32(1), jobSet1.Succeeded)
+		assert.Equal(t, uint32(1), jobSet1.Cancelled)
+		assert.Equal(t, uint32(1), jobSet1.RunTime.Count)
+
+		jobSet2 := response.JobSets[1]
+		assert.Equal(t, uint32(1), jobSet2.Jobs)
+		assert.Equal(t, uint32(1), jobSet2.Failed)
+		assert.Equal(t, uint32(1), jobSet2.Retries)
+		require.Len(t, jobSet2.Clusters, 1)
+		assert.Equal(t, []*lookout.ClusterAnalytics{{
+			Cluster: cluster,
+			Runs:    2,
+			Failed:  2,
+			RunTime: jobSet2.Clusters[0].RunTime,
+		}}, jobSet2.Clusters)
+
+		assert.Equal(t, "job-set-1", response.Baseline)
+		require.Len(t, response.Comparisons, 1)
+		assert.Equal(t, -0.5, response.Comparisons[0].SuccessRatioDelta)
+	})
+}
+
+func nullTime(t time.Time) pq.NullTime {
+	return pq.NullTime{Time: t, Valid: true}
+}
+
+func boolToNullBool(b bool) sql.NullBool {
+	return sql.NullBool{Bool: b, Valid: true}
+}
@@ -14,11 +14,37 @@ import (
 )
 
 func (r *SQLJobRepository) GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*lookout.JobInfo, error) {
+	page, err := r.GetJobsPage(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return page.JobInfos, nil
+}
+
+// GetJobsPage returns the jobs matching opts in the requested order, with the cursor of the next page if the page is full
+// and the ordering supports cursors.
+func (r *SQLJobRepository) GetJobsPage(ctx context.Context, opts *lookout.GetJobsRequest) (*lookout.GetJobsResponse, error) {
 	if valid, jobState := validateJobStates(opts.JobStates); !valid {
 		return nil, fmt.Errorf("unknown job state: %q", jobState)
 	}
+	ordering, err := getJobOrdering(opts.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeJobCursor(opts)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && !ordering.stable {
+		return nil, fmt.Errorf("invalid cursor: jobs ordered by %q can't be paged with a cursor", opts.OrderBy)
+	}
 
-	rows, err := r.queryJobs(ctx, opts)
+	keys, err := r.queryJobKeys(ctx, opts, ordering, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queryJobs(ctx, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -27,9 +53,16 @@ func (r *SQLJobRepository) GetJobs(ctx context.Context, opts *lookout.GetJobsReq
 	if err != nil {
 		return nil, err
 	}
-	sortJobsByJobId(result, opts.NewestFirst)
+	sortJobsByKeys(result, keys)
 
-	return result, nil
+	response := &lookout.GetJobsResponse{JobInfos: result}
+	if ordering.stable && opts.Take > 0 && len(keys) == int(opts.Take) {
+		response.NextCursor, err = encodeJobCursor(opts, keys[len(keys)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 func validateJobStates(jobStates []string) (bool, JobState) {
@@ -50,11 +83,36 @@ func isJobState(val string) bool {
 	return false
 }
 
-func (r *SQLJobRepository) queryJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*JobRow, error) {
-	ds := r.createJobsDataset(opts)
+// queryJobKeys returns the ids and sort keys of the jobs of the page in order.
+func (r *SQLJobRepository) queryJobKeys(ctx context.Context, opts *lookout.GetJobsRequest, ordering jobOrdering, cursor *jobCursor) ([]*jobKey, error) {
+	filters := r.createWhereFilters(ctx, opts)
+	if cursor != nil {
+		filters = append(filters, ordering.after(cursor, opts.NewestFirst))
+	}
 
+	ds := r.goquDb.
+		From(jobTable).
+		Select(job_jobId, goqu.L("CAST(? AS text)", ordering.key).As("sort_key")).
+		Where(goqu.And(filters...)).
+		Order(ordering.orderBy(opts.NewestFirst)...).
+		Limit(uint(opts.Take)).
+		Offset(uint(opts.Skip))
+
+	keys := make([]*jobKey, 0)
+	err := ds.Prepared(true).ScanStructsContext(ctx, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *SQLJobRepository) queryJobs(ctx context.Context, keys []*jobKey) ([]*JobRow, error) {
 	jobsInQueueRows := make([]*JobRow, 0)
-	err := ds.Prepared(true).ScanStructsContext(ctx, &jobsInQueueRows)
+	if len(keys) == 0 {
+		return jobsInQueueRows, nil
+	}
+
+	err := r.createJobsDataset(keys).Prepared(true).ScanStructsContext(ctx, &jobsInQueueRows)
 	if err != nil {
 		return nil, err
 	}
@@ -62,14 +120,11 @@ func (r *SQLJobRepository) queryJobs(ctx context.Context, opts *lookout.GetJobsR
 	return jobsInQueueRows, nil
 }
 
-func (r *SQLJobRepository) createJobsDataset(opts *lookout.GetJobsRequest) *goqu.SelectDataset {
-	subDs := r.goquDb.
-		From(jobTable).
-		Select(job_jobId).
-		Where(goqu.And(r.createWhereFilters(opts)...)).
-		Order(createJobOrdering(opts.NewestFirst)).
-		Limit(uint(opts.Take)).
-		Offset(uint(opts.Skip))
+func (r *SQLJobRepository) createJobsDataset(keys []*jobKey) *goqu.SelectDataset {
+	jobIds := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		jobIds = append(jobIds, key.JobId)
+	}
 
 	ds := r.goquDb.
 		From(jobTable).
@@ -93,14 +148,18 @@ func (r *SQLJobRepository) createJobsDataset(opts *lookout.GetJobsRequest) *goqu
 			jobRun_finished,
 			jobRun_succeeded,
 			jobRun_error).
-		Where(job_jobId.In(subDs))
+		Where(job_jobId.In(jobIds...))
 
 	return ds
 }
 
-func (r *SQLJobRepository) createWhereFilters(opts *lookout.GetJobsRequest) []goqu.Expression {
+func (r *SQLJobRepository) createWhereFilters(ctx context.Context, opts *lookout.GetJobsRequest) []goqu.Expression {
 	var filters []goqu.Expression
 
+	if queueFilter, ok := createQueueRestrictionFilter(ctx, job_queue); ok {
+		filters = append(filters, queueFilter)
+	}
+
 	if opts.Queue != "" {
 		filters = append(filters, StartsWith(job_queue, opts.Queue))
 	}
@@ -125,6 +184,69 @@ func (r *SQLJobRepository) createWhereFilters(opts *lookout.GetJobsRequest) []go
 		filters = append(filters, createJobStateFilter(defaultQueryStates))
 	}
 
+	filters = append(filters, createTimeRangeFilters(job_submitted, opts.Submitted)...)
+
+	if opts.Priority != nil && opts.Priority.Min != nil {
+		filters = append(filters, job_priority.Gte(*opts.Priority.Min))
+	}
+	if opts.Priority != nil && opts.Priority.Max != nil {
+		filters = append(filters, job_priority.Lte(*opts.Priority.Max))
+	}
+
+	if runFilters := createRunFilters(opts); len(runFilters) > 0 {
+		filters = append(filters, job_jobId.In(
+			r.goquDb.From(jobRunTable).
+				Select(jobRun_jobId).
+				Where(runFilters...)))
+	}
+
+	if len(opts.ExitCodes) > 0 {
+		exitCodes := make([]interface{}, 0, len(opts.ExitCodes))
+		for _, exitCode := range opts.ExitCodes {
+			exitCodes = append(exitCodes, exitCode)
+		}
+		filters = append(filters, job_jobId.In(
+			r.goquDb.From(jobRunContainerTable).
+				Join(jobRunTable, goqu.On(jobRunContainer_runId.Eq(jobRun_runId))).
+				Select(jobRun_jobId).
+				Where(jobRunContainer_exitCode.In(exitCodes...))))
+	}
+
+	return filters
+}
+
+// createRunFilters returns the filters on the runs of a job, a job matches if one of its runs matches all of them.
+func createRunFilters(opts *lookout.GetJobsRequest) []goqu.Expression {
+	var filters []goqu.Expression
+	filters = append(filters, createTimeRangeFilters(jobRun_started, opts.Started)...)
+	filters = append(filters, createTimeRangeFilters(jobRun_finished, opts.Finished)...)
+
+	if opts.Cluster != "" {
+		filters = append(filters, StartsWith(jobRun_cluster, opts.Cluster))
+	}
+
+	if opts.Node != "" {
+		filters = append(filters, StartsWith(jobRun_node, opts.Node))
+	}
+
+	if opts.ErrorContains != "" {
+		filters = append(filters, jobRun_error.ILike("%"+escapeLikePattern(opts.ErrorContains)+"%"))
+	}
+
+	return filters
+}
+
+func createTimeRangeFilters(field exp.IdentifierExpression, timeRange *lookout.TimeRange) []goqu.Expression {
+	var filters []goqu.Expression
+	if timeRange == nil {
+		return filters
+	}
+	if timeRange.From != nil {
+		filters = append(filters, field.Gte(ToUTC(*timeRange.From)))
+	}
+	if timeRange.To != nil {
+		filters = append(filters, field.Lt(ToUTC(*timeRange.To)))
+	}
 	return filters
 }
 
@@ -181,13 +303,6 @@ func createJobStateFilter(jobStates []JobState) goqu.Expression {
 	return job_state.In(stateInts...)
 }
 
-func createJobOrdering(newestFirst bool) exp.OrderedExpression {
-	if newestFirst {
-		return job_jobId.Desc()
-	}
-	return job_jobId.Asc()
-}
-
 func rowsToJobs(rows []*JobRow) ([]*lookout.JobInfo, error) {
 	jobMap := make(map[string]*lookout.JobInfo)
 
@@ -237,13 +352,14 @@ func jobMapToSlice(jobMap map[string]*lookout.JobInfo) []*lookout.JobInfo {
 	return result
 }
 
-func sortJobsByJobId(jobInfos []*lookout.JobInfo, descending bool) {
+// sortJobsByKeys puts the jobs in the order of the keys of the page.
+func sortJobsByKeys(jobInfos []*lookout.JobInfo, keys []*jobKey) {
+	positions := make(map[string]int, len(keys))
+	for i, key := range keys {
+		positions[key.JobId] = i
+	}
 	sort.SliceStable(jobInfos, func(i, j int) bool {
-		if descending {
-			return jobInfos[i].Job.Id > jobInfos[j].Job.Id
-		} else {
-			return jobInfos[i].Job.Id < jobInfos[j].Job.Id
-		}
+		return positions[jobInfos[i].Job.Id] < positions[jobInfos[j].Job.Id]
 	})
 }
 
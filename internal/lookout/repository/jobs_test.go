@@ -1429,3 +1429,206 @@ func TestGetJobs_RemovesDuplicateJobsByDefault(t *testing.T) {
 
 	})
 }
+
+func TestGetJobs_FilterByRunClusterNodeAndTimes(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		startedEarly := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			RunningAtTime("cluster-a", util.NewULID(), "node-1", someTime)
+		startedLate := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			RunningAtTime("cluster-b", util.NewULID(), "node-2", someTime.Add(time.Hour))
+
+		from := someTime.Add(time.Minute)
+		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:    10,
+			Started: &lookout.TimeRange{From: &from},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, startedLate.job, jobInfos[0].Job)
+
+		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:    10,
+			Cluster: "cluster-a",
+			Node:    "node",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, startedEarly.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_FilterByExitCodeAndError(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		oomKilled := NewJobSimulator(t, jobStore).CreateJob(queue)
+		assert.NoError(t, jobStore.RecordJobFailed(&api.JobFailedEvent{
+			JobId:        oomKilled.job.Id,
+			Queue:        queue,
+			Created:      someTime,
+			KubernetesId: util.NewULID(),
+			Reason:       "Container was OOMKilled (100% of memory)",
+			ExitCodes:    map[string]int32{"container": 137},
+		}))
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			Failed(cluster, util.NewULID(), node, "100 of memory")
+
+		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:      10,
+			ExitCodes: []int32{137},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, oomKilled.job, jobInfos[0].Job)
+
+		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:          10,
+			ErrorContains: "oomkilled (100%",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, oomKilled.job, jobInfos[0].Job)
+	})
+}
+
+func TestGetJobs_FilterBySubmittedAndPriority(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).CreateJobAtTime(queue, someTime)
+		submittedLater := NewJobSimulator(t, jobStore).CreateJobAtTime(queue, someTime.Add(time.Hour))
+
+		from := someTime.Add(time.Minute)
+		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:      10,
+			Submitted: &lookout.TimeRange{From: &from},
+			Priority:  &lookout.PriorityRange{Min: floatPointer(10), Max: floatPointer(10)},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		AssertJobsAreEquivalent(t, submittedLater.job, jobInfos[0].Job)
+
+		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:     10,
+			Priority: &lookout.PriorityRange{Min: floatPointer(0), Max: floatPointer(5)},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(jobInfos))
+
+		jobInfos, err = jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:     10,
+			Priority: &lookout.PriorityRange{Min: floatPointer(5)},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(jobInfos))
+	})
+}
+
+func TestGetJobs_OrderByStarted(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		startedLast := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			RunningAtTime(cluster, util.NewULID(), node, someTime.Add(time.Hour))
+		startedFirst := NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			RunningAtTime(cluster, util.NewULID(), node, someTime)
+		notStarted := NewJobSimulator(t, jobStore).CreateJob(queue)
+
+		jobInfos, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:    10,
+			OrderBy: "started",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(jobInfos))
+		AssertJobsAreEquivalent(t, notStarted.job, jobInfos[0].Job)
+		AssertJobsAreEquivalent(t, startedFirst.job, jobInfos[1].Job)
+		AssertJobsAreEquivalent(t, startedLast.job, jobInfos[2].Job)
+	})
+}
+
+func TestGetJobsPage_DoesNotPageUnstableOrderingsWithCursor(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+
+		for _, orderBy := range []string{"started", "priority"} {
+			page, err := jobRepo.GetJobsPage(ctx, &lookout.GetJobsRequest{Take: 1, OrderBy: orderBy})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(page.JobInfos))
+			assert.Empty(t, page.NextCursor)
+
+			cursor, err := encodeJobCursor(&lookout.GetJobsRequest{OrderBy: orderBy}, &jobKey{JobId: util.NewULID()})
+			assert.NoError(t, err)
+			_, err = jobRepo.GetJobsPage(ctx, &lookout.GetJobsRequest{Take: 1, OrderBy: orderBy, Cursor: cursor})
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestGetJobs_ErrorsIfUnknownOrderingIsGiven(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		_, err := jobRepo.GetJobs(ctx, &lookout.GetJobsRequest{
+			Take:    10,
+			OrderBy: "unknown",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetJobsPage_PagesWithCursorAreStableUnderInserts(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		nJobs := 5
+		allJobs := make([]*JobSimulator, nJobs)
+		for i := 0; i < nJobs; i++ {
+			allJobs[i] = NewJobSimulator(t, jobStore).CreateJobAtTime(queue, someTime.Add(time.Duration(i)*time.Minute))
+		}
+
+		request := &lookout.GetJobsRequest{Take: 2, OrderBy: "submitted", NewestFirst: true}
+		page, err := jobRepo.GetJobsPage(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(page.JobInfos))
+		AssertJobsAreEquivalent(t, allJobs[4].job, page.JobInfos[0].Job)
+		AssertJobsAreEquivalent(t, allJobs[3].job, page.JobInfos[1].Job)
+		assert.NotEmpty(t, page.NextCursor)
+
+		// A job submitted after the first page was returned does not shift the following pages
+		NewJobSimulator(t, jobStore).CreateJobAtTime(queue, someTime.Add(time.Hour))
+
+		request.Cursor = page.NextCursor
+		page, err = jobRepo.GetJobsPage(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(page.JobInfos))
+		AssertJobsAreEquivalent(t, allJobs[2].job, page.JobInfos[0].Job)
+		AssertJobsAreEquivalent(t, allJobs[1].job, page.JobInfos[1].Job)
+
+		request.Cursor = page.NextCursor
+		page, err = jobRepo.GetJobsPage(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(page.JobInfos))
+		AssertJobsAreEquivalent(t, allJobs[0].job, page.JobInfos[0].Job)
+		assert.Empty(t, page.NextCursor)
+	})
+}
+
+func floatPointer(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type QueueHistoryRecorder interface {
+	RecordQueueSample(sampled time.Time, since time.Time) error
+}
+
+type queueSampleRow struct {
+	Queue     string `db:"queue"`
+	Queued    uint32 `db:"queued"`
+	Pending   uint32 `db:"pending"`
+	Running   uint32 `db:"running"`
+	Succeeded uint32 `db:"succeeded"`
+	Failed    uint32 `db:"failed"`
+}
+
+type queueHistoryRow struct {
+	Queue     sql.NullString  `db:"queue"`
+	Time      pq.NullTime     `db:"time"`
+	Queued    sql.NullFloat64 `db:"queued"`
+	Pending   sql.NullFloat64 `db:"pending"`
+	Running   sql.NullFloat64 `db:"running"`
+	Succeeded sql.NullInt64   `db:"succeeded"`
+	Failed    sql.NullInt64   `db:"failed"`
+}
+
+// RecordQueueSample records the number of queued, pending and running jobs of each queue at sampled, and the number
+// of jobs which finished since the previous sample. Every queue with jobs in Lookout is recorded, so the times a queue
+// was empty count towards its averages.
+// Samples are only recorded once for each time, so several Lookout instances can sample the same database.
+func (r *SQLJobStore) RecordQueueSample(sampled time.Time, since time.Time) error {
+	samples := map[string]*queueSampleRow{}
+	var queues []string
+	sample := func(queue string) *queueSampleRow {
+		row, ok := samples[queue]
+		if !ok {
+			row = &queueSampleRow{Queue: queue}
+			samples[queue] = row
+			queues = append(queues, queue)
+		}
+		return row
+	}
+
+	knownQueues, err := r.queuesWithJobs()
+	if err != nil {
+		return err
+	}
+	for _, queue := range knownQueues {
+		sample(queue)
+	}
+
+	active := make([]*queueSampleRow, 0)
+	err = r.db.
+		From(jobTable).
+		Select(
+			job_queue,
+			goqu.L("COUNT(*) FILTER (WHERE job.state = ?)", JobStateToIntMap[JobQueued]).As("queued"),
+			goqu.L("COUNT(*) FILTER (WHERE job.state = ?)", JobStateToIntMap[JobPending]).As("pending"),
+			goqu.L("COUNT(*) FILTER (WHERE job.state = ?)", JobStateToIntMap[JobRunning]).As("running")).
+		Where(job_state.In(JobStateToIntMap[JobQueued], JobStateToIntMap[JobPending], JobStateToIntMap[JobRunning])).
+		GroupBy(job_queue).
+		Prepared(true).ScanStructs(&active)
+	if err != nil {
+		return err
+	}
+	for _, row := range active {
+		s := sample(row.Queue)
+		s.Queued, s.Pending, s.Running = row.Queued, row.Pending, row.Running
+	}
+
+	finished := make([]*queueSampleRow, 0)
+	err = r.db.
+		From(jobTable).
+		Join(jobRunTable, goqu.On(job_jobId.Eq(jobRun_jobId))).
+		Select(
+			job_queue,
+			goqu.L("COUNT(DISTINCT job.job_id) FILTER (WHERE job.state = ?)", JobStateToIntMap[JobSucceeded]).As("succeeded"),
+			goqu.L("COUNT(DISTINCT job.job_id) FILTER (WHERE job.state = ?)", JobStateToIntMap[JobFailed]).As("failed")).
+		Where(
+			job_state.In(JobStateToIntMap[JobSucceeded], JobStateToIntMap[JobFailed]),
+			jobRun_finished.Gt(ToUTC(since)),
+			jobRun_finished.Lte(ToUTC(sampled))).
+		GroupBy(job_queue).
+		Prepared(true).ScanStructs(&finished)
+	if err != nil {
+		return err
+	}
+	for _, row := range finished {
+		s := sample(row.Queue)
+		s.Succeeded, s.Failed = row.Succeeded, row.Failed
+	}
+
+	if len(queues) == 0 {
+		return nil
+	}
+	rows := make([]interface{}, 0, len(queues))
+	for _, queue := range queues {
+		s := samples[queue]
+		rows = append(rows, goqu.Record{
+			"queue":     queue,
+			"sampled":   ToUTC(sampled),
+			"queued":    s.Queued,
+			"pending":   s.Pending,
+			"running":   s.Running,
+			"succeeded": s.Succeeded,
+			"failed":    s.Failed,
+		})
+	}
+	_, err = r.db.Insert(queueHistoryTable).
+		Rows(rows...).
+		OnConflict(goqu.DoNothing()).
+		Prepared(true).Executor().Exec()
+	return err
+}
+
+// GetQueueHistory returns the samples of the queues in the time range of opts, averaged over each resolution
+// interval if a resolution is given.
+func (r *SQLJobRepository) GetQueueHistory(ctx context.Context, opts *lookout.GetQueueHistoryRequest) ([]*lookout.QueueHistory, error) {
+	if !opts.From.Before(opts.To) {
+		return nil, fmt.Errorf("invalid time range: %s is not before %s", opts.From, opts.To)
+	}
+	bucket := goqu.L("?", queueHistory_sampled)
+	if opts.Resolution != nil {
+		resolution, err := types.DurationFromProto(opts.Resolution)
+		if err != nil {
+			return nil, err
+		}
+		if resolution < time.Second {
+			return nil, fmt.Errorf("invalid resolution %s: must be at least one second", resolution)
+		}
+		bucket = goqu.L("to_timestamp(floor(extract(epoch FROM ?) / ?) * ?) AT TIME ZONE 'UTC'",
+			queueHistory_sampled, int64(resolution.Seconds()), int64(resolution.Seconds()))
+	}
+
+	filters := []goqu.Expression{
+		queueHistory_sampled.Gte(ToUTC(opts.From)),
+		queueHistory_sampled.Lt(ToUTC(opts.To)),
+	}
+	if opts.Queue != "" {
+		filters = append(filters, queueHistory_queue.Eq(opts.Queue))
+	}
+	if queueFilter, ok := createQueueRestrictionFilter(ctx, queueHistory_queue); ok {
+		filters = append(filters, queueFilter)
+	}
+
+	rows := make([]*queueHistoryRow, 0)
+	err := r.goquDb.
+		From(queueHistoryTable).
+		Select(
+			queueHistory_queue,
+			bucket.As("time"),
+			goqu.AVG(queueHistory_queued).As("queued"),
+			goqu.AVG(queueHistory_pending).As("pending"),
+			goqu.AVG(queueHistory_running).As("running"),
+			goqu.SUM(queueHistory_succeeded).As("succeeded"),
+			goqu.SUM(queueHistory_failed).As("failed")).
+		Where(filters...).
+		GroupBy(queueHistory_queue, goqu.I("time")).
+		Order(queueHistory_queue.Asc(), goqu.I("time").Asc()).
+		Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToQueueHistory(rows), nil
+}
+
+// queuesWithJobs returns the distinct queues of the jobs, skipping from one queue to the next through the queue index
+// rather than reading every job.
+func (r *SQLJobStore) queuesWithJobs() ([]string, error) {
+	queues := []string{}
+	err := r.db.ScanVals(&queues, `
+		WITH RECURSIVE queues AS (
+			(SELECT queue FROM job ORDER BY queue LIMIT 1)
+			UNION ALL
+			SELECT (SELECT job.queue FROM job WHERE job.queue > queues.queue ORDER BY job.queue LIMIT 1)
+			FROM queues
+			WHERE queues.queue IS NOT NULL
+		)
+		SELECT queue FROM queues WHERE queue IS NOT NULL`)
+	return queues, err
+}
+
+func rowsToQueueHistory(rows []*queueHistoryRow) []*lookout.QueueHistory {
+	historyByQueue := map[string]*lookout.QueueHistory{}
+	for _, row := range rows {
+		queue := ParseNullString(row.Queue)
+		history, ok := historyByQueue[queue]
+		if !ok {
+			history = &lookout.QueueHistory{Queue: queue, Points: []*lookout.QueueHistoryPoint{}}
+			historyByQueue[queue] = history
+		}
+		history.Points = append(history.Points, &lookout.QueueHistoryPoint{
+			Time:      ParseNullTimeDefault(row.Time),
+			Queued:    ParseNullFloat(row.Queued),
+			Pending:   ParseNullFloat(row.Pending),
+			Running:   ParseNullFloat(row.Running),
+			Succeeded: uint32(ParseNullInt(row.Succeeded)),
+			Failed:    uint32(ParseNullInt(row.Failed)),
+		})
+	}
+
+	result := make([]*lookout.QueueHistory, 0, len(historyByQueue))
+	for _, history := range historyByQueue {
+		result = append(result, history)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Queue < result[j].Queue
+	})
+	return result
+}
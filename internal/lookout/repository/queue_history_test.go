@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestQueueHistory_RecordsAndDownsamplesSamples(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+		start := someTime.Truncate(time.Hour)
+
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue).Pending(cluster, util.NewULID())
+		NewJobSimulator(t, jobStore).
+			CreateJob(queue).
+			SucceededAtTime(cluster, util.NewULID(), node, start.Add(30*time.Second))
+
+		assert.NoError(t, jobStore.RecordQueueSample(start.Add(time.Minute), start))
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		assert.NoError(t, jobStore.RecordQueueSample(start.Add(2*time.Minute), start.Add(time.Minute)))
+		// Samples are only recorded once
+		assert.NoError(t, jobStore.RecordQueueSample(start.Add(2*time.Minute), start.Add(time.Minute)))
+
+		history, err := jobRepo.GetQueueHistory(ctx, &lookout.GetQueueHistoryRequest{
+			From: start,
+			To:   start.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(history))
+		assert.Equal(t, queue, history[0].Queue)
+		require.Equal(t, 2, len(history[0].Points))
+		assert.Equal(t, 1.0, history[0].Points[0].Queued)
+		assert.Equal(t, 1.0, history[0].Points[0].Pending)
+		assert.Equal(t, uint32(1), history[0].Points[0].Succeeded)
+		assert.Equal(t, 2.0, history[0].Points[1].Queued)
+		assert.Equal(t, uint32(0), history[0].Points[1].Succeeded)
+
+		history, err = jobRepo.GetQueueHistory(ctx, &lookout.GetQueueHistoryRequest{
+			From:       start,
+			To:         start.Add(time.Hour),
+			Resolution: types.DurationProto(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(history))
+		require.Equal(t, 1, len(history[0].Points))
+		AssertTimesApproxEqual(t, &start, &history[0].Points[0].Time)
+		assert.Equal(t, 1.5, history[0].Points[0].Queued)
+		assert.Equal(t, uint32(1), history[0].Points[0].Succeeded)
+	})
+}
+
+func TestQueueHistory_AveragesIncludeSamplesOfEmptyQueues(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+		start := someTime.Truncate(time.Hour)
+
+		job := NewJobSimulator(t, jobStore).CreateJob(queue)
+		assert.NoError(t, jobStore.RecordQueueSample(start.Add(time.Minute), start))
+		job.Cancelled()
+		assert.NoError(t, jobStore.RecordQueueSample(start.Add(2*time.Minute), start.Add(time.Minute)))
+
+		history, err := jobRepo.GetQueueHistory(ctx, &lookout.GetQueueHistoryRequest{
+			From:       start,
+			To:         start.Add(time.Hour),
+			Resolution: types.DurationProto(time.Hour),
+		})
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Len(t, history[0].Points, 1)
+		assert.Equal(t, 0.5, history[0].Points[0].Queued)
+	})
+}
+
+func TestQueueHistory_ErrorsIfResolutionIsTooSmall(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		_, err := jobRepo.GetQueueHistory(ctx, &lookout.GetQueueHistoryRequest{
+			From:       someTime,
+			To:         someTime.Add(time.Hour),
+			Resolution: types.DurationProto(time.Millisecond),
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+// QueuePermissionStore records the owners and permissions of the queues in Lookout, as defined by the Armada queue
+// resources.
+type QueuePermissionStore interface {
+	GetQueueNames() ([]string, error)
+	RecordQueuePermissions(queues []*api.Queue, synced time.Time) error
+	DeleteQueuePermissions(names []string) error
+}
+
+type queuePermissionsRow struct {
+	Name        string `db:"name"`
+	Permissions []byte `db:"permissions"`
+}
+
+type restrictedQueuesKey struct{}
+
+// RestrictToQueues returns a context which limits the jobs, usage, failures and queue history read with it to the
+// given queues.
+func RestrictToQueues(ctx context.Context, queues []string) context.Context {
+	return context.WithValue(ctx, restrictedQueuesKey{}, queues)
+}
+
+// RestrictedQueues returns the queues ctx limits jobs to, and false if jobs of every queue can be read.
+func RestrictedQueues(ctx context.Context) ([]string, bool) {
+	queues, ok := ctx.Value(restrictedQueuesKey{}).([]string)
+	return queues, ok
+}
+
+// createQueueRestrictionFilter returns the filter of queueColumn limiting it to the queues ctx is restricted to.
+func createQueueRestrictionFilter(ctx context.Context, queueColumn exp.IdentifierExpression) (goqu.Expression, bool) {
+	queues, ok := RestrictedQueues(ctx)
+	if !ok {
+		return nil, false
+	}
+	if len(queues) == 0 {
+		return goqu.L("FALSE"), true
+	}
+	return queueColumn.In(queues), true
+}
+
+// GetQueueNames returns the queues with jobs in Lookout and the queues with recorded permissions.
+func (r *SQLJobStore) GetQueueNames() ([]string, error) {
+	names := []string{}
+	err := r.db.
+		From(jobTable).
+		Select(job_queue).
+		Union(r.db.From(queueTable).Select(queue_name)).
+		ScanVals(&names)
+	return names, err
+}
+
+// RecordQueuePermissions replaces the owners and permissions of the queues.
+func (r *SQLJobStore) RecordQueuePermissions(queues []*api.Queue, synced time.Time) error {
+	records := make([]goqu.Record, 0, len(queues))
+	for _, q := range queues {
+		permissions, err := json.Marshal(&api.Queue{
+			Name:        q.Name,
+			UserOwners:  q.UserOwners,
+			GroupOwners: q.GroupOwners,
+			Permissions: q.Permissions,
+		})
+		if err != nil {
+			return err
+		}
+		records = append(records, goqu.Record{
+			"name":        q.Name,
+			"permissions": string(permissions),
+			"synced":      ToUTC(synced),
+		})
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	return tx.Wrap(func() error {
+		return upsert(tx, queueTable, []string{"name"}, records)
+	})
+}
+
+// DeleteQueuePermissions deletes the permissions of queues which no longer exist in Armada.
+func (r *SQLJobStore) DeleteQueuePermissions(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	_, err := r.db.Delete(queueTable).
+		Where(queue_name.In(names)).
+		Prepared(true).Executor().Exec()
+	return err
+}
+
+// GetQueuePermissions returns the owners and permissions of the queues synced from Armada. Queues which haven't been
+// synced yet aren't returned.
+func (r *SQLJobRepository) GetQueuePermissions(ctx context.Context) ([]*api.Queue, error) {
+	rows := make([]*queuePermissionsRow, 0)
+	err := r.goquDb.
+		From(queueTable).
+		Select(queue_name, queue_permissions).
+		Order(queue_name.Asc()).
+		Prepared(true).ScanStructsContext(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	queues := make([]*api.Queue, 0, len(rows))
+	for _, row := range rows {
+		q := &api.Queue{}
+		if err := json.Unmarshal(row.Permissions, q); err != nil {
+			return nil, err
+		}
+		q.Name = row.Name
+		queues = append(queues, q)
+	}
+	return queues, nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestQueuePermissions_RecordsAndDeletesPermissions(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue2)
+
+		names, err := jobStore.GetQueueNames()
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{queue, queue2}, names)
+
+		permissions := []*api.Queue_Permissions{{
+			Subjects: []*api.Queue_Permissions_Subject{{Kind: "Group", Name: "team"}},
+			Verbs:    []string{"watch"},
+		}}
+		err = jobStore.RecordQueuePermissions([]*api.Queue{
+			{Name: queue, UserOwners: []string{"user"}, Permissions: permissions, PriorityFactor: 2},
+			{Name: queue2, GroupOwners: []string{"group"}},
+		}, someTime)
+		assert.NoError(t, err)
+		err = jobStore.RecordQueuePermissions([]*api.Queue{{Name: queue2, GroupOwners: []string{"other-group"}}}, someTime)
+		assert.NoError(t, err)
+
+		queues, err := jobRepo.GetQueuePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*api.Queue{
+			{Name: queue, UserOwners: []string{"user"}, Permissions: permissions},
+			{Name: queue2, GroupOwners: []string{"other-group"}},
+		}, queues)
+
+		err = jobStore.DeleteQueuePermissions([]string{queue})
+		assert.NoError(t, err)
+		queues, err = jobRepo.GetQueuePermissions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(queues))
+		assert.Equal(t, queue2, queues[0].Name)
+	})
+}
+
+func TestGetJobs_RestrictedToQueues(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		job := NewJobSimulator(t, jobStore).CreateJob(queue)
+		NewJobSimulator(t, jobStore).CreateJob(queue2)
+
+		jobInfos, err := jobRepo.GetJobs(RestrictToQueues(ctx, []string{queue}), &lookout.GetJobsRequest{Take: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobInfos))
+		assert.Equal(t, job.job.Id, jobInfos[0].Job.Id)
+
+		jobInfos, err = jobRepo.GetJobs(RestrictToQueues(ctx, []string{}), &lookout.GetJobsRequest{Take: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, jobInfos)
+	})
+}
@@ -0,0 +1 @@
+ALTER TABLE job_run_container ADD COLUMN log_excerpt text NULL;
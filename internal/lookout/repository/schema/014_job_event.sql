@@ -0,0 +1,14 @@
+CREATE TABLE job_event
+(
+    id            bigserial    NOT NULL,
+    job_id        varchar(32)  NOT NULL,
+    created       timestamp    NOT NULL,
+    event_type    varchar(32)  NOT NULL,
+    run_id        varchar(36)  NOT NULL DEFAULT '',
+    cluster       varchar(512) NULL,
+    node          varchar(512) NULL,
+    pod_number    int          NULL,
+    reason        text         NULL,
+    requestor     varchar(512) NULL,
+    PRIMARY KEY (job_id, created, event_type, run_id)
+);
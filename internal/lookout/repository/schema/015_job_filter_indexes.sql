@@ -0,0 +1,15 @@
+-- sorting and cursor pagination of jobs, the expressions match the sort keys of the queries
+CREATE INDEX idx_job_submitted_job_id ON job ((COALESCE(submitted, '-infinity'::timestamp)), job_id);
+
+CREATE INDEX idx_job_priority_job_id ON job ((COALESCE(priority, 0)), job_id);
+
+-- filtering of jobs by their runs
+CREATE INDEX idx_job_run_started ON job_run (started);
+
+CREATE INDEX idx_job_run_finished ON job_run (finished);
+
+CREATE INDEX idx_job_run_cluster ON job_run (cluster varchar_pattern_ops);
+
+CREATE INDEX idx_job_run_node ON job_run (node varchar_pattern_ops);
+
+CREATE INDEX idx_job_run_container_exit_code ON job_run_container (exit_code);
@@ -0,0 +1,15 @@
+CREATE TABLE job_resource
+(
+    job_id    varchar(32)  NOT NULL,
+    resource  varchar(256) NOT NULL,
+    requested float        NOT NULL,
+    PRIMARY KEY (job_id, resource)
+);
+
+CREATE TABLE job_run_resource
+(
+    run_id   varchar(36)  NOT NULL,
+    resource varchar(256) NOT NULL,
+    max_used float        NOT NULL,
+    PRIMARY KEY (run_id, resource)
+);
@@ -0,0 +1,13 @@
+CREATE TABLE queue_history
+(
+    queue     varchar(512) NOT NULL,
+    sampled   timestamp    NOT NULL,
+    queued    int          NOT NULL,
+    pending   int          NOT NULL,
+    running   int          NOT NULL,
+    succeeded int          NOT NULL,
+    failed    int          NOT NULL,
+    PRIMARY KEY (queue, sampled)
+);
+
+CREATE INDEX idx_queue_history_sampled ON queue_history (sampled);
@@ -0,0 +1 @@
+ALTER TABLE job_run ADD COLUMN cause varchar(32) NULL;
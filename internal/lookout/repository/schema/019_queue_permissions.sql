@@ -0,0 +1,6 @@
+CREATE TABLE queue
+(
+    name        varchar(512) NOT NULL PRIMARY KEY,
+    permissions jsonb        NOT NULL,
+    synced      timestamp    NOT NULL
+);
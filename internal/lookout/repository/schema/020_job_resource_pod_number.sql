@@ -0,0 +1,6 @@
+-- resources are requested by each pod of a job, as every pod is a run of its own
+ALTER TABLE job_resource ADD COLUMN pod_number int NOT NULL DEFAULT 0;
+
+ALTER TABLE job_resource DROP CONSTRAINT job_resource_pkey;
+
+ALTER TABLE job_resource ADD PRIMARY KEY (job_id, pod_number, resource);
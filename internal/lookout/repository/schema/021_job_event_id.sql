@@ -0,0 +1,11 @@
+-- events are identified by the id of the stream message they were delivered in, distinct events of the same job,
+-- type and time are all kept, id only orders the events
+ALTER TABLE job_event ADD COLUMN event_id varchar(512) NULL;
+
+ALTER TABLE job_event DROP CONSTRAINT job_event_pkey;
+
+ALTER TABLE job_event ADD PRIMARY KEY (id);
+
+CREATE UNIQUE INDEX idx_job_event_event_id ON job_event (event_id);
+
+CREATE INDEX idx_job_event_job_id_created ON job_event (job_id, created);
@@ -8,6 +8,7 @@ import (
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	"github.com/lib/pq"
 
+	"github.com/G-Research/armada/pkg/api"
 	"github.com/G-Research/armada/pkg/api/lookout"
 )
 
@@ -29,6 +30,13 @@ type JobRepository interface {
 	GetQueueInfos(ctx context.Context) ([]*lookout.QueueInfo, error)
 	GetJobSetInfos(ctx context.Context, opts *lookout.GetJobSetsRequest) ([]*lookout.JobSetInfo, error)
 	GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) ([]*lookout.JobInfo, error)
+	GetJobsPage(ctx context.Context, opts *lookout.GetJobsRequest) (*lookout.GetJobsResponse, error)
+	GetJobDetails(ctx context.Context, jobId string) (*lookout.GetJobDetailsResponse, error)
+	GetUsage(ctx context.Context, opts *lookout.GetUsageRequest) ([]*lookout.UsageInfo, error)
+	GetQueueHistory(ctx context.Context, opts *lookout.GetQueueHistoryRequest) ([]*lookout.QueueHistory, error)
+	GetFailureClusters(ctx context.Context, opts *lookout.GetFailureClustersRequest) (*lookout.GetFailureClustersResponse, error)
+	GetJobSetAnalytics(ctx context.Context, opts *lookout.GetJobSetAnalyticsRequest) (*lookout.GetJobSetAnalyticsResponse, error)
+	GetQueuePermissions(ctx context.Context) ([]*api.Queue, error)
 }
 
 type SQLJobRepository struct {
@@ -42,6 +50,11 @@ var (
 	jobRunTable               = goqu.T("job_run")
 	jobRunContainerTable      = goqu.T("job_run_container")
 	userAnnotationLookupTable = goqu.T("user_annotation_lookup")
+	jobEventTable             = goqu.T("job_event")
+	jobResourceTable          = goqu.T("job_resource")
+	jobRunResourceTable       = goqu.T("job_run_resource")
+	queueHistoryTable         = goqu.T("queue_history")
+	queueTable                = goqu.T("queue")
 
 	// Columns: job table
 	job_jobId      = goqu.I("job.job_id")
@@ -57,16 +70,60 @@ var (
 	job_jobUpdated = goqu.I("job.job_updated")
 
 	// Columns: job_run table
-	jobRun_runId     = goqu.I("job_run.run_id")
-	jobRun_jobId     = goqu.I("job_run.job_id")
-	jobRun_podNumber = goqu.I("job_run.pod_number")
-	jobRun_cluster   = goqu.I("job_run.cluster")
-	jobRun_node      = goqu.I("job_run.node")
-	jobRun_created   = goqu.I("job_run.created")
-	jobRun_started   = goqu.I("job_run.started")
-	jobRun_finished  = goqu.I("job_run.finished")
-	jobRun_succeeded = goqu.I("job_run.succeeded")
-	jobRun_error     = goqu.I("job_run.error")
+	jobRun_runId            = goqu.I("job_run.run_id")
+	jobRun_jobId            = goqu.I("job_run.job_id")
+	jobRun_podNumber        = goqu.I("job_run.pod_number")
+	jobRun_cluster          = goqu.I("job_run.cluster")
+	jobRun_node             = goqu.I("job_run.node")
+	jobRun_created          = goqu.I("job_run.created")
+	jobRun_started          = goqu.I("job_run.started")
+	jobRun_finished         = goqu.I("job_run.finished")
+	jobRun_succeeded        = goqu.I("job_run.succeeded")
+	jobRun_error            = goqu.I("job_run.error")
+	jobRun_cause            = goqu.I("job_run.cause")
+	jobRun_unableToSchedule = goqu.I("job_run.unable_to_schedule")
+
+	// Columns: job_run_container table
+	jobRunContainer_runId         = goqu.I("job_run_container.run_id")
+	jobRunContainer_containerName = goqu.I("job_run_container.container_name")
+	jobRunContainer_exitCode      = goqu.I("job_run_container.exit_code")
+	jobRunContainer_logExcerpt    = goqu.I("job_run_container.log_excerpt")
+
+	// Columns: job_event table
+	jobEvent_id        = goqu.I("job_event.id")
+	jobEvent_jobId     = goqu.I("job_event.job_id")
+	jobEvent_created   = goqu.I("job_event.created")
+	jobEvent_eventType = goqu.I("job_event.event_type")
+	jobEvent_runId     = goqu.I("job_event.run_id")
+	jobEvent_cluster   = goqu.I("job_event.cluster")
+	jobEvent_node      = goqu.I("job_event.node")
+	jobEvent_podNumber = goqu.I("job_event.pod_number")
+	jobEvent_reason    = goqu.I("job_event.reason")
+	jobEvent_requestor = goqu.I("job_event.requestor")
+
+	// Columns: job_resource table
+	jobResource_jobId     = goqu.I("job_resource.job_id")
+	jobResource_podNumber = goqu.I("job_resource.pod_number")
+	jobResource_resource  = goqu.I("job_resource.resource")
+	jobResource_requested = goqu.I("job_resource.requested")
+
+	// Columns: job_run_resource table
+	jobRunResource_runId    = goqu.I("job_run_resource.run_id")
+	jobRunResource_resource = goqu.I("job_run_resource.resource")
+	jobRunResource_maxUsed  = goqu.I("job_run_resource.max_used")
+
+	// Columns: queue_history table
+	queueHistory_queue     = goqu.I("queue_history.queue")
+	queueHistory_sampled   = goqu.I("queue_history.sampled")
+	queueHistory_queued    = goqu.I("queue_history.queued")
+	queueHistory_pending   = goqu.I("queue_history.pending")
+	queueHistory_running   = goqu.I("queue_history.running")
+	queueHistory_succeeded = goqu.I("queue_history.succeeded")
+	queueHistory_failed    = goqu.I("queue_history.failed")
+
+	// Columns: queue table
+	queue_name        = goqu.I("queue.name")
+	queue_permissions = goqu.I("queue.permissions")
 
 	// Columns: annotation table
 	annotation_jobId = goqu.I("user_annotation_lookup.job_id")
@@ -27,6 +27,9 @@ type JobRecorder interface {
 	RecordJobDuplicate(event *api.JobDuplicateFoundEvent) error
 	RecordJobTerminated(event *api.JobTerminatedEvent) error
 	RecordJobReprioritized(event *api.JobReprioritizedEvent) error
+
+	RecordEvents(events []api.Event) error
+	RecordStreamEvents(events []api.Event, messageIds []string) error
 }
 
 type SQLJobStore struct {
@@ -39,61 +42,131 @@ func NewSQLJobStore(db *goqu.Database, annotationPrefix string) *SQLJobStore {
 }
 
 func (r *SQLJobStore) RecordJob(job *api.Job, timestamp time.Time) error {
-	jobJson, err := json.Marshal(job)
+	return r.inTransaction(func(tx *goqu.TxDatabase) error {
+		return recordJob(tx, r.userAnnotationPrefix, job, timestamp)
+	})
+}
+
+func (r *SQLJobStore) MarkCancelled(event *api.JobCancelledEvent) error {
+	return r.inTransaction(func(tx *goqu.TxDatabase) error {
+		return markCancelled(tx, event)
+	})
+}
+
+func (r *SQLJobStore) RecordJobReprioritized(event *api.JobReprioritizedEvent) error {
+	return r.inTransaction(func(tx *goqu.TxDatabase) error {
+		return recordJobReprioritized(tx, event)
+	})
+}
+
+func (r *SQLJobStore) RecordJobDuplicate(event *api.JobDuplicateFoundEvent) error {
+	return r.inTransaction(func(tx *goqu.TxDatabase) error {
+		return recordJobDuplicate(tx, event)
+	})
+}
+
+func (r *SQLJobStore) RecordJobPending(event *api.JobPendingEvent) error {
+	return r.recordRunEvent(event)
+}
+
+func (r *SQLJobStore) RecordJobRunning(event *api.JobRunningEvent) error {
+	return r.recordRunEvent(event)
+}
+
+func (r *SQLJobStore) RecordJobSucceeded(event *api.JobSucceededEvent) error {
+	return r.recordRunEvent(event)
+}
+
+func (r *SQLJobStore) RecordJobFailed(event *api.JobFailedEvent) error {
+	return r.recordRunEvent(event)
+}
+
+func (r *SQLJobStore) RecordJobUnableToSchedule(event *api.JobUnableToScheduleEvent) error {
+	return r.recordRunEvent(event)
+}
+
+func (r *SQLJobStore) RecordJobTerminated(event *api.JobTerminatedEvent) error {
+	return r.recordRunEvent(event)
+}
+
+func (r *SQLJobStore) recordRunEvent(event api.KubernetesEvent) error {
+	update := newJobRunUpdate(event)
+	return r.inTransaction(func(tx *goqu.TxDatabase) error {
+		if err := upsertJobRun(tx, update.run); err != nil {
+			return err
+		}
+		if err := upsertContainers(tx, update.runId(), update.exitCodes, update.logExcerpts); err != nil {
+			return err
+		}
+		if update.state == nil {
+			return nil
+		}
+		return upsertJobState(tx, event, *update.state, update.updateJobSet)
+	})
+}
+
+func (r *SQLJobStore) inTransaction(action func(tx *goqu.TxDatabase) error) error {
+	tx, err := r.db.Begin()
 	if err != nil {
 		return err
 	}
+	return tx.Wrap(func() error {
+		return action(tx)
+	})
+}
 
-	tx, err := r.db.Begin()
+func recordJob(tx *goqu.TxDatabase, userAnnotationPrefix string, job *api.Job, timestamp time.Time) error {
+	jobJson, err := json.Marshal(job)
 	if err != nil {
 		return err
 	}
 
-	return tx.Wrap(func() error {
-		ds := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, job.Id)).
-			Rows(goqu.Record{
-				"job_id":      job.Id,
-				"queue":       job.Queue,
-				"owner":       job.Owner,
-				"jobset":      job.JobSetId,
-				"priority":    job.Priority,
-				"submitted":   ToUTC(job.Created),
-				"job":         jobJson,
-				"state":       JobStateToIntMap[JobQueued],
-				"job_updated": timestamp,
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"queue":       job.Queue,
-				"owner":       job.Owner,
-				"jobset":      job.JobSetId,
-				"priority":    job.Priority,
-				"submitted":   ToUTC(job.Created),
-				"job":         jobJson,
-				"state":       determineJobState(tx),
-				"job_updated": timestamp,
-			}).Where(job_jobUpdated.Lt(timestamp)))
-
-		res, err := ds.Prepared(true).Executor().Exec()
-		if err != nil {
-			return err
-		}
+	ds := tx.Insert(jobTable).
+		With("run_states", getRunStateCounts(tx, job.Id)).
+		Rows(goqu.Record{
+			"job_id":      job.Id,
+			"queue":       job.Queue,
+			"owner":       job.Owner,
+			"jobset":      job.JobSetId,
+			"priority":    job.Priority,
+			"submitted":   ToUTC(job.Created),
+			"job":         jobJson,
+			"state":       JobStateToIntMap[JobQueued],
+			"job_updated": timestamp,
+		}).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"queue":       job.Queue,
+			"owner":       job.Owner,
+			"jobset":      job.JobSetId,
+			"priority":    job.Priority,
+			"submitted":   ToUTC(job.Created),
+			"job":         jobJson,
+			"state":       determineJobState(tx),
+			"job_updated": timestamp,
+		}).Where(job_jobUpdated.Lt(timestamp)))
+
+	res, err := ds.Prepared(true).Executor().Exec()
+	if err != nil {
+		return err
+	}
 
-		rowsChanged, err := res.RowsAffected()
-		if err != nil {
-			return err
-		}
+	rowsChanged, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
 
-		if rowsChanged == 0 {
-			return nil
-		}
+	if rowsChanged == 0 {
+		return nil
+	}
 
-		return upsertUserAnnotations(tx, r.userAnnotationPrefix, job.Id, job.Annotations)
-	})
+	if err := upsertJobResources(tx, job); err != nil {
+		return err
+	}
+	return upsertUserAnnotations(tx, userAnnotationPrefix, job.Id, job.Annotations)
 }
 
-func (r *SQLJobStore) MarkCancelled(event *api.JobCancelledEvent) error {
-	ds := r.db.Insert(jobTable).
+func markCancelled(tx *goqu.TxDatabase, event *api.JobCancelledEvent) error {
+	ds := tx.Insert(jobTable).
 		Rows(goqu.Record{
 			"job_id":    event.JobId,
 			"queue":     event.Queue,
@@ -112,13 +185,13 @@ func (r *SQLJobStore) MarkCancelled(event *api.JobCancelledEvent) error {
 	return err
 }
 
-func (r *SQLJobStore) RecordJobReprioritized(event *api.JobReprioritizedEvent) error {
-	updatedJobJson, err := r.getReprioritizedJobJson(event)
+func recordJobReprioritized(tx *goqu.TxDatabase, event *api.JobReprioritizedEvent) error {
+	updatedJobJson, err := getReprioritizedJobJson(tx, event)
 	if err != nil {
 		return err
 	}
 
-	ds := r.db.Insert(jobTable).
+	ds := tx.Insert(jobTable).
 		Rows(goqu.Record{
 			"job_id":   event.JobId,
 			"queue":    event.Queue,
@@ -137,268 +210,132 @@ func (r *SQLJobStore) RecordJobReprioritized(event *api.JobReprioritizedEvent) e
 	return err
 }
 
-func (r *SQLJobStore) RecordJobDuplicate(event *api.JobDuplicateFoundEvent) error {
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	return tx.Wrap(func() error {
-		ds := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, event.GetJobId())).
-			Rows(goqu.Record{
-				"job_id":    event.JobId,
-				"queue":     event.Queue,
-				"jobset":    event.JobSetId,
-				"duplicate": true,
-				"state":     JobStateToIntMap[JobDuplicate],
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state":     JobStateToIntMap[JobDuplicate],
-				"duplicate": true,
-			}))
-
-		_, err := ds.Prepared(true).Executor().Exec()
-		return err
-	})
-}
-
-func (r *SQLJobStore) RecordJobPending(event *api.JobPendingEvent) error {
-
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	return tx.Wrap(func() error {
-		if err := upsertJobRun(tx, goqu.Record{
-			"run_id":     event.GetKubernetesId(),
-			"job_id":     event.GetJobId(),
-			"cluster":    event.GetClusterId(),
-			"pod_number": event.GetPodNumber(),
-			"created":    ToUTC(event.GetCreated()),
-		}); err != nil {
-			return err
-		}
+func recordJobDuplicate(tx *goqu.TxDatabase, event *api.JobDuplicateFoundEvent) error {
+	ds := tx.Insert(jobTable).
+		With("run_states", getRunStateCounts(tx, event.GetJobId())).
+		Rows(goqu.Record{
+			"job_id":    event.JobId,
+			"queue":     event.Queue,
+			"jobset":    event.JobSetId,
+			"duplicate": true,
+			"state":     JobStateToIntMap[JobDuplicate],
+		}).
+		OnConflict(goqu.DoUpdate("job_id", goqu.Record{
+			"state":     JobStateToIntMap[JobDuplicate],
+			"duplicate": true,
+		}))
 
-		jobDs := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, event.GetJobId())).
-			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobPending],
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  determineJobState(tx),
-			}))
-
-		_, err := jobDs.Prepared(true).Executor().Exec()
-		return err
-	})
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
 }
 
-func (r *SQLJobStore) RecordJobRunning(event *api.JobRunningEvent) error {
-	jobRunRecord := goqu.Record{
-		"run_id":     event.GetKubernetesId(),
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"started":    ToUTC(event.GetCreated()),
+// upsertJobState inserts the job with the state implied by a run event if it doesn't exist yet,
+// otherwise the state of the job is determined from the states of its runs.
+func upsertJobState(tx *goqu.TxDatabase, event api.Event, state JobState, updateJobSet bool) error {
+	onConflict := goqu.Record{
+		"state": determineJobState(tx),
 	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
-
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
+	if updateJobSet {
+		onConflict["queue"] = event.GetQueue()
+		onConflict["jobset"] = event.GetJobSetId()
 	}
 
-	return tx.Wrap(func() error {
-		if err := upsertJobRun(tx, jobRunRecord); err != nil {
-			return err
-		}
+	ds := tx.Insert(jobTable).
+		With("run_states", getRunStateCounts(tx, event.GetJobId())).
+		Rows(goqu.Record{
+			"job_id": event.GetJobId(),
+			"queue":  event.GetQueue(),
+			"jobset": event.GetJobSetId(),
+			"state":  JobStateToIntMap[state],
+		}).
+		OnConflict(goqu.DoUpdate("job_id", onConflict))
 
-		jobDs := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, event.GetJobId())).
-			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobRunning],
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
-			}))
-
-		_, err := jobDs.Prepared(true).Executor().Exec()
-		return err
-	})
+	_, err := ds.Prepared(true).Executor().Exec()
+	return err
 }
 
-func (r *SQLJobStore) RecordJobSucceeded(event *api.JobSucceededEvent) error {
-	jobRunRecord := goqu.Record{
-		"run_id":     event.GetKubernetesId(),
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"finished":   ToUTC(event.GetCreated()),
-		"succeeded":  true,
-	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
-
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	return tx.Wrap(func() error {
-
-		if err := upsertJobRun(tx, jobRunRecord); err != nil {
-			return err
-		}
-
-		ds := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, event.GetJobId())).
-			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobSucceeded],
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
-			}))
-
-		_, err := ds.Prepared(true).Executor().Exec()
-		return err
-	})
+// jobRunUpdate describes the changes a run event makes: the job_run record, the exit codes and log excerpts of
+// its containers and the state of the job implied by the event, if any.
+type jobRunUpdate struct {
+	run          goqu.Record
+	exitCodes    map[string]int32
+	logExcerpts  map[string]string
+	state        *JobState
+	updateJobSet bool
 }
 
-func (r *SQLJobStore) RecordJobFailed(event *api.JobFailedEvent) error {
-	// If job fails before a pod is created, we generate a new ULID
-	k8sId := event.KubernetesId
-	if k8sId == "" {
-		k8sId = util.NewULID() + "-nopod"
-	}
+func (u *jobRunUpdate) runId() string {
+	return u.run["run_id"].(string)
+}
 
-	jobRunRecord := goqu.Record{
-		"run_id":     k8sId,
+func newJobRunUpdate(event api.KubernetesEvent) *jobRunUpdate {
+	run := goqu.Record{
+		"run_id":     event.GetKubernetesId(),
 		"job_id":     event.GetJobId(),
 		"cluster":    event.GetClusterId(),
 		"pod_number": event.GetPodNumber(),
-		"finished":   ToUTC(event.GetCreated()),
-		"succeeded":  false,
-		"error":      truncateError(event.GetReason()),
 	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
+	update := &jobRunUpdate{run: run}
+	withState := func(state JobState) {
+		update.state = &state
 	}
 
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	return tx.Wrap(func() error {
-		if err := upsertJobRun(tx, jobRunRecord); err != nil {
-			return err
+	switch typed := event.(type) {
+	case *api.JobPendingEvent:
+		run["created"] = ToUTC(typed.Created)
+		withState(JobPending)
+		update.updateJobSet = true
+		// Node is not known when the pod is pending
+		return update
+
+	case *api.JobRunningEvent:
+		run["started"] = ToUTC(typed.Created)
+		withState(JobRunning)
+
+	case *api.JobSucceededEvent:
+		run["finished"] = ToUTC(typed.Created)
+		run["succeeded"] = true
+		withState(JobSucceeded)
+
+	case *api.JobFailedEvent:
+		// If job fails before a pod is created, we generate a new ULID
+		if typed.KubernetesId == "" {
+			run["run_id"] = util.NewULID() + "-nopod"
 		}
-
-		jobDs := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, event.GetJobId())).
-			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobFailed],
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
-			}))
-
-		if _, err := jobDs.Prepared(true).Executor().Exec(); err != nil {
-			return err
+		run["finished"] = ToUTC(typed.Created)
+		run["succeeded"] = false
+		run["error"] = truncateError(typed.Reason)
+		run["cause"] = typed.Cause.String()
+		update.exitCodes = typed.ExitCodes
+		update.logExcerpts = logExcerpts(typed.ContainerStatuses)
+		withState(JobFailed)
+
+	case *api.JobUnableToScheduleEvent:
+		if typed.KubernetesId == "" {
+			typed.KubernetesId = util.NewULID() + "-nopod"
+			run["run_id"] = typed.KubernetesId
 		}
-
-		return upsertContainers(tx, k8sId, event.ExitCodes)
-	})
-}
-
-func (r *SQLJobStore) RecordJobUnableToSchedule(event *api.JobUnableToScheduleEvent) error {
-	if event.GetKubernetesId() == "" {
-		event.KubernetesId = util.NewULID() + "-nopod"
-	}
-
-	jobRunRecord := goqu.Record{
-		"run_id":             event.GetKubernetesId(),
-		"job_id":             event.GetJobId(),
-		"cluster":            event.GetClusterId(),
-		"pod_number":         event.GetPodNumber(),
-		"finished":           ToUTC(event.GetCreated()),
-		"unable_to_schedule": true,
-		"error":              truncateError(event.GetReason()),
-	}
-	if event.GetNodeName() != "" {
-		jobRunRecord["node"] = event.GetNodeName()
-	}
-
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
-	}
-
-	return tx.Wrap(func() error {
-		return upsertJobRun(tx, jobRunRecord)
-	})
-}
-
-func (r *SQLJobStore) RecordJobTerminated(event *api.JobTerminatedEvent) error {
-	jobRunRecord := goqu.Record{
-		"run_id":     event.GetKubernetesId(),
-		"job_id":     event.GetJobId(),
-		"cluster":    event.GetClusterId(),
-		"pod_number": event.GetPodNumber(),
-		"finished":   ToUTC(event.GetCreated()),
-		"succeeded":  false,
-		"error":      truncateError(event.GetReason()),
+		run["finished"] = ToUTC(typed.Created)
+		run["unable_to_schedule"] = true
+		run["error"] = truncateError(typed.Reason)
+
+	case *api.JobTerminatedEvent:
+		run["finished"] = ToUTC(typed.Created)
+		run["succeeded"] = false
+		run["error"] = truncateError(typed.Reason)
+		withState(JobFailed)
+		// Terminated events don't carry the node
+		return update
 	}
 
-	tx, err := r.db.Begin()
-	if err != nil {
-		return err
+	if nodeEvent, ok := event.(interface{ GetNodeName() string }); ok && nodeEvent.GetNodeName() != "" {
+		run["node"] = nodeEvent.GetNodeName()
 	}
-
-	return tx.Wrap(func() error {
-
-		if err := upsertJobRun(tx, jobRunRecord); err != nil {
-			return err
-		}
-
-		jobDs := tx.Insert(jobTable).
-			With("run_states", getRunStateCounts(tx, event.GetJobId())).
-			Rows(goqu.Record{
-				"job_id": event.JobId,
-				"queue":  event.Queue,
-				"jobset": event.JobSetId,
-				"state":  JobStateToIntMap[JobFailed],
-			}).
-			OnConflict(goqu.DoUpdate("job_id", goqu.Record{
-				"state": determineJobState(tx),
-			}))
-
-		_, err := jobDs.Prepared(true).Executor().Exec()
-		return err
-	})
+	return update
 }
 
-func (r *SQLJobStore) getReprioritizedJobJson(event *api.JobReprioritizedEvent) (sql.NullString, error) {
-	selectDs := r.db.From(jobTable).
+func getReprioritizedJobJson(tx *goqu.TxDatabase, event *api.JobReprioritizedEvent) (sql.NullString, error) {
+	selectDs := tx.From(jobTable).
 		Select(job_job).
 		Where(job_jobId.Eq(event.JobId))
 
@@ -427,46 +364,45 @@ func (r *SQLJobStore) getReprioritizedJobJson(event *api.JobReprioritizedEvent)
 	return NewNullString(string(updatedJobJson)), nil
 }
 
-func (r *SQLJobStore) getUpdatedJobJson(event *api.JobUpdatedEvent) (sql.NullString, error) {
-	updatedJobJson, err := json.Marshal(event.Job)
-	if err != nil {
-		return sql.NullString{}, nil
-	}
-	return NewNullString(string(updatedJobJson)), nil
-}
-
 func upsertJobRun(tx *goqu.TxDatabase, record goqu.Record) error {
 	return upsert(tx, jobRunTable, []string{"run_id"}, []goqu.Record{record})
 }
 
-func upsertContainers(tx *goqu.TxDatabase, k8sId string, exitCodes map[string]int32) error {
+func upsertContainers(tx *goqu.TxDatabase, k8sId string, exitCodes map[string]int32, logExcerpts map[string]string) error {
 	containerRecords := make([]goqu.Record, len(exitCodes))
 	i := 0
 	for name, code := range exitCodes {
-		containerRecords[i] = goqu.Record{
-			"run_id":         k8sId,
-			"container_name": name,
-			"exit_code":      code,
-		}
+		containerRecords[i] = containerRecord(k8sId, name, code, logExcerpts[name])
 		i++
 	}
 
 	return upsert(tx, jobRunContainerTable, []string{"run_id", "container_name"}, containerRecords)
 }
 
+func containerRecord(k8sId string, name string, exitCode int32, logExcerpt string) goqu.Record {
+	return goqu.Record{
+		"run_id":         k8sId,
+		"container_name": name,
+		"exit_code":      exitCode,
+		"log_excerpt":    NewNullString(strings.ToValidUTF8(strings.ReplaceAll(logExcerpt, "\x00", ""), "")),
+	}
+}
+
+func logExcerpts(containerStatuses []*api.ContainerStatus) map[string]string {
+	excerpts := map[string]string{}
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.LogExcerpt != "" {
+			excerpts[containerStatus.Name] = containerStatus.LogExcerpt
+		}
+	}
+	return excerpts
+}
+
 func upsertUserAnnotations(tx *goqu.TxDatabase, userAnnotationPrefix string, jobId string, annotations map[string]string) error {
 	trimmedKeys := []string{}
-	var annotationRecords []goqu.Record
-	for key, value := range annotations {
-		if strings.HasPrefix(key, userAnnotationPrefix) && len(key) > len(userAnnotationPrefix) {
-			trimmedKey := key[len(userAnnotationPrefix):]
-			trimmedKeys = append(trimmedKeys, trimmedKey)
-			annotationRecords = append(annotationRecords, goqu.Record{
-				"job_id": jobId,
-				"key":    trimmedKey,
-				"value":  value,
-			})
-		}
+	annotationRecords := userAnnotationRecords(userAnnotationPrefix, jobId, annotations)
+	for _, record := range annotationRecords {
+		trimmedKeys = append(trimmedKeys, record["key"].(string))
 	}
 
 	deleteWhereClause := []exp.Expression{annotation_jobId.Eq(jobId)}
@@ -481,6 +417,20 @@ func upsertUserAnnotations(tx *goqu.TxDatabase, userAnnotationPrefix string, job
 	return upsert(tx, userAnnotationLookupTable, []string{"job_id", "key"}, annotationRecords)
 }
 
+func userAnnotationRecords(userAnnotationPrefix string, jobId string, annotations map[string]string) []goqu.Record {
+	var annotationRecords []goqu.Record
+	for key, value := range annotations {
+		if strings.HasPrefix(key, userAnnotationPrefix) && len(key) > len(userAnnotationPrefix) {
+			annotationRecords = append(annotationRecords, goqu.Record{
+				"job_id": jobId,
+				"key":    key[len(userAnnotationPrefix):],
+				"value":  value,
+			})
+		}
+	}
+	return annotationRecords
+}
+
 func determineJobState(tx *goqu.TxDatabase) exp.CaseExpression {
 	return goqu.Case().
 		When(job_duplicate.Eq(true), stateAsLiteral(JobDuplicate)).
@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+const (
+	dataExceptionClass                pq.ErrorClass = "22"
+	integrityConstraintViolationClass pq.ErrorClass = "23"
+)
+
+// RecordEvents records a batch of events in a single transaction.
+// Every lifecycle event is added to the history of its job before the events are applied to the job and its runs.
+// New jobs, job runs and container exit codes are written with multi-row upserts. The remaining changes to jobs are
+// applied in event order, and as all runs are written first, the state of a job only has to be determined once
+// for its first and once for its last run event.
+func (r *SQLJobStore) RecordEvents(events []api.Event) error {
+	return r.RecordStreamEvents(events, nil)
+}
+
+// RecordStreamEvents records a batch of events like RecordEvents, given the ids of the stream messages they were
+// delivered in. An event with a message id is added to the history of its job only once, however often its message
+// is delivered. Events without a message id, e.g. if messageIds is nil, are added every time they are recorded.
+func (r *SQLJobStore) RecordStreamEvents(events []api.Event, messageIds []string) error {
+	return r.inTransaction(func(tx *goqu.TxDatabase) error {
+		if err := insertJobEvents(tx, events, messageIds); err != nil {
+			return err
+		}
+
+		stateEvents := make([]api.Event, len(events))
+		for i, event := range events {
+			stateEvents[i] = jobStateEvent(event)
+		}
+		events = stateEvents
+
+		insertedJobs, err := insertNewJobs(tx, r.userAnnotationPrefix, events)
+		if err != nil {
+			return err
+		}
+
+		runUpdates := map[int]*jobRunUpdate{}
+		firstRunEvent := map[string]int{}
+		lastRunEvent := map[string]int{}
+		for i, event := range events {
+			kubernetesEvent, ok := event.(api.KubernetesEvent)
+			if !ok || !isRunEvent(event) {
+				continue
+			}
+			update := newJobRunUpdate(kubernetesEvent)
+			runUpdates[i] = update
+			if update.state == nil {
+				continue
+			}
+			if _, ok := firstRunEvent[event.GetJobId()]; !ok {
+				firstRunEvent[event.GetJobId()] = i
+			}
+			lastRunEvent[event.GetJobId()] = i
+		}
+		if err := upsertJobRuns(tx, events, runUpdates); err != nil {
+			return err
+		}
+		if err := upsertRunResources(tx, events); err != nil {
+			return err
+		}
+
+		for i, event := range events {
+			var err error
+			switch typed := event.(type) {
+			case *api.JobSubmittedEvent:
+				if insertedJobs[i] {
+					continue
+				}
+				err = recordJob(tx, r.userAnnotationPrefix, &typed.Job, typed.Created)
+			case *api.JobUpdatedEvent:
+				if insertedJobs[i] {
+					continue
+				}
+				err = recordJob(tx, r.userAnnotationPrefix, &typed.Job, typed.Created)
+			case *api.JobDuplicateFoundEvent:
+				err = recordJobDuplicate(tx, typed)
+			case *api.JobReprioritizedEvent:
+				err = recordJobReprioritized(tx, typed)
+			case *api.JobCancelledEvent:
+				err = markCancelled(tx, typed)
+			default:
+				update, ok := runUpdates[i]
+				jobId := event.GetJobId()
+				if ok && update.state != nil && (firstRunEvent[jobId] == i || lastRunEvent[jobId] == i) {
+					err = upsertJobState(tx, event, *update.state, update.updateJobSet)
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// IsInvalidEventError returns true if err was caused by the data of the recorded events, such as a value out of range
+// or a violated constraint, rather than by the database. Recording the same events again fails the same way.
+func IsInvalidEventError(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		class := pqErr.Code.Class()
+		return class == dataExceptionClass || class == integrityConstraintViolationClass
+	}
+	return false
+}
+
+func isRunEvent(event api.Event) bool {
+	switch event.(type) {
+	case *api.JobPendingEvent, *api.JobRunningEvent, *api.JobSucceededEvent, *api.JobFailedEvent,
+		*api.JobUnableToScheduleEvent, *api.JobTerminatedEvent:
+		return true
+	}
+	return false
+}
+
+// insertNewJobs inserts the first submission or update of each job in events with a single statement, if the job
+// doesn't exist yet. Returns the indexes of the events which were inserted, the others still have to be recorded.
+func insertNewJobs(tx *goqu.TxDatabase, userAnnotationPrefix string, events []api.Event) (map[int]bool, error) {
+	indexes := map[string]int{}
+	jobs := map[string]*api.Job{}
+	var rows []interface{}
+	for i, event := range events {
+		var job *api.Job
+		switch typed := event.(type) {
+		case *api.JobSubmittedEvent:
+			job = &typed.Job
+		case *api.JobUpdatedEvent:
+			job = &typed.Job
+		default:
+			continue
+		}
+		if _, ok := indexes[job.Id]; ok {
+			continue
+		}
+		jobJson, err := json.Marshal(job)
+		if err != nil {
+			return nil, err
+		}
+		indexes[job.Id] = i
+		jobs[job.Id] = job
+		rows = append(rows, goqu.Record{
+			"job_id":      job.Id,
+			"queue":       job.Queue,
+			"owner":       job.Owner,
+			"jobset":      job.JobSetId,
+			"priority":    job.Priority,
+			"submitted":   ToUTC(job.Created),
+			"job":         jobJson,
+			"state":       JobStateToIntMap[JobQueued],
+			"job_updated": event.GetCreated(),
+		})
+	}
+
+	inserted := map[int]bool{}
+	if len(rows) == 0 {
+		return inserted, nil
+	}
+
+	var insertedIds []string
+	err := tx.Insert(jobTable).
+		Rows(rows...).
+		OnConflict(goqu.DoNothing()).
+		Returning("job_id").
+		Prepared(true).Executor().ScanVals(&insertedIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var annotationRecords []goqu.Record
+	var resourceRecords []goqu.Record
+	for _, jobId := range insertedIds {
+		inserted[indexes[jobId]] = true
+		annotationRecords = append(annotationRecords, userAnnotationRecords(userAnnotationPrefix, jobId, jobs[jobId].Annotations)...)
+		resourceRecords = append(resourceRecords, jobResourceRecords(jobs[jobId])...)
+	}
+	if err := upsert(tx, jobResourceTable, []string{"job_id", "pod_number", "resource"}, resourceRecords); err != nil {
+		return nil, err
+	}
+	return inserted, upsert(tx, userAnnotationLookupTable, []string{"job_id", "key"}, annotationRecords)
+}
+
+// upsertJobRuns merges the run updates of the same run in event order and writes them with one statement for
+// each set of updated columns, as a multi-row insert has to provide the same columns for every row.
+func upsertJobRuns(tx *goqu.TxDatabase, events []api.Event, runUpdates map[int]*jobRunUpdate) error {
+	runs := map[string]goqu.Record{}
+	var runIds []string
+	containers := map[string]goqu.Record{}
+	var containerKeys []string
+
+	for i := range events {
+		update, ok := runUpdates[i]
+		if !ok {
+			continue
+		}
+		runId := update.runId()
+		run, ok := runs[runId]
+		if !ok {
+			run = goqu.Record{}
+			runs[runId] = run
+			runIds = append(runIds, runId)
+		}
+		for column, value := range update.run {
+			run[column] = value
+		}
+
+		for name, code := range update.exitCodes {
+			key := runId + "/" + name
+			if _, ok := containers[key]; !ok {
+				containerKeys = append(containerKeys, key)
+			}
+			containers[key] = containerRecord(runId, name, code, update.logExcerpts[name])
+		}
+	}
+
+	groups := map[string][]goqu.Record{}
+	var groupKeys []string
+	for _, runId := range runIds {
+		run := runs[runId]
+		columns := make([]string, 0, len(run))
+		for column := range run {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+		key := strings.Join(columns, ",")
+		if _, ok := groups[key]; !ok {
+			groupKeys = append(groupKeys, key)
+		}
+		groups[key] = append(groups[key], run)
+	}
+	for _, key := range groupKeys {
+		if err := upsert(tx, jobRunTable, []string{"run_id"}, groups[key]); err != nil {
+			return err
+		}
+	}
+
+	containerRecords := make([]goqu.Record, 0, len(containerKeys))
+	for _, key := range containerKeys {
+		containerRecords = append(containerRecords, containers[key])
+	}
+	return upsert(tx, jobRunContainerTable, []string{"run_id", "container_name"}, containerRecords)
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func Test_RecordEvents(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		succeededJobId := util.NewULID()
+		failedJobId := util.NewULID()
+		cancelledJobId := util.NewULID()
+
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: succeededJobId, Queue: queue, Created: someTime, Job: api.Job{
+				Id:          succeededJobId,
+				Queue:       queue,
+				JobSetId:    "job-set",
+				Priority:    1,
+				Created:     someTime,
+				Annotations: map[string]string{userAnnotationPrefix + "a": "b"},
+			}},
+			&api.JobSubmittedEvent{JobId: failedJobId, Queue: queue, Created: someTime, Job: api.Job{
+				Id:       failedJobId,
+				Queue:    queue,
+				JobSetId: "job-set",
+				Priority: 1,
+				Created:  someTime,
+			}},
+			&api.JobPendingEvent{JobId: succeededJobId, Queue: queue, Created: someTime, KubernetesId: k8sId1, ClusterId: cluster},
+			&api.JobRunningEvent{JobId: succeededJobId, Queue: queue, Created: someTime, KubernetesId: k8sId1, ClusterId: cluster, NodeName: node},
+			&api.JobSucceededEvent{JobId: succeededJobId, Queue: queue, Created: someTime, KubernetesId: k8sId1, ClusterId: cluster},
+			&api.JobPendingEvent{JobId: failedJobId, Queue: queue, Created: someTime, KubernetesId: k8sId2, ClusterId: cluster},
+			&api.JobFailedEvent{JobId: failedJobId, Queue: queue, Created: someTime, KubernetesId: k8sId2, ClusterId: cluster,
+				ExitCodes: map[string]int32{"container": 2}},
+			&api.JobReprioritizedEvent{JobId: succeededJobId, Queue: queue, Created: someTime, NewPriority: 5},
+			&api.JobCancelledEvent{JobId: cancelledJobId, Queue: queue, JobSetId: "job-set", Created: someTime},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, JobStateToIntMap[JobSucceeded], selectInt(t, db,
+			fmt.Sprintf("SELECT state FROM job WHERE job_id = '%s'", succeededJobId)))
+		assert.Equal(t, JobStateToIntMap[JobFailed], selectInt(t, db,
+			fmt.Sprintf("SELECT state FROM job WHERE job_id = '%s'", failedJobId)))
+		assert.Equal(t, JobStateToIntMap[JobCancelled], selectInt(t, db,
+			fmt.Sprintf("SELECT state FROM job WHERE job_id = '%s'", cancelledJobId)))
+
+		assert.Equal(t, 1, selectInt(t, db,
+			fmt.Sprintf("SELECT COUNT(*) FROM job_run WHERE run_id = '%s' AND created IS NOT NULL AND started IS NOT NULL AND finished IS NOT NULL AND node = '%s'", k8sId1, node)))
+		assert.Equal(t, 2, selectInt(t, db,
+			fmt.Sprintf("SELECT exit_code FROM job_run_container WHERE run_id = '%s' AND container_name = 'container'", k8sId2)))
+		assert.Equal(t, 5.0, getPriority(t, db, succeededJobId))
+		assert.True(t, hasUserAnnotation(t, db, succeededJobId, "a", "b"))
+	})
+}
+
+func Test_RecordEvents_UpdatesExistingJob(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobId := util.NewULID()
+
+		job := api.Job{Id: jobId, Queue: queue, JobSetId: "job-set", Priority: 1, Created: someTime}
+		err := jobStore.RecordJob(&job, someTime)
+		assert.NoError(t, err)
+
+		updatedJob := job
+		updatedJob.Priority = 3
+		err = jobStore.RecordEvents([]api.Event{
+			&api.JobRunningEvent{JobId: jobId, Queue: queue, Created: someTime, KubernetesId: k8sId1},
+			&api.JobUpdatedEvent{JobId: jobId, Queue: queue, Created: someTime.Add(time.Second), Job: updatedJob},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, JobStateToIntMap[JobRunning], selectInt(t, db,
+			fmt.Sprintf("SELECT state FROM job WHERE job_id = '%s'", jobId)))
+		assert.Equal(t, 3.0, getPriority(t, db, jobId))
+	})
+}
+
+func Test_IsInvalidEventError(t *testing.T) {
+	assert.True(t, IsInvalidEventError(&pq.Error{Code: "23505"}))
+	assert.True(t, IsInvalidEventError(fmt.Errorf("recording events: %w", &pq.Error{Code: "22001"})))
+	assert.False(t, IsInvalidEventError(&pq.Error{Code: "57P01"}))
+	assert.False(t, IsInvalidEventError(errors.New("connection refused")))
+	assert.False(t, IsInvalidEventError(nil))
+}
@@ -100,6 +100,30 @@ func Test_RunContainers(t *testing.T) {
 				"SELECT exit_code FROM job_run_container WHERE run_id = 'a1' AND container_name = 'container-1'"))
 		})
 	})
+
+	t.Run("log excerpts", func(t *testing.T) {
+		withDatabase(t, func(db *goqu.Database) {
+			jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+
+			err := jobStore.RecordJobFailed(&api.JobFailedEvent{
+				JobId:        "job-1",
+				Queue:        queue,
+				Created:      time.Now(),
+				ExitCodes:    map[string]int32{"container-1": 1, "container-2": 0},
+				KubernetesId: "a1",
+				ContainerStatuses: []*api.ContainerStatus{
+					{Name: "container-1", ExitCode: 1, LogExcerpt: "error: failed\n"},
+					{Name: "container-2", ExitCode: 0},
+				},
+			})
+			assert.NoError(t, err)
+
+			assert.Equal(t, NewNullString("error: failed\n"), selectNullString(t, db,
+				"SELECT log_excerpt FROM job_run_container WHERE run_id = 'a1' AND container_name = 'container-1'"))
+			assert.Equal(t, sql.NullString{}, selectNullString(t, db,
+				"SELECT log_excerpt FROM job_run_container WHERE run_id = 'a1' AND container_name = 'container-2'"))
+		})
+	})
 }
 
 func Test_RecordNullNodeIfEmptyString(t *testing.T) {
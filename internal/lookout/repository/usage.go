@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+// Resources measured in bytes are accounted in GiB.
+var usageResourceUnits = map[string]float64{
+	"memory":            1 << 30,
+	"ephemeral-storage": 1 << 30,
+}
+
+type usageRow struct {
+	Group         sql.NullString  `db:"usage_group"`
+	Resource      sql.NullString  `db:"resource"`
+	Runs          sql.NullInt64   `db:"runs"`
+	RequestedSecs sql.NullFloat64 `db:"requested_seconds"`
+	UsedSecs      sql.NullFloat64 `db:"used_seconds"`
+}
+
+// GetUsage returns the resources requested and used by runs over the time range of opts, grouped by queue, owner,
+// cluster or user annotation. Runs which never finished, e.g. because their lease expired or their executor went away,
+// end when the next run of the same pod was created or when the job was cancelled, succeeded or failed. Only runs which
+// may still be running are accounted until now.
+func (r *SQLJobRepository) GetUsage(ctx context.Context, opts *lookout.GetUsageRequest) ([]*lookout.UsageInfo, error) {
+	if !opts.From.Before(opts.To) {
+		return nil, fmt.Errorf("invalid time range: %s is not before %s", opts.From, opts.To)
+	}
+	group, err := usageGroup(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	from := ToUTC(opts.From)
+	to := ToUTC(opts.To)
+	end := goqu.COALESCE(jobRun_finished, nextRunCreated(), job_cancelled, jobFinished())
+	seconds := goqu.L("GREATEST(EXTRACT(EPOCH FROM (LEAST(COALESCE(?, ?), ?) - GREATEST(?, ?))), 0)",
+		end, ToUTC(r.clock.Now()), to, jobRun_started, from)
+
+	filters := []goqu.Expression{
+		jobRun_started.IsNotNull(),
+		jobRun_started.Lt(to),
+		goqu.Or(end.IsNull(), end.Gt(from)),
+	}
+	if queueFilter, ok := createQueueRestrictionFilter(ctx, job_queue); ok {
+		filters = append(filters, queueFilter)
+	}
+
+	runs := r.goquDb.
+		From(jobRunTable).
+		Join(jobTable, goqu.On(job_jobId.Eq(jobRun_jobId))).
+		Where(filters...)
+	if opts.GroupBy == "annotation" {
+		runs = runs.LeftJoin(userAnnotationLookupTable, goqu.On(
+			annotation_jobId.Eq(job_jobId),
+			annotation_key.Eq(opts.AnnotationKey)))
+	}
+
+	runCounts := make([]*usageRow, 0)
+	err = runs.
+		Select(
+			goqu.COALESCE(group, "").As("usage_group"),
+			goqu.COUNT(jobRun_runId).As("runs")).
+		GroupBy(goqu.I("usage_group")).
+		Prepared(true).ScanStructsContext(ctx, &runCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceUsage := make([]*usageRow, 0)
+	err = runs.
+		Join(jobResourceTable, goqu.On(
+			jobResource_jobId.Eq(jobRun_jobId),
+			jobResource_podNumber.Eq(goqu.COALESCE(jobRun_podNumber, 0)))).
+		LeftJoin(jobRunResourceTable, goqu.On(
+			jobRunResource_runId.Eq(jobRun_runId),
+			jobRunResource_resource.Eq(jobResource_resource))).
+		Select(
+			goqu.COALESCE(group, "").As("usage_group"),
+			jobResource_resource.As("resource"),
+			goqu.SUM(goqu.L("? * ?", jobResource_requested, seconds)).As("requested_seconds"),
+			goqu.SUM(goqu.L("COALESCE(?, 0) * ?", jobRunResource_maxUsed, seconds)).As("used_seconds")).
+		GroupBy(goqu.I("usage_group"), goqu.I("resource")).
+		Prepared(true).ScanStructsContext(ctx, &resourceUsage)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsToUsage(runCounts, resourceUsage), nil
+}
+
+// nextRunCreated returns when the run following the current job_run row on the same pod was created.
+func nextRunCreated() exp.LiteralExpression {
+	return goqu.L(`(SELECT MIN(COALESCE(next_run.created, next_run.started)) FROM job_run AS next_run
+		WHERE next_run.job_id = job_run.job_id
+		AND next_run.pod_number IS NOT DISTINCT FROM job_run.pod_number
+		AND COALESCE(next_run.created, next_run.started) > job_run.started)`)
+}
+
+// jobFinished returns when the last run of a succeeded or failed job finished.
+func jobFinished() exp.CaseExpression {
+	return goqu.Case().
+		When(job_state.In(JobStateToIntMap[JobSucceeded], JobStateToIntMap[JobFailed]),
+			goqu.L("(SELECT MAX(last_run.finished) FROM job_run AS last_run WHERE last_run.job_id = job_run.job_id)"))
+}
+
+func usageGroup(opts *lookout.GetUsageRequest) (exp.IdentifierExpression, error) {
+	switch opts.GroupBy {
+	case "", "queue":
+		return job_queue, nil
+	case "owner":
+		return job_owner, nil
+	case "cluster":
+		return jobRun_cluster, nil
+	case "annotation":
+		if opts.AnnotationKey == "" {
+			return nil, fmt.Errorf("annotation key is required to group usage by annotation")
+		}
+		return annotation_value, nil
+	}
+	return nil, fmt.Errorf("unknown usage grouping: %q", opts.GroupBy)
+}
+
+func rowsToUsage(runCounts []*usageRow, resourceUsage []*usageRow) []*lookout.UsageInfo {
+	usageByGroup := map[string]*lookout.UsageInfo{}
+	for _, row := range runCounts {
+		group := ParseNullString(row.Group)
+		usageByGroup[group] = &lookout.UsageInfo{
+			Group:                  group,
+			Runs:                   uint32(ParseNullInt(row.Runs)),
+			RequestedResourceHours: map[string]float64{},
+			UsedResourceHours:      map[string]float64{},
+		}
+	}
+
+	for _, row := range resourceUsage {
+		usage, ok := usageByGroup[ParseNullString(row.Group)]
+		if !ok {
+			continue
+		}
+		resource := ParseNullString(row.Resource)
+		unit := 1.0
+		if resourceUnit, ok := usageResourceUnits[resource]; ok {
+			unit = resourceUnit
+		}
+		usage.RequestedResourceHours[resource] = ParseNullFloat(row.RequestedSecs) / 3600 / unit
+		usage.UsedResourceHours[resource] = ParseNullFloat(row.UsedSecs) / 3600 / unit
+	}
+
+	result := make([]*lookout.UsageInfo, 0, len(usageByGroup))
+	for _, usage := range usageByGroup {
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Group < result[j].Group
+	})
+	return result
+}
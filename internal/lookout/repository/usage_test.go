@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/G-Research/armada/internal/common/util"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestGetUsage_GroupsResourceHoursByQueue(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DummyClock{someTime.Add(10 * time.Hour)})
+
+		finishedJob := usageTestJob("queue-a", map[string]string{userAnnotationPrefix + "project": "p1"})
+		runningJob := usageTestJob("queue-b", nil)
+		runId := util.NewULID()
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: finishedJob.Id, Queue: finishedJob.Queue, Created: someTime, Job: *finishedJob},
+			&api.JobSubmittedEvent{JobId: runningJob.Id, Queue: runningJob.Queue, Created: someTime, Job: *runningJob},
+			&api.JobRunningEvent{JobId: finishedJob.Id, Queue: finishedJob.Queue, Created: someTime, KubernetesId: runId, ClusterId: cluster},
+			&api.JobUtilisationEvent{JobId: finishedJob.Id, Queue: finishedJob.Queue, Created: someTime.Add(time.Hour), KubernetesId: runId,
+				MaxResourcesForPeriod: map[string]resource.Quantity{"cpu": resource.MustParse("1")}},
+			&api.JobUtilisationEvent{JobId: finishedJob.Id, Queue: finishedJob.Queue, Created: someTime.Add(time.Hour), KubernetesId: runId,
+				MaxResourcesForPeriod: map[string]resource.Quantity{"cpu": resource.MustParse("500m")}},
+			&api.JobSucceededEvent{JobId: finishedJob.Id, Queue: finishedJob.Queue, Created: someTime.Add(2 * time.Hour), KubernetesId: runId, ClusterId: cluster},
+			&api.JobRunningEvent{JobId: runningJob.Id, Queue: runningJob.Queue, Created: someTime.Add(8 * time.Hour), KubernetesId: util.NewULID(), ClusterId: cluster},
+		})
+		assert.NoError(t, err)
+
+		usage, err := jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(24 * time.Hour)})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(usage))
+
+		assert.Equal(t, "queue-a", usage[0].Group)
+		assert.Equal(t, uint32(1), usage[0].Runs)
+		assert.InDelta(t, 4.0, usage[0].RequestedResourceHours["cpu"], 0.001)
+		assert.InDelta(t, 2.0, usage[0].UsedResourceHours["cpu"], 0.001)
+		assert.InDelta(t, 8.0, usage[0].RequestedResourceHours["memory"], 0.001)
+
+		// Running jobs are accounted until now
+		assert.Equal(t, "queue-b", usage[1].Group)
+		assert.InDelta(t, 4.0, usage[1].RequestedResourceHours["cpu"], 0.001)
+
+		usage, err = jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{
+			From:          someTime.Add(time.Hour),
+			To:            someTime.Add(24 * time.Hour),
+			GroupBy:       "annotation",
+			AnnotationKey: "project",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(usage))
+		assert.Equal(t, "", usage[0].Group)
+		assert.Equal(t, "p1", usage[1].Group)
+		assert.InDelta(t, 2.0, usage[1].RequestedResourceHours["cpu"], 0.001)
+	})
+}
+
+func TestGetUsage_EndsRunsOfCancelledJobs(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DummyClock{someTime.Add(10 * time.Hour)})
+
+		job := usageTestJob(queue, nil)
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, Job: *job},
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, KubernetesId: util.NewULID(), ClusterId: cluster},
+			&api.JobCancelledEvent{JobId: job.Id, Queue: job.Queue, Created: someTime.Add(time.Hour)},
+		})
+		require.NoError(t, err)
+
+		usage, err := jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(24 * time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+		assert.InDelta(t, 2.0, usage[0].RequestedResourceHours["cpu"], 0.001)
+
+		usage, err = jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime.Add(2 * time.Hour), To: someTime.Add(24 * time.Hour)})
+		require.NoError(t, err)
+		assert.Empty(t, usage)
+	})
+}
+
+func TestGetUsage_EndsOrphanedRunsWhenNextRunIsCreated(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DummyClock{someTime.Add(10 * time.Hour)})
+
+		job := usageTestJob(queue, nil)
+		retryId := util.NewULID()
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, Job: *job},
+			// The first run never finishes, e.g. because its lease expired
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, KubernetesId: util.NewULID(), ClusterId: cluster},
+			&api.JobPendingEvent{JobId: job.Id, Queue: job.Queue, Created: someTime.Add(time.Hour), KubernetesId: retryId, ClusterId: cluster},
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime.Add(time.Hour), KubernetesId: retryId, ClusterId: cluster},
+			&api.JobSucceededEvent{JobId: job.Id, Queue: job.Queue, Created: someTime.Add(3 * time.Hour), KubernetesId: retryId, ClusterId: cluster},
+		})
+		require.NoError(t, err)
+
+		usage, err := jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(24 * time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+		assert.Equal(t, uint32(2), usage[0].Runs)
+		assert.InDelta(t, 6.0, usage[0].RequestedResourceHours["cpu"], 0.001)
+	})
+}
+
+func TestGetUsage_EndsOrphanedRunsWhenJobFinished(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DummyClock{someTime.Add(10 * time.Hour)})
+
+		job := usageTestJob(queue, nil)
+		job.PodSpecs = []*v1.PodSpec{job.PodSpec, job.PodSpec}
+		job.PodSpec = nil
+		failedId := util.NewULID()
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, Job: *job},
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, KubernetesId: util.NewULID(), ClusterId: cluster, PodNumber: 0},
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, KubernetesId: failedId, ClusterId: cluster, PodNumber: 1},
+			&api.JobFailedEvent{JobId: job.Id, Queue: job.Queue, Created: someTime.Add(2 * time.Hour), KubernetesId: failedId, ClusterId: cluster, PodNumber: 1},
+		})
+		require.NoError(t, err)
+
+		usage, err := jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(24 * time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+		assert.InDelta(t, 8.0, usage[0].RequestedResourceHours["cpu"], 0.001)
+	})
+}
+
+func TestGetUsage_AccountsRequestsOfEachPod(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobStore := NewSQLJobStore(db, userAnnotationPrefix)
+		jobRepo := NewSQLJobRepository(db, &DummyClock{someTime.Add(10 * time.Hour)})
+
+		job := usageTestJob(queue, nil)
+		smallPod := &v1.PodSpec{Containers: []v1.Container{{
+			Resources: v1.ResourceRequirements{Requests: v1.ResourceList{"cpu": resource.MustParse("1")}},
+		}}}
+		job.PodSpecs = []*v1.PodSpec{job.PodSpec, smallPod}
+		job.PodSpec = nil
+		err := jobStore.RecordEvents([]api.Event{
+			&api.JobSubmittedEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, Job: *job},
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, KubernetesId: util.NewULID(), ClusterId: cluster, PodNumber: 0},
+			&api.JobRunningEvent{JobId: job.Id, Queue: job.Queue, Created: someTime, KubernetesId: util.NewULID(), ClusterId: cluster, PodNumber: 1},
+		})
+		require.NoError(t, err)
+
+		usage, err := jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(24 * time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, usage, 1)
+		assert.Equal(t, uint32(2), usage[0].Runs)
+		assert.InDelta(t, 30.0, usage[0].RequestedResourceHours["cpu"], 0.001)
+		assert.InDelta(t, 40.0, usage[0].RequestedResourceHours["memory"], 0.001)
+	})
+}
+
+func TestJobResourceRecords_RecordsRequestsOfEachPod(t *testing.T) {
+	job := usageTestJob(queue, nil)
+	job.PodSpecs = []*v1.PodSpec{job.PodSpec, job.PodSpec}
+	job.PodSpec = nil
+
+	records := jobResourceRecords(job)
+
+	assert.Equal(t, []goqu.Record{
+		{"job_id": job.Id, "pod_number": 0, "resource": "cpu", "requested": 2.0},
+		{"job_id": job.Id, "pod_number": 0, "resource": "memory", "requested": float64(4 << 30)},
+		{"job_id": job.Id, "pod_number": 1, "resource": "cpu", "requested": 2.0},
+		{"job_id": job.Id, "pod_number": 1, "resource": "memory", "requested": float64(4 << 30)},
+	}, records)
+}
+
+func TestGetUsage_ErrorsIfGroupingIsInvalid(t *testing.T) {
+	withDatabase(t, func(db *goqu.Database) {
+		jobRepo := NewSQLJobRepository(db, &DefaultClock{})
+
+		_, err := jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(time.Hour), GroupBy: "unknown"})
+		assert.Error(t, err)
+		_, err = jobRepo.GetUsage(ctx, &lookout.GetUsageRequest{From: someTime, To: someTime.Add(time.Hour), GroupBy: "annotation"})
+		assert.Error(t, err)
+	})
+}
+
+func usageTestJob(queue string, annotations map[string]string) *api.Job {
+	return &api.Job{
+		Id:          util.NewULID(),
+		Queue:       queue,
+		JobSetId:    "job-set",
+		Owner:       "user",
+		Created:     someTime,
+		Annotations: annotations,
+		PodSpec: &v1.PodSpec{Containers: []v1.Container{{
+			Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+				"cpu":    resource.MustParse("2"),
+				"memory": resource.MustParse("4Gi"),
+			}},
+		}}},
+	}
+}
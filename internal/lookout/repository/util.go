@@ -59,6 +59,20 @@ func StartsWith(field exp.IdentifierExpression, pattern string) goqu.Expression
 	return field.Like(pattern + "%")
 }
 
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLikePattern escapes the wildcards of LIKE patterns, so s only matches itself.
+func escapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
+
+var globWildcardReplacer = strings.NewReplacer("*", "%", "?", "_")
+
+// globToLikePattern converts a glob pattern using * and ? wildcards to a LIKE pattern.
+func globToLikePattern(glob string) string {
+	return globWildcardReplacer.Replace(escapeLikePattern(glob))
+}
+
 func NewNullString(s string) sql.NullString {
 	if len(s) == 0 {
 		return sql.NullString{}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/client/queue"
+)
+
+// queueAccess is the set of queues a principal may watch.
+type queueAccess struct {
+	all    bool
+	queues map[string]bool
+}
+
+func (a *queueAccess) canWatch(name string) bool {
+	return a.all || a.queues[name]
+}
+
+func (a *queueAccess) queueNames() []string {
+	names := make([]string, 0, len(a.queues))
+	for name := range a.queues {
+		names = append(names, name)
+	}
+	return names
+}
+
+// getQueueAccess returns the queues the principal of ctx may watch, following the rules of the Armada server:
+// principals with the watch_all_events permission may watch every queue, principals with the watch_events permission
+// may watch the queues they have the watch permission for. Queue owners have every permission of their queues.
+// Queues whose permissions haven't been synced from Armada can only be watched with watch_all_events.
+func (s *LookoutServer) getQueueAccess(ctx context.Context) (*queueAccess, error) {
+	if s.permissions.UserHasPermission(ctx, permissions.WatchAllEvents) {
+		return &queueAccess{all: true}, nil
+	}
+	access := &queueAccess{queues: map[string]bool{}}
+	if !s.permissions.UserHasPermission(ctx, permissions.WatchEvents) {
+		return access, nil
+	}
+
+	queuePermissions, err := s.jobRepository.GetQueuePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	principal := authorization.GetPrincipal(ctx)
+	for _, apiQueue := range queuePermissions {
+		if canWatchQueue(principal, apiQueue) {
+			access.queues[apiQueue.Name] = true
+		}
+	}
+	return access, nil
+}
+
+// RestrictToWatchableQueues returns a context which limits what is read from the repository with it to the queues the
+// principal of ctx may watch.
+func (s *LookoutServer) RestrictToWatchableQueues(ctx context.Context) (context.Context, error) {
+	access, err := s.getQueueAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !access.all {
+		ctx = repository.RestrictToQueues(ctx, access.queueNames())
+	}
+	return ctx, nil
+}
+
+func canWatchQueue(principal authorization.Principal, apiQueue *api.Queue) bool {
+	q, err := queuePermissions(apiQueue)
+	if err != nil {
+		log.Errorf("Invalid permissions of queue %s: %v", apiQueue.Name, err)
+		return false
+	}
+
+	subjects := queue.PermissionSubjects{{
+		Name: principal.GetName(),
+		Kind: queue.PermissionSubjectKindUser,
+	}}
+	for _, group := range principal.GetGroupNames() {
+		subjects = append(subjects, queue.PermissionSubject{
+			Name: group,
+			Kind: queue.PermissionSubjectKindGroup,
+		})
+	}
+	for _, subject := range subjects {
+		if q.HasPermission(subject, queue.PermissionVerbWatch) {
+			return true
+		}
+	}
+	return false
+}
+
+// queuePermissions maps the owners and permissions of apiQueue in the same way as queue.NewQueue, without validating
+// the fields of the queue which aren't synced to Lookout.
+func queuePermissions(apiQueue *api.Queue) (queue.Queue, error) {
+	q := queue.Queue{Name: apiQueue.Name}
+	if len(apiQueue.UserOwners) != 0 || len(apiQueue.GroupOwners) != 0 {
+		q.Permissions = append(q.Permissions, queue.NewPermissionsFromOwners(apiQueue.UserOwners, apiQueue.GroupOwners))
+	}
+	for _, apiPermissions := range apiQueue.Permissions {
+		perm, err := queue.NewPermissions(apiPermissions)
+		if err != nil {
+			return queue.Queue{}, err
+		}
+		q.Permissions = append(q.Permissions, perm)
+	}
+	return q, nil
+}
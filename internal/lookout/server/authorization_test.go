@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/G-Research/armada/internal/armada/permissions"
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/common/auth/permission"
+	"github.com/G-Research/armada/internal/lookout/configuration"
+	"github.com/G-Research/armada/internal/lookout/repository"
+	"github.com/G-Research/armada/pkg/api"
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+type fakeJobRepository struct {
+	repository.JobRepository
+	queuePermissions []*api.Queue
+	queueInfos       []*lookout.QueueInfo
+	jobSetInfos      []*lookout.JobSetInfo
+	jobDetails       *lookout.GetJobDetailsResponse
+	queryContext     context.Context
+}
+
+func (r *fakeJobRepository) GetQueuePermissions(context.Context) ([]*api.Queue, error) {
+	return r.queuePermissions, nil
+}
+
+func (r *fakeJobRepository) GetQueueInfos(context.Context) ([]*lookout.QueueInfo, error) {
+	return r.queueInfos, nil
+}
+
+func (r *fakeJobRepository) GetJobSetInfos(context.Context, *lookout.GetJobSetsRequest) ([]*lookout.JobSetInfo, error) {
+	return r.jobSetInfos, nil
+}
+
+func (r *fakeJobRepository) GetJobsPage(ctx context.Context, _ *lookout.GetJobsRequest) (*lookout.GetJobsResponse, error) {
+	r.queryContext = ctx
+	return &lookout.GetJobsResponse{}, nil
+}
+
+func (r *fakeJobRepository) GetJobDetails(context.Context, string) (*lookout.GetJobDetailsResponse, error) {
+	return r.jobDetails, nil
+}
+
+func (r *fakeJobRepository) GetUsage(ctx context.Context, _ *lookout.GetUsageRequest) ([]*lookout.UsageInfo, error) {
+	r.queryContext = ctx
+	return nil, nil
+}
+
+func (r *fakeJobRepository) GetQueueHistory(ctx context.Context, _ *lookout.GetQueueHistoryRequest) ([]*lookout.QueueHistory, error) {
+	r.queryContext = ctx
+	return nil, nil
+}
+
+func (r *fakeJobRepository) GetFailureClusters(ctx context.Context, _ *lookout.GetFailureClustersRequest) (*lookout.GetFailureClustersResponse, error) {
+	r.queryContext = ctx
+	return &lookout.GetFailureClustersResponse{}, nil
+}
+
+func (r *fakeJobRepository) GetJobSetAnalytics(ctx context.Context, _ *lookout.GetJobSetAnalyticsRequest) (*lookout.GetJobSetAnalyticsResponse, error) {
+	r.queryContext = ctx
+	return &lookout.GetJobSetAnalyticsResponse{}, nil
+}
+
+func newTestServer(repo repository.JobRepository) *LookoutServer {
+	checker := authorization.NewPrincipalPermissionChecker(
+		map[permission.Permission][]string{
+			permissions.WatchEvents:    {"watchers", "admins"},
+			permissions.WatchAllEvents: {"admins"},
+		},
+		map[permission.Permission][]string{},
+		map[permission.Permission][]string{})
+	return NewLookoutServer(repo, checker, configuration.AccountingConfig{})
+}
+
+func newTestRepository() *fakeJobRepository {
+	return &fakeJobRepository{
+		queuePermissions: []*api.Queue{
+			{Name: "owned", UserOwners: []string{"alice"}},
+			{Name: "team", Permissions: []*api.Queue_Permissions{{
+				Subjects: []*api.Queue_Permissions_Subject{{Kind: "Group", Name: "team"}},
+				Verbs:    []string{"watch"},
+			}}},
+			{Name: "submit-only", Permissions: []*api.Queue_Permissions{{
+				Subjects: []*api.Queue_Permissions_Subject{{Kind: "Group", Name: "team"}},
+				Verbs:    []string{"submit"},
+			}}},
+		},
+		queueInfos: []*lookout.QueueInfo{
+			{Queue: "owned"}, {Queue: "team"}, {Queue: "submit-only"}, {Queue: "not-synced"},
+		},
+		jobSetInfos: []*lookout.JobSetInfo{
+			{Queue: "owned", JobSet: "a"}, {Queue: "other", JobSet: "b"},
+		},
+	}
+}
+
+func withPrincipal(name string, groups ...string) context.Context {
+	return authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal(name, groups))
+}
+
+func TestOverview_ShowsQueuesThePrincipalMayWatch(t *testing.T) {
+	server := newTestServer(newTestRepository())
+
+	overview, err := server.Overview(withPrincipal("alice", "watchers", "team"), &types.Empty{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*lookout.QueueInfo{{Queue: "owned"}, {Queue: "team"}}, overview.Queues)
+}
+
+func TestOverview_ShowsAllQueuesWithWatchAllEvents(t *testing.T) {
+	repo := newTestRepository()
+	server := newTestServer(repo)
+
+	overview, err := server.Overview(withPrincipal("bob", "admins"), &types.Empty{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, repo.queueInfos, overview.Queues)
+}
+
+func TestOverview_ShowsNoQueuesWithoutWatchEvents(t *testing.T) {
+	server := newTestServer(newTestRepository())
+
+	overview, err := server.Overview(withPrincipal("alice", "team"), &types.Empty{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, overview.Queues)
+}
+
+func TestGetJobSets_ShowsJobSetsOfQueuesThePrincipalMayWatch(t *testing.T) {
+	server := newTestServer(newTestRepository())
+
+	response, err := server.GetJobSets(withPrincipal("alice", "watchers"), &lookout.GetJobSetsRequest{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*lookout.JobSetInfo{{Queue: "owned", JobSet: "a"}}, response.JobSetInfos)
+}
+
+func TestGetJobs_RestrictsQueriesToQueuesThePrincipalMayWatch(t *testing.T) {
+	repo := newTestRepository()
+	server := newTestServer(repo)
+
+	_, err := server.GetJobs(withPrincipal("carol", "watchers", "team"), &lookout.GetJobsRequest{})
+	assert.NoError(t, err)
+	queues, restricted := repository.RestrictedQueues(repo.queryContext)
+	assert.True(t, restricted)
+	assert.Equal(t, []string{"team"}, queues)
+
+	_, err = server.GetJobs(withPrincipal("bob", "admins"), &lookout.GetJobsRequest{})
+	assert.NoError(t, err)
+	_, restricted = repository.RestrictedQueues(repo.queryContext)
+	assert.False(t, restricted)
+}
+
+func TestGetJobDetails_DeniesJobsOfQueuesThePrincipalMayNotWatch(t *testing.T) {
+	repo := newTestRepository()
+	repo.jobDetails = &lookout.GetJobDetailsResponse{JobInfo: &lookout.JobInfo{Job: &api.Job{Id: "job", Queue: "team"}}}
+	server := newTestServer(repo)
+
+	details, err := server.GetJobDetails(withPrincipal("carol", "watchers", "team"), &lookout.GetJobDetailsRequest{JobId: "job"})
+	assert.NoError(t, err)
+	assert.Equal(t, repo.jobDetails, details)
+
+	_, err = server.GetJobDetails(withPrincipal("alice", "watchers"), &lookout.GetJobDetailsRequest{JobId: "job"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetJobDetails(withPrincipal("carol", "team"), &lookout.GetJobDetailsRequest{JobId: "job"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetJobSetAnalytics_DeniesQueuesThePrincipalMayNotWatch(t *testing.T) {
+	server := newTestServer(newTestRepository())
+
+	_, err := server.GetJobSetAnalytics(withPrincipal("alice", "watchers"), &lookout.GetJobSetAnalyticsRequest{Queue: "owned", JobSetIds: []string{"a"}})
+	assert.NoError(t, err)
+
+	_, err = server.GetJobSetAnalytics(withPrincipal("alice", "watchers"), &lookout.GetJobSetAnalyticsRequest{Queue: "team", JobSetIds: []string{"a"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.GetJobSetAnalytics(withPrincipal("bob", "admins"), &lookout.GetJobSetAnalyticsRequest{Queue: "team", JobSetIds: []string{"a"}})
+	assert.NoError(t, err)
+}
+
+func TestQueries_RestrictToQueuesThePrincipalMayWatch(t *testing.T) {
+	for name, query := range map[string]func(server *LookoutServer, ctx context.Context) error{
+		"usage": func(server *LookoutServer, ctx context.Context) error {
+			_, err := server.GetUsage(ctx, &lookout.GetUsageRequest{})
+			return err
+		},
+		"queue history": func(server *LookoutServer, ctx context.Context) error {
+			_, err := server.GetQueueHistory(ctx, &lookout.GetQueueHistoryRequest{})
+			return err
+		},
+		"failure clusters": func(server *LookoutServer, ctx context.Context) error {
+			_, err := server.GetFailureClusters(ctx, &lookout.GetFailureClustersRequest{})
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			repo := newTestRepository()
+			server := newTestServer(repo)
+
+			err := query(server, withPrincipal("alice", "watchers"))
+			assert.NoError(t, err)
+			queues, restricted := repository.RestrictedQueues(repo.queryContext)
+			assert.True(t, restricted)
+			assert.Equal(t, []string{"owned"}, queues)
+
+			err = query(server, withPrincipal("bob", "admins"))
+			assert.NoError(t, err)
+			_, restricted = repository.RestrictedQueues(repo.queryContext)
+			assert.False(t, restricted)
+		})
+	}
+}
@@ -7,38 +7,158 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/G-Research/armada/internal/common/auth/authorization"
+	"github.com/G-Research/armada/internal/lookout/configuration"
 	"github.com/G-Research/armada/internal/lookout/repository"
 	"github.com/G-Research/armada/pkg/api/lookout"
 )
 
 type LookoutServer struct {
-	jobRepository repository.JobRepository
+	jobRepository    repository.JobRepository
+	permissions      authorization.PermissionChecker
+	accountingConfig configuration.AccountingConfig
 }
 
-func NewLookoutServer(jobRepository repository.JobRepository) *LookoutServer {
-	return &LookoutServer{jobRepository: jobRepository}
+func NewLookoutServer(
+	jobRepository repository.JobRepository,
+	permissions authorization.PermissionChecker,
+	accountingConfig configuration.AccountingConfig) *LookoutServer {
+	return &LookoutServer{jobRepository: jobRepository, permissions: permissions, accountingConfig: accountingConfig}
 }
 
 func (s *LookoutServer) Overview(ctx context.Context, _ *types.Empty) (*lookout.SystemOverview, error) {
+	access, err := s.getQueueAccess(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
 	queues, err := s.jobRepository.GetQueueInfos(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to query queue stats: %s", err)
 	}
-	return &lookout.SystemOverview{Queues: queues}, nil
+
+	visible := make([]*lookout.QueueInfo, 0, len(queues))
+	for _, queue := range queues {
+		if access.canWatch(queue.Queue) {
+			visible = append(visible, queue)
+		}
+	}
+	return &lookout.SystemOverview{Queues: visible}, nil
 }
 
 func (s *LookoutServer) GetJobSets(ctx context.Context, opts *lookout.GetJobSetsRequest) (*lookout.GetJobSetsResponse, error) {
+	access, err := s.getQueueAccess(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
 	jobSets, err := s.jobRepository.GetJobSetInfos(ctx, opts)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to query queue stats: %s", err)
 	}
-	return &lookout.GetJobSetsResponse{JobSetInfos: jobSets}, nil
+
+	visible := make([]*lookout.JobSetInfo, 0, len(jobSets))
+	for _, jobSet := range jobSets {
+		if access.canWatch(jobSet.Queue) {
+			visible = append(visible, jobSet)
+		}
+	}
+	return &lookout.GetJobSetsResponse{JobSetInfos: visible}, nil
 }
 
 func (s *LookoutServer) GetJobs(ctx context.Context, opts *lookout.GetJobsRequest) (*lookout.GetJobsResponse, error) {
-	jobInfos, err := s.jobRepository.GetJobs(ctx, opts)
+	ctx, err := s.RestrictToWatchableQueues(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+
+	page, err := s.jobRepository.GetJobsPage(ctx, opts)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to query jobs in queue: %s", err)
 	}
-	return &lookout.GetJobsResponse{JobInfos: jobInfos}, nil
+	return page, nil
+}
+
+func (s *LookoutServer) GetJobDetails(ctx context.Context, opts *lookout.GetJobDetailsRequest) (*lookout.GetJobDetailsResponse, error) {
+	access, err := s.getQueueAccess(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+	details, err := s.jobRepository.GetJobDetails(ctx, opts.JobId)
+	if err == repository.ErrJobNotFound {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", opts.JobId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query job details: %s", err)
+	}
+	if queue := details.GetJobInfo().GetJob().GetQueue(); !access.canWatch(queue) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to watch jobs of queue %s", queue)
+	}
+	return details, nil
+}
+
+func (s *LookoutServer) GetUsage(ctx context.Context, opts *lookout.GetUsageRequest) (*lookout.GetUsageResponse, error) {
+	ctx, err := s.RestrictToWatchableQueues(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+	usage, err := s.jobRepository.GetUsage(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query usage: %s", err)
+	}
+	for _, usageInfo := range usage {
+		addCost(usageInfo, s.accountingConfig.UnitPrices)
+	}
+	return &lookout.GetUsageResponse{Usage: usage}, nil
+}
+
+// addCost prices the resources requested by the runs.
+func addCost(usage *lookout.UsageInfo, unitPrices map[string]float64) {
+	usage.Cost = map[string]float64{}
+	usage.TotalCost = 0
+	for resource, hours := range usage.RequestedResourceHours {
+		price, ok := unitPrices[resource]
+		if !ok {
+			continue
+		}
+		usage.Cost[resource] = hours * price
+		usage.TotalCost += hours * price
+	}
+}
+
+func (s *LookoutServer) GetQueueHistory(ctx context.Context, opts *lookout.GetQueueHistoryRequest) (*lookout.GetQueueHistoryResponse, error) {
+	ctx, err := s.RestrictToWatchableQueues(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+	queues, err := s.jobRepository.GetQueueHistory(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query queue history: %s", err)
+	}
+	return &lookout.GetQueueHistoryResponse{Queues: queues}, nil
+}
+
+func (s *LookoutServer) GetFailureClusters(ctx context.Context, opts *lookout.GetFailureClustersRequest) (*lookout.GetFailureClustersResponse, error) {
+	ctx, err := s.RestrictToWatchableQueues(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+	failures, err := s.jobRepository.GetFailureClusters(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query failure clusters: %s", err)
+	}
+	return failures, nil
+}
+
+func (s *LookoutServer) GetJobSetAnalytics(ctx context.Context, opts *lookout.GetJobSetAnalyticsRequest) (*lookout.GetJobSetAnalyticsResponse, error) {
+	access, err := s.getQueueAccess(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+	if !access.canWatch(opts.Queue) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to watch jobs of queue %s", opts.Queue)
+	}
+	analytics, err := s.jobRepository.GetJobSetAnalytics(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query job set analytics: %s", err)
+	}
+	return analytics, nil
 }
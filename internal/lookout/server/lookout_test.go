@@ -0,0 +1,30 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/G-Research/armada/pkg/api/lookout"
+)
+
+func TestAddCost_PricesRequestedResources(t *testing.T) {
+	usage := &lookout.UsageInfo{
+		RequestedResourceHours: map[string]float64{"cpu": 10, "memory": 20, "nvidia.com/gpu": 2},
+		UsedResourceHours:      map[string]float64{"cpu": 5, "memory": 10, "nvidia.com/gpu": 1},
+	}
+
+	addCost(usage, map[string]float64{"cpu": 0.5, "nvidia.com/gpu": 3})
+
+	assert.Equal(t, map[string]float64{"cpu": 5, "nvidia.com/gpu": 6}, usage.Cost)
+	assert.Equal(t, 11.0, usage.TotalCost)
+}
+
+func TestAddCost_WithoutPrices(t *testing.T) {
+	usage := &lookout.UsageInfo{RequestedResourceHours: map[string]float64{"cpu": 10}}
+
+	addCost(usage, nil)
+
+	assert.Empty(t, usage.Cost)
+	assert.Equal(t, 0.0, usage.TotalCost)
+}
@@ -354,6 +354,27 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"QueueWebhook\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Webhook receives job lifecycle notifications for the queue as signed JSON POST requests\",\n" +
+		"      \"properties\": {\n" +
+		"        \"events\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"format\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"secretName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"url\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiCancellationResult\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -373,7 +394,8 @@ func SwaggerJsonTemplate() string {
 		"        \"Error\",\n" +
 		"        \"Evicted\",\n" +
 		"        \"OOM\",\n" +
-		"        \"DeadlineExceeded\"\n" +
+		"        \"DeadlineExceeded\",\n" +
+		"        \"Unhealthy\"\n" +
 		"      ]\n" +
 		"    },\n" +
 		"    \"apiContainerStatus\": {\n" +
@@ -386,6 +408,9 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
+		"        \"logExcerpt\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"message\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1380,6 +1405,12 @@ func SwaggerJsonTemplate() string {
 		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"webhooks\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/QueueWebhook\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	CloudEventsContentType = "application/cloudevents+json"
+	CloudEventTypePrefix   = "io.armada.job."
+)
+
+// CloudEvent is the structured-mode JSON representation of an event as defined by the CloudEvents v1.0 specification.
+// Queue and job set are carried as the armadaqueue and armadajobset extension attributes.
+type CloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	Id              string      `json:"id"`
+	Type            string      `json:"type"`
+	Source          string      `json:"source"`
+	Subject         string      `json:"subject,omitempty"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Queue           string      `json:"armadaqueue"`
+	JobSetId        string      `json:"armadajobset"`
+	Data            interface{} `json:"data"`
+}
+
+// NewCloudEvent maps message to a CloudEvent. The type is derived from the event variant (e.g. io.armada.job.failed),
+// the source identifies the cluster the event originated from if there is one and the queue, and the subject is the job id.
+// The id is a digest of the protobuf encoding of message, so the same event gets the same id from every export path.
+func NewCloudEvent(message *EventMessage) (*CloudEvent, error) {
+	eventType, err := CloudEventType(message)
+	if err != nil {
+		return nil, err
+	}
+	event, err := UnwrapEvent(message)
+	if err != nil {
+		return nil, err
+	}
+	data, err := message.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event message: %s", err)
+	}
+	digest := sha256.Sum256(data)
+
+	return &CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		Id:              hex.EncodeToString(digest[:16]),
+		Type:            eventType,
+		Source:          cloudEventSource(event),
+		Subject:         event.GetJobId(),
+		Time:            event.GetCreated(),
+		DataContentType: "application/json",
+		Queue:           event.GetQueue(),
+		JobSetId:        event.GetJobSetId(),
+		Data:            event,
+	}, nil
+}
+
+func cloudEventSource(event Event) string {
+	if kubernetesEvent, ok := event.(KubernetesEvent); ok && kubernetesEvent.GetClusterId() != "" {
+		return fmt.Sprintf("/clusters/%s/queues/%s", kubernetesEvent.GetClusterId(), event.GetQueue())
+	}
+	return fmt.Sprintf("/queues/%s", event.GetQueue())
+}
+
+// CloudEventType returns the CloudEvents type of message, the name of its event variant prefixed with io.armada.job.
+func CloudEventType(message *EventMessage) (string, error) {
+	var name string
+	switch message.Events.(type) {
+	case *EventMessage_Submitted:
+		name = "submitted"
+	case *EventMessage_Queued:
+		name = "queued"
+	case *EventMessage_DuplicateFound:
+		name = "duplicate_found"
+	case *EventMessage_Leased:
+		name = "leased"
+	case *EventMessage_LeaseReturned:
+		name = "lease_returned"
+	case *EventMessage_LeaseExpired:
+		name = "lease_expired"
+	case *EventMessage_Pending:
+		name = "pending"
+	case *EventMessage_Running:
+		name = "running"
+	case *EventMessage_UnableToSchedule:
+		name = "unable_to_schedule"
+	case *EventMessage_Failed:
+		name = "failed"
+	case *EventMessage_Succeeded:
+		name = "succeeded"
+	case *EventMessage_Reprioritizing:
+		name = "reprioritizing"
+	case *EventMessage_Reprioritized:
+		name = "reprioritized"
+	case *EventMessage_Cancelling:
+		name = "cancelling"
+	case *EventMessage_Cancelled:
+		name = "cancelled"
+	case *EventMessage_Terminated:
+		name = "terminated"
+	case *EventMessage_Utilisation:
+		name = "utilisation"
+	case *EventMessage_IngressInfo:
+		name = "ingress_info"
+	case *EventMessage_Updated:
+		name = "updated"
+	default:
+		return "", fmt.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
+	}
+	return CloudEventTypePrefix + name, nil
+}
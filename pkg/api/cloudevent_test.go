@@ -0,0 +1,108 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCloudEvent_MapsEveryEventVariant(t *testing.T) {
+	created := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	types := map[string]bool{}
+
+	for _, wrapper := range (*EventMessage)(nil).XXX_OneofWrappers() {
+		message := newTestEventMessage(wrapper, created)
+
+		cloudEvent, err := NewCloudEvent(message)
+		assert.NoError(t, err)
+		assert.Equal(t, CloudEventsSpecVersion, cloudEvent.SpecVersion)
+		assert.True(t, strings.HasPrefix(cloudEvent.Type, CloudEventTypePrefix))
+		assert.Equal(t, "job-id", cloudEvent.Subject)
+		assert.Equal(t, "queue", cloudEvent.Queue)
+		assert.Equal(t, "job-set", cloudEvent.JobSetId)
+		assert.Equal(t, created, cloudEvent.Time)
+		assert.NotEmpty(t, cloudEvent.Id)
+		types[cloudEvent.Type] = true
+	}
+
+	assert.Len(t, types, len((*EventMessage)(nil).XXX_OneofWrappers()))
+}
+
+func TestNewCloudEvent_Failed(t *testing.T) {
+	message := &EventMessage{
+		Events: &EventMessage_Failed{
+			Failed: &JobFailedEvent{
+				JobId:     "job-id",
+				JobSetId:  "job-set",
+				Queue:     "queue",
+				Created:   time.Now(),
+				ClusterId: "cluster",
+				Reason:    "OOMKilled",
+			},
+		},
+	}
+
+	cloudEvent, err := NewCloudEvent(message)
+	assert.NoError(t, err)
+	assert.Equal(t, "io.armada.job.failed", cloudEvent.Type)
+	assert.Equal(t, "/clusters/cluster/queues/queue", cloudEvent.Source)
+
+	data, err := json.Marshal(cloudEvent)
+	assert.NoError(t, err)
+	decoded := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "1.0", decoded["specversion"])
+	assert.Equal(t, "job-id", decoded["subject"])
+	assert.Equal(t, "OOMKilled", decoded["data"].(map[string]interface{})["reason"])
+}
+
+func TestNewCloudEvent_SourceWithoutCluster(t *testing.T) {
+	message := &EventMessage{
+		Events: &EventMessage_Submitted{
+			Submitted: &JobSubmittedEvent{JobId: "job-id", JobSetId: "job-set", Queue: "queue"},
+		},
+	}
+
+	cloudEvent, err := NewCloudEvent(message)
+	assert.NoError(t, err)
+	assert.Equal(t, "io.armada.job.submitted", cloudEvent.Type)
+	assert.Equal(t, "/queues/queue", cloudEvent.Source)
+}
+
+func TestNewCloudEvent_IdIsStable(t *testing.T) {
+	created := time.Now()
+	first, err := NewCloudEvent(newTestEventMessage((*EventMessage_Running)(nil), created))
+	assert.NoError(t, err)
+	second, err := NewCloudEvent(newTestEventMessage((*EventMessage_Running)(nil), created))
+	assert.NoError(t, err)
+	other, err := NewCloudEvent(newTestEventMessage((*EventMessage_Pending)(nil), created))
+	assert.NoError(t, err)
+
+	assert.Equal(t, first.Id, second.Id)
+	assert.NotEqual(t, first.Id, other.Id)
+}
+
+func TestCloudEventType_UnknownEvent(t *testing.T) {
+	_, err := CloudEventType(&EventMessage{})
+	assert.Error(t, err)
+}
+
+// newTestEventMessage populates the oneof wrapper type of wrapper with an event for job-id in job-set of queue
+func newTestEventMessage(wrapper interface{}, created time.Time) *EventMessage {
+	wrapperValue := reflect.New(reflect.TypeOf(wrapper).Elem())
+	eventField := wrapperValue.Elem().Field(0)
+	eventValue := reflect.New(eventField.Type().Elem())
+	eventField.Set(eventValue)
+
+	event := eventValue.Elem()
+	event.FieldByName("JobId").SetString("job-id")
+	event.FieldByName("JobSetId").SetString("job-set")
+	event.FieldByName("Queue").SetString("queue")
+	event.FieldByName("Created").Set(reflect.ValueOf(created))
+
+	return &EventMessage{Events: wrapperValue.Interface().(isEventMessage_Events)}
+}
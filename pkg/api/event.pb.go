@@ -45,6 +45,7 @@ const (
 	Cause_Evicted          Cause = 1
 	Cause_OOM              Cause = 2
 	Cause_DeadlineExceeded Cause = 3
+	Cause_Unhealthy        Cause = 4
 )
 
 var Cause_name = map[int32]string{
@@ -52,6 +53,7 @@ var Cause_name = map[int32]string{
 	1: "Evicted",
 	2: "OOM",
 	3: "DeadlineExceeded",
+	4: "Unhealthy",
 }
 
 var Cause_value = map[string]int32{
@@ -59,6 +61,7 @@ var Cause_value = map[string]int32{
 	"Evicted":          1,
 	"OOM":              2,
 	"DeadlineExceeded": 3,
+	"Unhealthy":        4,
 }
 
 func (x Cause) String() string {
@@ -2209,11 +2212,12 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 }
 
 type ContainerStatus struct {
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ExitCode int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
-	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	Cause    Cause  `protobuf:"varint,5,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExitCode   int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cause      Cause  `protobuf:"varint,5,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+	LogExcerpt string `protobuf:"bytes,6,opt,name=log_excerpt,json=logExcerpt,proto3" json:"logExcerpt,omitempty"`
 }
 
 func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
@@ -2283,6 +2287,13 @@ func (m *ContainerStatus) GetCause() Cause {
 	return Cause_Error
 }
 
+func (m *ContainerStatus) GetLogExcerpt() string {
+	if m != nil {
+		return m.LogExcerpt
+	}
+	return ""
+}
+
 type EventList struct {
 	Events []*EventMessage `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
@@ -2549,129 +2560,130 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
 
 var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 1942 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x95, 0x4b, 0x89, 0x5f, 0x8f, 0x12, 0x45, 0x8d, 0x65, 0x79, 0x4d, 0xdb, 0xb2, 0xba, 0x01, 0x0c,
-	0x35, 0x85, 0x49, 0x57, 0x2e, 0x02, 0xd7, 0x48, 0x83, 0x56, 0x32, 0x5d, 0x8a, 0x88, 0x1a, 0x7b,
-	0x65, 0xa3, 0x87, 0x1c, 0x88, 0xfd, 0x18, 0xd1, 0x23, 0x2d, 0x77, 0x36, 0xbb, 0xb3, 0xb2, 0xd4,
-	0x20, 0x40, 0x91, 0x53, 0x8f, 0x01, 0x8a, 0x1e, 0x8a, 0xa0, 0x87, 0xfe, 0x87, 0x9e, 0x8a, 0x16,
-	0xed, 0x31, 0x40, 0x2f, 0x01, 0x7a, 0x09, 0x8a, 0x20, 0x69, 0xed, 0xfc, 0x8d, 0xa2, 0xc5, 0xcc,
-	0xec, 0x92, 0xb3, 0x14, 0x45, 0xa1, 0x5f, 0x28, 0xad, 0xf6, 0x24, 0xee, 0x9b, 0xf7, 0xde, 0xbc,
-	0xef, 0x37, 0xef, 0x09, 0x2e, 0x05, 0x87, 0xfd, 0x96, 0x15, 0x90, 0x16, 0x3e, 0xc2, 0x3e, 0x6b,
-	0x06, 0x21, 0x65, 0x14, 0xcd, 0x59, 0x01, 0x69, 0xdc, 0xec, 0x53, 0xda, 0xf7, 0x70, 0x4b, 0x80,
-	0xec, 0x78, 0xbf, 0xc5, 0xc8, 0x00, 0x47, 0xcc, 0x1a, 0x04, 0x12, 0xab, 0x31, 0x24, 0x7d, 0x2f,
-	0xc6, 0x31, 0x4e, 0x80, 0xd7, 0xc6, 0xa9, 0xf0, 0x20, 0x60, 0x27, 0xc9, 0xe1, 0xed, 0x3e, 0x61,
-	0xcf, 0x62, 0xbb, 0xe9, 0xd0, 0x41, 0xab, 0x4f, 0xfb, 0x74, 0x84, 0xc5, 0xbf, 0xc4, 0x87, 0xf8,
-	0x95, 0xa0, 0x5f, 0x4f, 0x78, 0xf1, 0x3b, 0x2c, 0xdf, 0xa7, 0xcc, 0x62, 0x84, 0xfa, 0x51, 0x72,
-	0xfa, 0xad, 0xc3, 0x7b, 0x51, 0x93, 0x50, 0x7e, 0x3a, 0xb0, 0x9c, 0x67, 0xc4, 0xc7, 0xe1, 0x49,
-	0x2b, 0x15, 0x29, 0xc4, 0x11, 0x8d, 0x43, 0x07, 0xb7, 0xfa, 0xd8, 0xc7, 0xa1, 0xc5, 0xb0, 0x2b,
-	0xa9, 0x8c, 0xdf, 0x6b, 0xb0, 0xdc, 0xa5, 0xf6, 0x5e, 0x6c, 0x0f, 0x08, 0x63, 0xd8, 0x6d, 0x73,
-	0xb5, 0xd1, 0x65, 0x28, 0x1e, 0x50, 0xbb, 0x47, 0x5c, 0x5d, 0x5b, 0xd7, 0x36, 0x2a, 0x66, 0xe1,
-	0x80, 0xda, 0x3b, 0x2e, 0xba, 0x0e, 0xc0, 0xc1, 0x11, 0x66, 0xfc, 0x28, 0x2f, 0x8e, 0xca, 0x07,
-	0xd4, 0xde, 0xc3, 0x6c, 0xc7, 0x45, 0x2b, 0x50, 0x10, 0x9a, 0xeb, 0x73, 0x92, 0x46, 0x7c, 0xa0,
-	0xb7, 0xa0, 0xe4, 0x84, 0x98, 0xdf, 0xa8, 0xcf, 0xaf, 0x6b, 0x1b, 0xd5, 0xcd, 0x46, 0x53, 0xaa,
-	0xd1, 0x4c, 0x95, 0x6d, 0x3e, 0x49, 0x0d, 0xb9, 0x55, 0xfe, 0xe4, 0x8b, 0x9b, 0xb9, 0x8f, 0xbe,
-	0xbc, 0xa9, 0x99, 0x29, 0x11, 0x5a, 0x87, 0xb9, 0x03, 0x6a, 0xeb, 0x05, 0x41, 0x5b, 0x6e, 0x5a,
-	0x01, 0x69, 0x76, 0xa9, 0xbd, 0x35, 0xcf, 0x31, 0x4d, 0x7e, 0x64, 0x7c, 0xac, 0x41, 0xad, 0x4b,
-	0xed, 0xc7, 0xfc, 0xba, 0x99, 0x93, 0xdf, 0xf8, 0x83, 0x06, 0xab, 0x5d, 0x6a, 0x3f, 0x88, 0x03,
-	0x8f, 0x38, 0x16, 0xc3, 0x0f, 0x69, 0xec, 0xcf, 0x9e, 0x95, 0x6f, 0xc1, 0x12, 0x0d, 0x49, 0x9f,
-	0xf8, 0x96, 0xd7, 0x4b, 0x64, 0x2a, 0x08, 0xfe, 0x8b, 0x29, 0xb8, 0xcb, 0x65, 0x33, 0x7e, 0x2d,
-	0x6d, 0xfd, 0x36, 0xb6, 0xa2, 0x19, 0x8c, 0x95, 0x1b, 0x00, 0x8e, 0x17, 0x47, 0x0c, 0x87, 0x23,
-	0x05, 0x2a, 0x09, 0x64, 0xc7, 0x35, 0x7e, 0x91, 0x87, 0xcb, 0xa9, 0xf0, 0x26, 0x66, 0x71, 0xe8,
-	0xbf, 0x72, 0x3a, 0xa0, 0x55, 0x28, 0x86, 0xd8, 0x8a, 0xa8, 0xaf, 0x17, 0xc5, 0x51, 0xf2, 0x85,
-	0x5e, 0x83, 0xc5, 0xc3, 0xd8, 0xc6, 0xa1, 0x8f, 0x19, 0x8e, 0x38, 0x65, 0x49, 0x1c, 0x2f, 0x8c,
-	0x80, 0x3b, 0x82, 0x77, 0x40, 0xdd, 0x9e, 0x1f, 0x0f, 0x6c, 0x1c, 0xea, 0xe5, 0x75, 0x6d, 0xa3,
-	0x60, 0x56, 0x02, 0xea, 0xfe, 0x40, 0x00, 0x8c, 0x5f, 0x6a, 0xb0, 0x92, 0xda, 0xa7, 0x7d, 0x1c,
-	0x90, 0x70, 0x06, 0xd3, 0xe9, 0x77, 0x79, 0x58, 0xea, 0x52, 0xfb, 0x11, 0xf6, 0x5d, 0xe2, 0xf7,
-	0x5f, 0x35, 0xef, 0x9d, 0xf2, 0x52, 0xf1, 0x5c, 0x2f, 0x95, 0xc6, 0xbc, 0x84, 0xae, 0x42, 0x59,
-	0x1c, 0x5b, 0x03, 0x2c, 0x5c, 0x58, 0x31, 0x4b, 0xfc, 0xd0, 0x1a, 0x60, 0xce, 0x3e, 0x3d, 0x8a,
-	0x02, 0xcb, 0xc1, 0x7a, 0x45, 0xb2, 0x4f, 0xce, 0x05, 0xcc, 0xf8, 0x5c, 0x5a, 0xd0, 0x8c, 0x7d,
-	0xff, 0xa2, 0x5a, 0xf0, 0x1a, 0x54, 0x7c, 0xea, 0x62, 0x69, 0x23, 0x99, 0x08, 0x65, 0x0e, 0x10,
-	0x46, 0x9a, 0x9e, 0x04, 0x19, 0xf3, 0x56, 0xce, 0x31, 0x2f, 0x4c, 0x30, 0xef, 0x87, 0xf3, 0x70,
-	0x89, 0xd7, 0x4a, 0xbf, 0x1f, 0xe2, 0x28, 0xda, 0xf1, 0xf7, 0xe9, 0xff, 0x4d, 0x3c, 0xc5, 0xc4,
-	0x70, 0x8e, 0x89, 0xab, 0xa7, 0x4d, 0x8c, 0xde, 0x85, 0x65, 0x22, 0xcd, 0xdb, 0xb3, 0x5c, 0x97,
-	0xff, 0xc5, 0x91, 0x5e, 0x59, 0x9f, 0xdb, 0xa8, 0x6e, 0x36, 0xd3, 0x07, 0xc2, 0xb8, 0xfd, 0x9b,
-	0x09, 0xe0, 0x7b, 0x29, 0x41, 0xdb, 0x67, 0xe1, 0x89, 0x59, 0x27, 0x63, 0xe0, 0xc6, 0x36, 0x5c,
-	0x9e, 0x88, 0x8a, 0xea, 0x30, 0x77, 0x88, 0x4f, 0x84, 0xf7, 0x0a, 0x26, 0xff, 0xc9, 0xbd, 0x73,
-	0x64, 0x79, 0x31, 0x4e, 0xdc, 0x26, 0x3f, 0xee, 0xe7, 0xef, 0x69, 0xc6, 0x5f, 0xf3, 0xa0, 0x77,
-	0xa9, 0xfd, 0xd4, 0xb7, 0x6c, 0x0f, 0x3f, 0xa1, 0x7b, 0xce, 0x33, 0xec, 0xc6, 0x1e, 0xfe, 0x9f,
-	0x6a, 0x36, 0x99, 0x08, 0x29, 0x4f, 0x8d, 0x90, 0xca, 0xbf, 0x39, 0x42, 0x8c, 0x2f, 0xe7, 0xc5,
-	0x33, 0xe5, 0xa1, 0x45, 0xbc, 0x8b, 0xd3, 0xe2, 0xdb, 0x00, 0xf8, 0x98, 0xb0, 0x9e, 0x43, 0x5d,
-	0x1c, 0xe9, 0x25, 0x11, 0xef, 0x46, 0x1a, 0xef, 0x8a, 0xaa, 0xcd, 0xf6, 0x31, 0x61, 0xdb, 0x1c,
-	0x49, 0x04, 0xee, 0x56, 0x5e, 0xd7, 0xcc, 0x0a, 0x4e, 0x61, 0xa7, 0x9d, 0x57, 0x3e, 0xcf, 0x79,
-	0x95, 0xa9, 0xce, 0x83, 0x69, 0xce, 0x5b, 0x3c, 0xc7, 0x79, 0xb5, 0x09, 0xe9, 0xbd, 0x0d, 0xc8,
-	0xa1, 0x3e, 0xb3, 0xf8, 0x04, 0xd3, 0x8b, 0x98, 0xc5, 0x62, 0x9e, 0xdf, 0x55, 0xa1, 0xef, 0x8a,
-	0xd0, 0x77, 0x3b, 0x3d, 0xde, 0x13, 0xa7, 0xe6, 0xb2, 0x93, 0x05, 0xe0, 0x08, 0xad, 0x43, 0xc1,
-	0xb1, 0xe2, 0x08, 0xeb, 0x0b, 0xeb, 0xda, 0x46, 0x6d, 0x13, 0x24, 0x1d, 0x87, 0x98, 0xf2, 0xa0,
-	0xf1, 0x26, 0xd4, 0xb2, 0x86, 0x52, 0x33, 0xbc, 0x32, 0x21, 0xc3, 0x0b, 0x6a, 0x86, 0x7f, 0x91,
-	0x4f, 0xe6, 0x26, 0xc7, 0xc1, 0xd8, 0x7d, 0xf5, 0x82, 0x6c, 0xe6, 0xfb, 0xe8, 0xaf, 0x8a, 0xa2,
-	0x8f, 0x3e, 0x65, 0xc4, 0x23, 0x91, 0x18, 0x74, 0x2f, 0xa4, 0x89, 0x29, 0x5c, 0xde, 0xb5, 0x8e,
-	0xcd, 0x64, 0x3c, 0x8f, 0x1e, 0xd2, 0xf0, 0x11, 0x0e, 0x09, 0x75, 0x93, 0xfc, 0xbe, 0x9b, 0xe6,
-	0xf7, 0xb8, 0x1d, 0x9a, 0x13, 0xa9, 0x64, 0xc2, 0xcb, 0xd9, 0x78, 0x32, 0xdf, 0xff, 0x66, 0x59,
-	0x46, 0x3e, 0xac, 0x32, 0xca, 0x2c, 0xaf, 0xe7, 0xc4, 0x83, 0xd8, 0xb3, 0x18, 0x39, 0xc2, 0xbd,
-	0x38, 0xb2, 0xfa, 0x3c, 0x4b, 0xb9, 0xb6, 0x9b, 0x67, 0x6a, 0xfb, 0x84, 0x93, 0x6d, 0x0f, 0xa9,
-	0x9e, 0x72, 0x22, 0x55, 0xd9, 0x15, 0x36, 0x01, 0xa1, 0x71, 0x0c, 0x8d, 0xb3, 0xcd, 0x34, 0x21,
-	0xdd, 0x1f, 0xa8, 0xe9, 0xce, 0x1f, 0x13, 0x72, 0xa5, 0xd2, 0x54, 0x57, 0x2a, 0xcd, 0xe0, 0xb0,
-	0x2f, 0xc4, 0x4c, 0x57, 0x2a, 0xcd, 0xc7, 0xb1, 0xe5, 0x33, 0xc2, 0x4e, 0x94, 0xf2, 0xd0, 0x78,
-	0x0e, 0x57, 0xcf, 0x14, 0xf9, 0x3f, 0x79, 0xb1, 0xf1, 0x95, 0x5c, 0x37, 0x98, 0x38, 0x08, 0x09,
-	0x0d, 0x09, 0x23, 0x3f, 0x9a, 0xc5, 0x47, 0xfe, 0xd7, 0x60, 0xc1, 0xc7, 0xcf, 0x7b, 0x89, 0x8c,
-	0x27, 0x22, 0x77, 0x34, 0xb3, 0xea, 0xe3, 0xe7, 0x8f, 0x12, 0x10, 0xba, 0x0e, 0x95, 0x10, 0xbf,
-	0x17, 0xe3, 0x88, 0xd1, 0x30, 0xc9, 0x9c, 0x11, 0xc0, 0x78, 0xa9, 0x89, 0x51, 0x5e, 0x51, 0x73,
-	0x06, 0x4b, 0xf0, 0xbf, 0xac, 0xe5, 0x6f, 0x35, 0x40, 0x5d, 0x6a, 0x6f, 0x5b, 0xbe, 0x83, 0x3d,
-	0x6f, 0x16, 0x1d, 0x99, 0x91, 0xbf, 0x30, 0x2e, 0xff, 0x6f, 0xe4, 0x72, 0x31, 0x91, 0x7f, 0x06,
-	0x3d, 0x34, 0x5d, 0xfc, 0x3f, 0xe5, 0x85, 0xf9, 0x9f, 0xe0, 0x70, 0x40, 0x7c, 0x8b, 0x5d, 0xd0,
-	0x26, 0xff, 0x0f, 0xac, 0x1b, 0xfe, 0x89, 0x3e, 0xae, 0xbc, 0x66, 0xcb, 0xea, 0x6b, 0xd6, 0xf8,
-	0x5c, 0x13, 0x6b, 0x88, 0xa7, 0x81, 0x3b, 0x93, 0x96, 0x9d, 0x1a, 0x19, 0xe9, 0x52, 0xba, 0x78,
-	0xf6, 0x52, 0xfa, 0x6f, 0x65, 0x58, 0x10, 0x4a, 0xed, 0xe2, 0x88, 0x57, 0x7e, 0xf4, 0x06, 0x54,
-	0xa2, 0x74, 0xc9, 0x2e, 0xd4, 0xab, 0x6e, 0xae, 0xa6, 0x84, 0xd9, 0xed, 0x7b, 0x27, 0x67, 0x8e,
-	0x50, 0xd1, 0x6d, 0x28, 0x0a, 0x8d, 0xdc, 0xa4, 0x37, 0x5c, 0x4a, 0x89, 0x94, 0x7d, 0x77, 0x27,
-	0x67, 0x26, 0x48, 0xe8, 0x21, 0x2c, 0xb9, 0xe9, 0xaa, 0xb9, 0xb7, 0x4f, 0x63, 0xdf, 0xd5, 0xeb,
-	0x82, 0xee, 0x5a, 0x4a, 0x37, 0x61, 0x13, 0xdd, 0xc9, 0x99, 0x35, 0x37, 0x03, 0xe6, 0xd7, 0x7a,
-	0x62, 0xc9, 0x2b, 0xcc, 0xaa, 0x5c, 0xab, 0xac, 0x7e, 0xf9, 0xb5, 0x12, 0x09, 0x6d, 0x43, 0x4d,
-	0xfc, 0xea, 0x85, 0xc9, 0x5e, 0x75, 0x68, 0x75, 0x95, 0x2c, 0xb3, 0x74, 0xed, 0xe4, 0xcc, 0x45,
-	0x4f, 0x85, 0xa2, 0xef, 0x82, 0x04, 0xf4, 0xb0, 0x5c, 0x3e, 0x26, 0x4b, 0xff, 0xab, 0x19, 0x1e,
-	0xea, 0x62, 0xb2, 0x93, 0x33, 0x17, 0x3c, 0x05, 0x88, 0xee, 0x40, 0x29, 0x90, 0x9b, 0xc1, 0xc4,
-	0x37, 0x2b, 0x29, 0xad, 0xba, 0x30, 0xec, 0xe4, 0xcc, 0x14, 0x8d, 0x53, 0x84, 0x72, 0x13, 0x26,
-	0x42, 0x5f, 0xa1, 0x50, 0x17, 0x64, 0x9c, 0x22, 0x41, 0x43, 0xbb, 0x80, 0x62, 0x31, 0xd7, 0xf7,
-	0x18, 0xed, 0x45, 0xc9, 0x64, 0x2f, 0x82, 0xbb, 0xba, 0x79, 0x63, 0xf8, 0x80, 0x99, 0x34, 0xf9,
-	0x77, 0x72, 0x66, 0x3d, 0x1e, 0x3b, 0xe0, 0x86, 0xde, 0x17, 0xb3, 0x9b, 0xc8, 0x2e, 0xc5, 0xd0,
-	0xca, 0x44, 0xc7, 0x0d, 0x2d, 0x91, 0x64, 0x18, 0x25, 0x33, 0x87, 0xc8, 0xb7, 0x4c, 0x18, 0xa9,
-	0xc3, 0x88, 0x0c, 0xa3, 0x04, 0x82, 0xb6, 0x60, 0x31, 0x54, 0x9b, 0xa5, 0x78, 0x9f, 0x29, 0xfe,
-	0x39, 0xdd, 0x49, 0xb9, 0x7f, 0x32, 0x24, 0xe8, 0xdb, 0x00, 0xce, 0xb0, 0x15, 0x89, 0xc1, 0xaa,
-	0xba, 0x79, 0x25, 0x65, 0x30, 0xd6, 0xa4, 0x3a, 0x39, 0x53, 0x41, 0xe6, 0x62, 0x3b, 0x69, 0x17,
-	0x10, 0x43, 0xa1, 0x22, 0x76, 0xb6, 0x3d, 0x70, 0xb1, 0x87, 0xa8, 0xfc, 0x4a, 0x36, 0x2c, 0xbf,
-	0x62, 0x5a, 0x54, 0xae, 0x1c, 0x2b, 0xcc, 0xfc, 0xca, 0x11, 0x32, 0x7a, 0x13, 0xaa, 0xf1, 0xe8,
-	0x19, 0xa9, 0x2f, 0x09, 0x5a, 0xfd, 0xac, 0x17, 0x66, 0x27, 0x67, 0xaa, 0xe8, 0xe8, 0x3b, 0xb0,
-	0x90, 0xee, 0x98, 0x88, 0xbf, 0x4f, 0xf5, 0xe5, 0x2c, 0xf9, 0xf8, 0x7a, 0x89, 0x93, 0x93, 0x11,
-	0x0c, 0xb5, 0xa1, 0x16, 0x66, 0x9e, 0x60, 0x3a, 0xca, 0x66, 0xe1, 0x84, 0x07, 0x1a, 0xcf, 0xc2,
-	0x2c, 0x11, 0x8f, 0xce, 0x58, 0x16, 0x48, 0xfd, 0x52, 0x36, 0x3a, 0xd5, 0xba, 0xc9, 0xa3, 0x33,
-	0x41, 0xdb, 0x2a, 0x43, 0x51, 0xfc, 0xe7, 0x32, 0x32, 0x7e, 0xa6, 0xc1, 0xd2, 0xd8, 0xa0, 0x8c,
-	0x10, 0xcc, 0x8b, 0x42, 0x2e, 0xcb, 0xab, 0xf8, 0x8d, 0x1a, 0x50, 0x4e, 0x97, 0x03, 0xc9, 0x98,
-	0x3b, 0xfc, 0x46, 0x3a, 0x94, 0x06, 0xb2, 0x7e, 0x25, 0xd5, 0x35, 0xfd, 0x54, 0xca, 0xfa, 0x7c,
-	0x66, 0x49, 0x31, 0x9c, 0xbb, 0x0b, 0x67, 0xcc, 0xdd, 0xc6, 0x1b, 0x50, 0x11, 0x52, 0xbf, 0x4d,
-	0x22, 0x86, 0xbe, 0x9e, 0x8a, 0xab, 0x6b, 0x62, 0x02, 0x58, 0x16, 0xf8, 0x6a, 0xe1, 0x34, 0x53,
-	0x7d, 0x1e, 0x03, 0x12, 0xf0, 0x3d, 0x16, 0x62, 0x6b, 0x90, 0x96, 0xd5, 0x1a, 0xe4, 0x87, 0xed,
-	0x22, 0x4f, 0x5c, 0xf4, 0x8d, 0x91, 0xc4, 0xb2, 0x5e, 0x4e, 0xe0, 0x98, 0x62, 0x18, 0x1f, 0x6b,
-	0xb0, 0xd8, 0x15, 0x7d, 0xc4, 0x94, 0xa5, 0xfd, 0x14, 0xbb, 0x15, 0x28, 0x3c, 0xb7, 0x98, 0xf3,
-	0x4c, 0x30, 0x2b, 0x9b, 0xf2, 0x03, 0xdd, 0x82, 0xa5, 0xfd, 0x90, 0x0e, 0x7a, 0x09, 0x1f, 0xde,
-	0x95, 0xa4, 0x79, 0x16, 0x39, 0x38, 0xb9, 0x46, 0x6d, 0x4d, 0xf3, 0x6a, 0x6b, 0xba, 0x05, 0x35,
-	0x1c, 0x86, 0x34, 0xdc, 0xd9, 0xdf, 0x25, 0x51, 0xc4, 0x63, 0xa3, 0x20, 0x98, 0x8f, 0x41, 0x8d,
-	0x77, 0x61, 0xe1, 0x87, 0xfc, 0xba, 0x54, 0xb6, 0x21, 0x37, 0x4d, 0xe5, 0x36, 0xbd, 0x39, 0x5e,
-	0x81, 0x92, 0x90, 0x74, 0x28, 0x61, 0x91, 0x7f, 0xee, 0xb8, 0xaf, 0xbf, 0x05, 0x05, 0xe1, 0x15,
-	0x54, 0x81, 0x42, 0x9b, 0xdf, 0x5b, 0xcf, 0xa1, 0x2a, 0x94, 0xda, 0x47, 0xc4, 0x61, 0xd8, 0xad,
-	0x6b, 0xa8, 0x04, 0x73, 0xef, 0xbc, 0xb3, 0x5b, 0xcf, 0xa3, 0x15, 0xa8, 0x3f, 0xc0, 0x96, 0xeb,
-	0x11, 0x1f, 0xb7, 0x8f, 0x65, 0x35, 0xa9, 0xcf, 0x6d, 0xfe, 0x3c, 0x0f, 0x05, 0xd9, 0xb4, 0xef,
-	0x41, 0xcd, 0xc4, 0x01, 0x0d, 0xd9, 0x6e, 0xec, 0x31, 0x12, 0x78, 0x18, 0xd5, 0x46, 0x26, 0xe7,
-	0x4e, 0x6e, 0xac, 0x9e, 0x6a, 0xbd, 0xed, 0x41, 0xc0, 0x4e, 0xd0, 0x5d, 0x28, 0x4a, 0x4a, 0x74,
-	0xda, 0x49, 0x67, 0x12, 0x61, 0x58, 0xfa, 0x3e, 0x66, 0xd2, 0x6b, 0x82, 0x20, 0x42, 0x68, 0x58,
-	0x00, 0x87, 0x8e, 0x6c, 0x5c, 0x19, 0x71, 0xcc, 0x04, 0x8c, 0xf1, 0xda, 0x87, 0x7f, 0xfc, 0xea,
-	0xa7, 0xf9, 0x1b, 0x86, 0xde, 0x3a, 0xfa, 0x66, 0xeb, 0x80, 0xda, 0xb7, 0x23, 0xcc, 0x5a, 0xef,
-	0x0b, 0x5b, 0x7e, 0xd0, 0x7a, 0x9f, 0xb8, 0x1f, 0xdc, 0xd7, 0x5e, 0xbf, 0xa3, 0xa1, 0xfb, 0x50,
-	0x10, 0xc6, 0x4f, 0x44, 0x53, 0x1d, 0x71, 0x36, 0xef, 0xb9, 0x9f, 0xe4, 0xb5, 0x3b, 0xda, 0xd6,
-	0xfa, 0x67, 0x7f, 0x59, 0xcb, 0xfd, 0xf8, 0xc5, 0x9a, 0xf6, 0xc9, 0x8b, 0x35, 0xed, 0xd3, 0x17,
-	0x6b, 0xda, 0x9f, 0x5f, 0xac, 0x69, 0x1f, 0xbd, 0x5c, 0xcb, 0x7d, 0xfa, 0x72, 0x2d, 0xf7, 0xd9,
-	0xcb, 0xb5, 0x9c, 0x5d, 0x14, 0x4a, 0xdd, 0xfd, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xf2,
-	0x70, 0x77, 0x6e, 0x20, 0x00, 0x00,
+	// 1959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0x5d, 0x6f, 0x1c, 0x57,
+	0x75, 0x67, 0xed, 0xfd, 0x98, 0xb3, 0xf6, 0x7a, 0x73, 0x63, 0x3b, 0x93, 0x4d, 0xe2, 0x98, 0xa9,
+	0x54, 0x99, 0xa2, 0xec, 0x06, 0x07, 0x55, 0x21, 0x2a, 0x08, 0xec, 0x6c, 0xb0, 0x57, 0x35, 0x4d,
+	0xc6, 0xb1, 0x78, 0xe8, 0xc3, 0x6a, 0x3e, 0xae, 0xd7, 0xd7, 0x9e, 0x9d, 0x3b, 0x9d, 0xb9, 0xe3,
+	0xd8, 0x54, 0x95, 0x50, 0x9f, 0x78, 0xac, 0xc4, 0x13, 0x42, 0x3c, 0xf0, 0x1f, 0x10, 0x0f, 0x08,
+	0x04, 0x8f, 0x95, 0x78, 0xa9, 0xc4, 0x4b, 0x85, 0xaa, 0x16, 0x92, 0xfe, 0x0d, 0x04, 0xba, 0x1f,
+	0xb3, 0x3b, 0xb3, 0x5e, 0xdb, 0x02, 0x81, 0xb0, 0x4d, 0x9f, 0xec, 0x39, 0xf7, 0x9c, 0x73, 0xcf,
+	0xf7, 0xb9, 0xe7, 0x2c, 0x5c, 0x0f, 0x0f, 0xfa, 0x6d, 0x3b, 0x24, 0x6d, 0x7c, 0x88, 0x03, 0xd6,
+	0x0a, 0x23, 0xca, 0x28, 0x9a, 0xb2, 0x43, 0xd2, 0xbc, 0xdb, 0xa7, 0xb4, 0xef, 0xe3, 0xb6, 0x00,
+	0x39, 0xc9, 0x6e, 0x9b, 0x91, 0x01, 0x8e, 0x99, 0x3d, 0x08, 0x25, 0x56, 0x73, 0x48, 0xfa, 0x5e,
+	0x82, 0x13, 0xac, 0x80, 0xb7, 0xc6, 0xa9, 0xf0, 0x20, 0x64, 0xc7, 0xea, 0xf0, 0x5e, 0x9f, 0xb0,
+	0xbd, 0xc4, 0x69, 0xb9, 0x74, 0xd0, 0xee, 0xd3, 0x3e, 0x1d, 0x61, 0xf1, 0x2f, 0xf1, 0x21, 0xfe,
+	0x53, 0xe8, 0xb7, 0x15, 0x2f, 0x7e, 0x87, 0x1d, 0x04, 0x94, 0xd9, 0x8c, 0xd0, 0x20, 0x56, 0xa7,
+	0xdf, 0x3a, 0x78, 0x18, 0xb7, 0x08, 0xe5, 0xa7, 0x03, 0xdb, 0xdd, 0x23, 0x01, 0x8e, 0x8e, 0xdb,
+	0xa9, 0x48, 0x11, 0x8e, 0x69, 0x12, 0xb9, 0xb8, 0xdd, 0xc7, 0x01, 0x8e, 0x6c, 0x86, 0x3d, 0x49,
+	0x65, 0xfe, 0x51, 0x83, 0x6b, 0x5d, 0xea, 0x6c, 0x27, 0xce, 0x80, 0x30, 0x86, 0xbd, 0x0e, 0x57,
+	0x1b, 0x2d, 0x40, 0x79, 0x9f, 0x3a, 0x3d, 0xe2, 0x19, 0xda, 0xb2, 0xb6, 0xa2, 0x5b, 0xa5, 0x7d,
+	0xea, 0x6c, 0x7a, 0xe8, 0x36, 0x00, 0x07, 0xc7, 0x98, 0xf1, 0xa3, 0xa2, 0x38, 0xaa, 0xee, 0x53,
+	0x67, 0x1b, 0xb3, 0x4d, 0x0f, 0xcd, 0x43, 0x49, 0x68, 0x6e, 0x4c, 0x49, 0x1a, 0xf1, 0x81, 0xbe,
+	0x0b, 0x15, 0x37, 0xc2, 0xfc, 0x46, 0x63, 0x7a, 0x59, 0x5b, 0xa9, 0xad, 0x36, 0x5b, 0x52, 0x8d,
+	0x56, 0xaa, 0x6c, 0xeb, 0x79, 0x6a, 0xc8, 0xb5, 0xea, 0xc7, 0x9f, 0xdf, 0x2d, 0x7c, 0xf4, 0xc5,
+	0x5d, 0xcd, 0x4a, 0x89, 0xd0, 0x32, 0x4c, 0xed, 0x53, 0xc7, 0x28, 0x09, 0xda, 0x6a, 0xcb, 0x0e,
+	0x49, 0xab, 0x4b, 0x9d, 0xb5, 0x69, 0x8e, 0x69, 0xf1, 0x23, 0xf3, 0x17, 0x1a, 0xd4, 0xbb, 0xd4,
+	0x79, 0xc6, 0xaf, 0xbb, 0x70, 0xf2, 0x9b, 0x7f, 0xd2, 0x60, 0xb1, 0x4b, 0x9d, 0xc7, 0x49, 0xe8,
+	0x13, 0xd7, 0x66, 0xf8, 0x09, 0x4d, 0x82, 0x8b, 0x67, 0xe5, 0xd7, 0x61, 0x8e, 0x46, 0xa4, 0x4f,
+	0x02, 0xdb, 0xef, 0x29, 0x99, 0x4a, 0x82, 0xff, 0x6c, 0x0a, 0xee, 0x72, 0xd9, 0xcc, 0xdf, 0x4a,
+	0x5b, 0xbf, 0x8d, 0xed, 0xf8, 0x02, 0xc6, 0xca, 0x1d, 0x00, 0xd7, 0x4f, 0x62, 0x86, 0xa3, 0x91,
+	0x02, 0xba, 0x82, 0x6c, 0x7a, 0xe6, 0x2f, 0x8b, 0xb0, 0x90, 0x0a, 0x6f, 0x61, 0x96, 0x44, 0xc1,
+	0xa5, 0xd3, 0x01, 0x2d, 0x42, 0x39, 0xc2, 0x76, 0x4c, 0x03, 0xa3, 0x2c, 0x8e, 0xd4, 0x17, 0x7a,
+	0x0d, 0x66, 0x0f, 0x12, 0x07, 0x47, 0x01, 0x66, 0x38, 0xe6, 0x94, 0x15, 0x71, 0x3c, 0x33, 0x02,
+	0x6e, 0x0a, 0xde, 0x21, 0xf5, 0x7a, 0x41, 0x32, 0x70, 0x70, 0x64, 0x54, 0x97, 0xb5, 0x95, 0x92,
+	0xa5, 0x87, 0xd4, 0xfb, 0xa1, 0x00, 0x98, 0xbf, 0xd2, 0x60, 0x3e, 0xb5, 0x4f, 0xe7, 0x28, 0x24,
+	0xd1, 0x05, 0x4c, 0xa7, 0x3f, 0x14, 0x61, 0xae, 0x4b, 0x9d, 0xa7, 0x38, 0xf0, 0x48, 0xd0, 0xbf,
+	0x6c, 0xde, 0x3b, 0xe1, 0xa5, 0xf2, 0xb9, 0x5e, 0xaa, 0x8c, 0x79, 0x09, 0xdd, 0x84, 0xaa, 0x38,
+	0xb6, 0x07, 0x58, 0xb8, 0x50, 0xb7, 0x2a, 0xfc, 0xd0, 0x1e, 0x60, 0xce, 0x3e, 0x3d, 0x8a, 0x43,
+	0xdb, 0xc5, 0x86, 0x2e, 0xd9, 0xab, 0x73, 0x01, 0x33, 0x3f, 0x93, 0x16, 0xb4, 0x92, 0x20, 0xb8,
+	0xaa, 0x16, 0xbc, 0x05, 0x7a, 0x40, 0x3d, 0x2c, 0x6d, 0x24, 0x13, 0xa1, 0xca, 0x01, 0xc2, 0x48,
+	0x67, 0x27, 0x41, 0xce, 0xbc, 0xfa, 0x39, 0xe6, 0x85, 0x09, 0xe6, 0xfd, 0x70, 0x1a, 0xae, 0xf3,
+	0x5a, 0x19, 0xf4, 0x23, 0x1c, 0xc7, 0x9b, 0xc1, 0x2e, 0xfd, 0xca, 0xc4, 0x67, 0x98, 0x18, 0xce,
+	0x31, 0x71, 0xed, 0xa4, 0x89, 0xd1, 0xbb, 0x70, 0x8d, 0x48, 0xf3, 0xf6, 0x6c, 0xcf, 0xe3, 0x7f,
+	0x71, 0x6c, 0xe8, 0xcb, 0x53, 0x2b, 0xb5, 0xd5, 0x56, 0xfa, 0x40, 0x18, 0xb7, 0x7f, 0x4b, 0x01,
+	0xbe, 0x9f, 0x12, 0x74, 0x02, 0x16, 0x1d, 0x5b, 0x0d, 0x32, 0x06, 0x6e, 0xae, 0xc3, 0xc2, 0x44,
+	0x54, 0xd4, 0x80, 0xa9, 0x03, 0x7c, 0x2c, 0xbc, 0x57, 0xb2, 0xf8, 0xbf, 0xdc, 0x3b, 0x87, 0xb6,
+	0x9f, 0x60, 0xe5, 0x36, 0xf9, 0xf1, 0xa8, 0xf8, 0x50, 0x33, 0xff, 0x5e, 0x04, 0xa3, 0x4b, 0x9d,
+	0x9d, 0xc0, 0x76, 0x7c, 0xfc, 0x9c, 0x6e, 0xbb, 0x7b, 0xd8, 0x4b, 0x7c, 0xfc, 0x7f, 0xd5, 0x6c,
+	0x72, 0x11, 0x52, 0x3d, 0x33, 0x42, 0xf4, 0xff, 0x70, 0x84, 0x98, 0x5f, 0x4c, 0x8b, 0x67, 0xca,
+	0x13, 0x9b, 0xf8, 0x57, 0xa7, 0xc5, 0x77, 0x00, 0xf0, 0x11, 0x61, 0x3d, 0x97, 0x7a, 0x38, 0x36,
+	0x2a, 0x22, 0xde, 0xcd, 0x34, 0xde, 0x33, 0xaa, 0xb6, 0x3a, 0x47, 0x84, 0xad, 0x53, 0x4f, 0x05,
+	0xee, 0x5a, 0xd1, 0xd0, 0x2c, 0x1d, 0xa7, 0xb0, 0x93, 0xce, 0xab, 0x9e, 0xe7, 0x3c, 0xfd, 0x4c,
+	0xe7, 0xc1, 0x59, 0xce, 0x9b, 0x3d, 0xc7, 0x79, 0xf5, 0x09, 0xe9, 0xbd, 0x0e, 0xc8, 0xa5, 0x01,
+	0xb3, 0xf9, 0x04, 0xd3, 0x8b, 0x99, 0xcd, 0x12, 0x9e, 0xdf, 0x35, 0xa1, 0xef, 0xbc, 0xd0, 0x77,
+	0x3d, 0x3d, 0xde, 0x16, 0xa7, 0xd6, 0x35, 0x37, 0x0f, 0xc0, 0x31, 0x5a, 0x86, 0x92, 0x6b, 0x27,
+	0x31, 0x36, 0x66, 0x96, 0xb5, 0x95, 0xfa, 0x2a, 0x48, 0x3a, 0x0e, 0xb1, 0xe4, 0x41, 0xf3, 0x2d,
+	0xa8, 0xe7, 0x0d, 0x95, 0xcd, 0x70, 0x7d, 0x42, 0x86, 0x97, 0xb2, 0x19, 0xfe, 0x79, 0x51, 0xcd,
+	0x4d, 0xae, 0x8b, 0xb1, 0x77, 0xf9, 0x82, 0xec, 0xc2, 0xf7, 0xd1, 0x5f, 0x97, 0x45, 0x1f, 0xdd,
+	0x61, 0xc4, 0x27, 0xb1, 0x18, 0x74, 0xaf, 0xa4, 0x89, 0x29, 0x2c, 0x6c, 0xd9, 0x47, 0x96, 0x1a,
+	0xcf, 0xe3, 0x27, 0x34, 0x7a, 0x8a, 0x23, 0x42, 0x3d, 0x95, 0xdf, 0x0f, 0xd2, 0xfc, 0x1e, 0xb7,
+	0x43, 0x6b, 0x22, 0x95, 0x4c, 0x78, 0x39, 0x1b, 0x4f, 0xe6, 0xfb, 0xbf, 0x2c, 0xcb, 0x28, 0x80,
+	0x45, 0x46, 0x99, 0xed, 0xf7, 0xdc, 0x64, 0x90, 0xf8, 0x36, 0x23, 0x87, 0xb8, 0x97, 0xc4, 0x76,
+	0x9f, 0x67, 0x29, 0xd7, 0x76, 0xf5, 0x54, 0x6d, 0x9f, 0x73, 0xb2, 0xf5, 0x21, 0xd5, 0x0e, 0x27,
+	0xca, 0x2a, 0x3b, 0xcf, 0x26, 0x20, 0x34, 0x8f, 0xa0, 0x79, 0xba, 0x99, 0x26, 0xa4, 0xfb, 0xe3,
+	0x6c, 0xba, 0xf3, 0xc7, 0x84, 0x5c, 0xa9, 0xb4, 0xb2, 0x2b, 0x95, 0x56, 0x78, 0xd0, 0x17, 0x62,
+	0xa6, 0x2b, 0x95, 0xd6, 0xb3, 0xc4, 0x0e, 0x18, 0x61, 0xc7, 0x99, 0xf2, 0xd0, 0x7c, 0x01, 0x37,
+	0x4f, 0x15, 0xf9, 0xbf, 0x79, 0xb1, 0xf9, 0xa5, 0x5c, 0x37, 0x58, 0x38, 0x8c, 0x08, 0x8d, 0x08,
+	0x23, 0x3f, 0xbe, 0x88, 0x8f, 0xfc, 0xaf, 0xc1, 0x4c, 0x80, 0x5f, 0xf4, 0x94, 0x8c, 0xc7, 0x22,
+	0x77, 0x34, 0xab, 0x16, 0xe0, 0x17, 0x4f, 0x15, 0x08, 0xdd, 0x06, 0x3d, 0xc2, 0xef, 0x25, 0x38,
+	0x66, 0x34, 0x52, 0x99, 0x33, 0x02, 0x98, 0xaf, 0x34, 0x58, 0xc8, 0xab, 0x89, 0xbd, 0xab, 0xa7,
+	0xe5, 0xef, 0x35, 0x40, 0x5d, 0xea, 0xac, 0xdb, 0x81, 0x8b, 0x7d, 0xff, 0x22, 0x3a, 0x32, 0x27,
+	0x7f, 0x69, 0x5c, 0xfe, 0xdf, 0xc9, 0xe5, 0xa2, 0x92, 0x1f, 0x7b, 0x97, 0x4c, 0xfc, 0xbf, 0x14,
+	0x85, 0xf9, 0x9f, 0xe3, 0x68, 0x40, 0x02, 0x9b, 0x5d, 0xd1, 0x26, 0xff, 0x2f, 0xac, 0x1b, 0xfe,
+	0x8d, 0x3e, 0x9e, 0x79, 0xcd, 0x56, 0xb3, 0xaf, 0x59, 0xf3, 0x33, 0x4d, 0xac, 0x21, 0x76, 0x42,
+	0xcf, 0x66, 0x97, 0x2d, 0x32, 0xd2, 0xa5, 0x74, 0xf9, 0xf4, 0xa5, 0xf4, 0x3f, 0xaa, 0x30, 0x23,
+	0x94, 0xda, 0xc2, 0x31, 0xaf, 0xfc, 0xe8, 0x4d, 0xd0, 0xe3, 0x74, 0xc9, 0x2e, 0xd4, 0xab, 0xad,
+	0x2e, 0xa6, 0x84, 0xf9, 0xed, 0xfb, 0x46, 0xc1, 0x1a, 0xa1, 0xa2, 0x7b, 0x50, 0x16, 0x1a, 0x79,
+	0xaa, 0x37, 0x5c, 0x4f, 0x89, 0x32, 0xfb, 0xee, 0x8d, 0x82, 0xa5, 0x90, 0xd0, 0x13, 0x98, 0xf3,
+	0xd2, 0x55, 0x73, 0x6f, 0x97, 0xef, 0x9a, 0x8d, 0x86, 0xa0, 0xbb, 0x95, 0xd2, 0x4d, 0xd8, 0x44,
+	0x6f, 0x14, 0xac, 0xba, 0x97, 0x03, 0xf3, 0x6b, 0x7d, 0xb1, 0xe4, 0x35, 0xa6, 0xf2, 0xd7, 0x66,
+	0x56, 0xbf, 0xfc, 0x5a, 0x89, 0x84, 0xd6, 0xa1, 0x2e, 0xfe, 0xeb, 0x45, 0x6a, 0xaf, 0x3a, 0xb4,
+	0x7a, 0x96, 0x2c, 0xb7, 0x74, 0xdd, 0x28, 0x58, 0xb3, 0x7e, 0x16, 0x8a, 0xbe, 0x07, 0x12, 0xd0,
+	0xc3, 0x72, 0xf9, 0xa8, 0x96, 0xfe, 0x37, 0x73, 0x3c, 0xb2, 0x8b, 0xc9, 0x8d, 0x82, 0x35, 0xe3,
+	0x67, 0x80, 0xe8, 0x3e, 0x54, 0x42, 0xb9, 0x19, 0x54, 0xbe, 0x99, 0x4f, 0x69, 0xb3, 0x0b, 0xc3,
+	0x8d, 0x82, 0x95, 0xa2, 0x71, 0x8a, 0x48, 0x6e, 0xc2, 0x8c, 0x4a, 0x9e, 0x22, 0xbb, 0x20, 0xe3,
+	0x14, 0x0a, 0x0d, 0x6d, 0x01, 0x4a, 0xc4, 0x5c, 0xdf, 0x63, 0xb4, 0x17, 0xab, 0xc9, 0x5e, 0x04,
+	0x77, 0x6d, 0xf5, 0xce, 0xf0, 0x01, 0x33, 0x69, 0xf2, 0xdf, 0x28, 0x58, 0x8d, 0x64, 0xec, 0x80,
+	0x1b, 0x7a, 0x57, 0xcc, 0x6e, 0x86, 0x9e, 0x37, 0x74, 0x66, 0xa2, 0xe3, 0x86, 0x96, 0x48, 0x32,
+	0x8c, 0xd4, 0xcc, 0x61, 0xc0, 0x78, 0x18, 0x65, 0x87, 0x11, 0x19, 0x46, 0x0a, 0x82, 0xd6, 0x60,
+	0x36, 0xca, 0x36, 0x4b, 0xa3, 0x96, 0xf7, 0xcf, 0xc9, 0x4e, 0xca, 0xfd, 0x93, 0x23, 0x41, 0xdf,
+	0x06, 0x70, 0x87, 0xad, 0x48, 0x0c, 0x56, 0xb5, 0xd5, 0x1b, 0x29, 0x83, 0xb1, 0x26, 0xb5, 0x51,
+	0xb0, 0x32, 0xc8, 0x5c, 0x6c, 0x37, 0xed, 0x02, 0xc6, 0x6c, 0x5e, 0xec, 0x7c, 0x7b, 0xe0, 0x62,
+	0x0f, 0x51, 0xf9, 0x95, 0x6c, 0x58, 0x7e, 0x8d, 0x7a, 0xfe, 0xca, 0xb1, 0xc2, 0xcc, 0xaf, 0x1c,
+	0x21, 0xa3, 0xb7, 0xa0, 0x96, 0x8c, 0x9e, 0x91, 0xc6, 0x9c, 0xa0, 0x35, 0x4e, 0x7b, 0x61, 0x6e,
+	0x14, 0xac, 0x2c, 0x3a, 0xfa, 0x0e, 0xcc, 0xa4, 0x3b, 0x26, 0x12, 0xec, 0x52, 0xe3, 0x5a, 0x9e,
+	0x7c, 0x7c, 0xbd, 0xc4, 0xc9, 0xc9, 0x08, 0x86, 0x3a, 0x50, 0x8f, 0x72, 0x4f, 0x30, 0x03, 0xe5,
+	0xb3, 0x70, 0xc2, 0x03, 0x8d, 0x67, 0x61, 0x9e, 0x88, 0x47, 0x67, 0x22, 0x0b, 0xa4, 0x71, 0x3d,
+	0x1f, 0x9d, 0xd9, 0xba, 0xc9, 0xa3, 0x53, 0xa1, 0xad, 0x55, 0xa1, 0x2c, 0x7e, 0xb9, 0x8c, 0xcd,
+	0xdf, 0x68, 0x30, 0x37, 0x36, 0x28, 0x23, 0x04, 0xd3, 0xa2, 0x90, 0xcb, 0xf2, 0x2a, 0xfe, 0x47,
+	0x4d, 0xa8, 0xa6, 0xcb, 0x01, 0x35, 0xe6, 0x0e, 0xbf, 0x91, 0x01, 0x95, 0x81, 0xac, 0x5f, 0xaa,
+	0xba, 0xa6, 0x9f, 0x99, 0xb2, 0x3e, 0x9d, 0x5b, 0x52, 0x0c, 0xe7, 0xee, 0xd2, 0x29, 0x73, 0x37,
+	0xba, 0x0b, 0x35, 0x9f, 0xf6, 0x7b, 0xf8, 0xc8, 0xc5, 0x51, 0xc8, 0x54, 0x4b, 0x02, 0x9f, 0xf6,
+	0x3b, 0x12, 0x62, 0xbe, 0x09, 0xba, 0x50, 0xeb, 0x6d, 0x12, 0x33, 0xf4, 0xf5, 0x54, 0x1f, 0x43,
+	0x13, 0x23, 0xc2, 0x35, 0xc1, 0x30, 0x5b, 0x59, 0xad, 0x54, 0xe1, 0x67, 0x80, 0x04, 0x7c, 0x9b,
+	0x45, 0xd8, 0x1e, 0xa8, 0x53, 0x54, 0x87, 0xe2, 0xb0, 0x9f, 0x14, 0x89, 0x87, 0xbe, 0x31, 0x52,
+	0x49, 0x16, 0xd4, 0x09, 0x1c, 0x53, 0x0c, 0xfe, 0xd3, 0xe2, 0x6c, 0x57, 0x34, 0x1a, 0x4b, 0xd6,
+	0xfe, 0x13, 0xec, 0xe6, 0xa1, 0xf4, 0xc2, 0x66, 0xee, 0x9e, 0x60, 0x56, 0xb5, 0xe4, 0x07, 0xff,
+	0x39, 0x6d, 0x37, 0xa2, 0x83, 0x9e, 0xe2, 0xc3, 0xdb, 0x96, 0xb4, 0xdf, 0x2c, 0x07, 0xab, 0x6b,
+	0xb2, 0xbd, 0x6b, 0x3a, 0xdb, 0xbb, 0x5e, 0x87, 0x3a, 0x8e, 0x22, 0x1a, 0x6d, 0xee, 0x6e, 0x91,
+	0x38, 0xe6, 0xc1, 0x53, 0x12, 0xcc, 0xc7, 0xa0, 0xe6, 0xbb, 0x30, 0xf3, 0x23, 0x7e, 0x5d, 0x2a,
+	0xdb, 0x90, 0x9b, 0x96, 0xe5, 0x76, 0x76, 0xf7, 0xbc, 0x01, 0x15, 0x21, 0xe9, 0x50, 0xc2, 0x32,
+	0xff, 0xdc, 0xf4, 0xde, 0xd8, 0x82, 0x92, 0x70, 0x1b, 0xd2, 0xa1, 0xd4, 0xe1, 0xf7, 0x36, 0x0a,
+	0xa8, 0x06, 0x95, 0xce, 0x21, 0x71, 0x19, 0xf6, 0x1a, 0x1a, 0xaa, 0xc0, 0xd4, 0x3b, 0xef, 0x6c,
+	0x35, 0x8a, 0x68, 0x1e, 0x1a, 0x8f, 0xb1, 0xed, 0xf9, 0x24, 0xc0, 0x9d, 0x23, 0x59, 0x6e, 0x1a,
+	0x53, 0x68, 0x16, 0xf4, 0x9d, 0x60, 0x0f, 0xdb, 0x3e, 0xdb, 0x3b, 0x6e, 0x4c, 0xaf, 0xfe, 0xbc,
+	0x08, 0x25, 0xd9, 0xe4, 0x1f, 0x42, 0xdd, 0xc2, 0x21, 0x8d, 0xd8, 0x56, 0xe2, 0x33, 0x12, 0xfa,
+	0x18, 0xd5, 0x47, 0x1e, 0xe0, 0x3e, 0x6f, 0x2e, 0x9e, 0x68, 0xd5, 0x1d, 0xfe, 0xa3, 0x39, 0x7a,
+	0x00, 0x65, 0x49, 0x89, 0x4e, 0xfa, 0xec, 0x54, 0x22, 0x0c, 0x73, 0x3f, 0xc0, 0x4c, 0x3a, 0x51,
+	0x10, 0xc4, 0x08, 0x0d, 0x0b, 0xe6, 0xd0, 0xaf, 0xcd, 0x1b, 0x23, 0x8e, 0xb9, 0xf8, 0x31, 0x5f,
+	0xfb, 0xf0, 0xcf, 0x5f, 0xfe, 0xac, 0x78, 0xc7, 0x34, 0xda, 0x87, 0xdf, 0x6c, 0xef, 0x53, 0xe7,
+	0x5e, 0x8c, 0x59, 0xfb, 0x7d, 0x61, 0xda, 0x0f, 0xda, 0xef, 0x13, 0xef, 0x83, 0x47, 0xda, 0x1b,
+	0xf7, 0x35, 0xf4, 0x08, 0x4a, 0xc2, 0x17, 0x4a, 0xb4, 0xac, 0x5f, 0x4e, 0xe7, 0x3d, 0xf5, 0xd3,
+	0xa2, 0x76, 0x5f, 0x5b, 0x5b, 0xfe, 0xf4, 0x6f, 0x4b, 0x85, 0x9f, 0xbc, 0x5c, 0xd2, 0x3e, 0x7e,
+	0xb9, 0xa4, 0x7d, 0xf2, 0x72, 0x49, 0xfb, 0xeb, 0xcb, 0x25, 0xed, 0xa3, 0x57, 0x4b, 0x85, 0x4f,
+	0x5e, 0x2d, 0x15, 0x3e, 0x7d, 0xb5, 0x54, 0x70, 0xca, 0x42, 0xa9, 0x07, 0xff, 0x1c, 0x00, 0x1d,
+	0x66, 0xeb, 0xf6, 0x9e, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4900,6 +4912,13 @@ func (m *ContainerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LogExcerpt) > 0 {
+		i -= len(m.LogExcerpt)
+		copy(dAtA[i:], m.LogExcerpt)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LogExcerpt)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Cause != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
 		i--
@@ -6117,6 +6136,10 @@ func (m *ContainerStatus) Size() (n int) {
 	if m.Cause != 0 {
 		n += 1 + sovEvent(uint64(m.Cause))
 	}
+	l = len(m.LogExcerpt)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -6778,6 +6801,7 @@ func (this *ContainerStatus) String() string {
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`LogExcerpt:` + fmt.Sprintf("%v", this.LogExcerpt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13549,6 +13573,38 @@ func (m *ContainerStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogExcerpt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogExcerpt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -22,6 +22,38 @@ func SwaggerJsonTemplate() string {
 		"    \"version\": \"version not set\"\n" +
 		"  },\n" +
 		"  \"paths\": {\n" +
+		"    \"/api/v1/lookout/failures\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Lookout\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetFailureClusters\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetFailureClustersRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetFailureClustersResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/api/v1/lookout/jobs\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -54,6 +86,36 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/api/v1/lookout/jobs/{jobId}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Lookout\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobDetails\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetJobDetailsResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/api/v1/lookout/jobsets\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -86,6 +148,38 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/api/v1/lookout/jobsets/analytics\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Lookout\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobSetAnalytics\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetJobSetAnalyticsRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetJobSetAnalyticsResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/api/v1/lookout/overview\": {\n" +
 		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
@@ -107,6 +201,70 @@ func SwaggerJsonTemplate() string {
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
+		"    },\n" +
+		"    \"/api/v1/lookout/queuehistory\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Lookout\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueueHistory\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetQueueHistoryRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetQueueHistoryResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/api/v1/lookout/usage\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Lookout\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetUsage\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetUsageRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/lookoutGetUsageResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
 		"    }\n" +
 		"  },\n" +
 		"  \"definitions\": {\n" +
@@ -271,6 +429,80 @@ func SwaggerJsonTemplate() string {
 		"      \"title\": \"Type represents the stored type of IntOrString.\",\n" +
 		"      \"x-go-package\": \"k8s.io/apimachinery/pkg/util/intstr\"\n" +
 		"    },\n" +
+		"    \"lookoutClusterAnalytics\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cluster\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"runTime\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutDurationDistribution\"\n" +
+		"        },\n" +
+		"        \"runs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutContainerInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"exitCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"logExcerpt\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutDurationDistribution\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"count\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"histogram\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutHistogramBucket\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"max\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"mean\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"min\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p50\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p90\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"p99\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"lookoutDurationStats\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -294,84 +526,394 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"lookoutGetJobSetsRequest\": {\n" +
+		"    \"lookoutFailureCluster\": {\n" +
+		"      \"description\": \"FailureCluster is a group of failed runs with the same error signature, exit code and cause. The signature is the\\nerror of the runs with ids, numbers and pod names replaced by placeholders.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"activeOnly\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"cause\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"newestFirst\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"clusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"count\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"exampleError\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"lookoutGetJobSetsResponse\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"jobSetInfos\": {\n" +
+		"        },\n" +
+		"        \"exampleJobIds\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/lookoutJobSetInfo\"\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"lookoutGetJobsRequest\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetIds\": {\n" +
+		"        \"exitCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"jobSets\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"jobStates\": {\n" +
+		"        \"nodes\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"newestFirst\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"share\": {\n" +
+		"          \"description\": \"Fraction of all failed runs in the time range which are in the cluster.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        },\n" +
-		"        \"owner\": {\n" +
+		"        \"signature\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetFailureClustersRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"from\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
+		"          \"description\": \"Failures of all queues if empty.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"skip\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int64\"\n" +
-		"        },\n" +
 		"        \"take\": {\n" +
+		"          \"description\": \"Maximum number of clusters returned, all clusters if zero.\",\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int64\"\n" +
 		"        },\n" +
-		"        \"userAnnotations\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
+		"        \"to\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"lookoutGetJobsResponse\": {\n" +
+		"    \"lookoutGetFailureClustersResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"jobInfos\": {\n" +
+		"        \"clusters\": {\n" +
+		"          \"description\": \"Largest clusters first.\",\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/lookoutJobInfo\"\n" +
+		"            \"$ref\": \"#/definitions/lookoutFailureCluster\"\n" +
 		"          }\n" +
-		"        }\n" +
-		"      }\n" +
+		"        },\n" +
+		"        \"totalFailures\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobDetailsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"containers\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutContainerInfo\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"events\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutJobEventInfo\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobInfo\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutJobInfo\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobSetAnalyticsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"compare\": {\n" +
+		"          \"description\": \"Compares every other job set to the first one.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"histogramBuckets\": {\n" +
+		"          \"description\": \"Number of buckets of the duration histograms, 10 if not set.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobSetIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"resolution\": {\n" +
+		"          \"description\": \"Outcomes of jobs are counted for each resolution interval over time if set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobSetAnalyticsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"baseline\": {\n" +
+		"          \"description\": \"Job set the others are compared to, if a comparison was requested.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"comparisons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutJobSetComparison\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSets\": {\n" +
+		"          \"description\": \"In the order the job sets were requested.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutJobSetAnalytics\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobSetsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"activeOnly\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"newestFirst\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobSetsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetInfos\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutJobSetInfo\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobsRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cluster\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"cursor\": {\n" +
+		"          \"description\": \"Cursor returned with the previous page, the page continues after the last job of the previous page.\\nCursors are only returned when ordering by job_id or submitted, the priority and the runs of jobs the other\\norderings depend on change while paging.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"errorContains\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"exitCodes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"finished\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutTimeRange\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobStates\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"newestFirst\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"node\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"orderBy\": {\n" +
+		"          \"description\": \"One of job_id, submitted, started, finished, priority, cluster, node, exit_code and error, job_id by default.\\nJobs are sorted in descending order if newest_first is set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"owner\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"priority\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutPriorityRange\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"skip\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"started\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutTimeRange\"\n" +
+		"        },\n" +
+		"        \"submitted\": {\n" +
+		"          \"$ref\": \"#/definitions/lookoutTimeRange\"\n" +
+		"        },\n" +
+		"        \"take\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"userAnnotations\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetJobsResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobInfos\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutJobInfo\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nextCursor\": {\n" +
+		"          \"description\": \"Cursor of the next page, empty if there are no more jobs.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetQueueHistoryRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"from\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"description\": \"History of all queues if empty.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"resolution\": {\n" +
+		"          \"description\": \"Samples are downsampled to one point per resolution, returned as recorded if not set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"to\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetQueueHistoryResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"queues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutQueueHistory\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetUsageRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotationKey\": {\n" +
+		"          \"description\": \"User annotation the usage is grouped by when grouping by annotation, without the user annotation prefix.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"from\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"groupBy\": {\n" +
+		"          \"description\": \"One of queue, owner, cluster and annotation, queue by default.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"to\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutGetUsageResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"usage\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutUsageInfo\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutHistogramBucket\": {\n" +
+		"      \"description\": \"HistogramBucket counts the durations from lower up to upper, the last bucket includes its upper bound.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"count\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"lower\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"upper\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutJobEventInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cluster\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"node\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"type\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
 		"    },\n" +
 		"    \"lookoutJobInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
@@ -397,6 +939,115 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"lookoutJobSetAnalytics\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"cancelledRatio\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"clusters\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutClusterAnalytics\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"failureRatio\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"jobSet\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobsRetried\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"outcomes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutOutcomePoint\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"retries\": {\n" +
+		"          \"description\": \"Runs after the first run of each job, and the number of jobs with more than one run.\\nRuns which couldn't be scheduled are not counted.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"runTime\": {\n" +
+		"          \"description\": \"Time from start until finish of finished runs.\",\n" +
+		"          \"$ref\": \"#/definitions/lookoutDurationDistribution\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"successRatio\": {\n" +
+		"          \"description\": \"Fractions of the finished jobs which succeeded, failed or were cancelled.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"waitTime\": {\n" +
+		"          \"description\": \"Time from submission until the first run of the job started.\",\n" +
+		"          \"$ref\": \"#/definitions/lookoutDurationDistribution\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutJobSetComparison\": {\n" +
+		"      \"description\": \"JobSetComparison gives the differences of a job set to the baseline job set, as the value of the job set minus\\nthe value of the baseline. Durations are not set if either job set has no durations.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelledRatioDelta\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"failureRatioDelta\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"jobSet\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"retriesPerJobDelta\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"runTimeP50Delta\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runTimeP90Delta\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runTimeP99Delta\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"successRatioDelta\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"waitTimeP50Delta\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"waitTimeP90Delta\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"lookoutJobSetInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -438,6 +1089,86 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"lookoutOutcomePoint\": {\n" +
+		"      \"description\": \"OutcomePoint counts the jobs which finished in the resolution interval starting at time.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"time\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutPriorityRange\": {\n" +
+		"      \"description\": \"PriorityRange matches priorities from min to max (both inclusive), either bound can be left out.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"max\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"min\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutQueueHistory\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"points\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/lookoutQueueHistoryPoint\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutQueueHistoryPoint\": {\n" +
+		"      \"description\": \"QueueHistoryPoint gives the average number of jobs in each state over the samples of the point,\\nand the number of jobs which finished during the time covered by the point.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"failed\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"pending\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queued\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"running\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"time\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"lookoutQueueInfo\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -523,6 +1254,58 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"lookoutTimeRange\": {\n" +
+		"      \"description\": \"TimeRange matches times from from (inclusive) to to (exclusive), either bound can be left out.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"from\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"to\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"lookoutUsageInfo\": {\n" +
+		"      \"description\": \"UsageInfo is the usage of a group of runs over the requested time range. Resources are given in resource-hours,\\nwith memory and ephemeral storage in GiB-hours. The cost is calculated from the requested resources.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cost\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"group\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestedResourceHours\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"runs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"totalCost\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"usedResourceHours\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"protobufAny\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
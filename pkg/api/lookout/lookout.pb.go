@@ -5,6 +5,7 @@ package lookout
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -279,6 +280,172 @@ func (m *RunInfo) GetUnableToSchedule() bool {
 	return false
 }
 
+type ContainerInfo struct {
+	RunId      string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExitCode   int32  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
+	LogExcerpt string `protobuf:"bytes,4,opt,name=log_excerpt,json=logExcerpt,proto3" json:"logExcerpt,omitempty"`
+}
+
+func (m *ContainerInfo) Reset()      { *m = ContainerInfo{} }
+func (*ContainerInfo) ProtoMessage() {}
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{3}
+}
+func (m *ContainerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContainerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerInfo.Merge(m, src)
+}
+func (m *ContainerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerInfo proto.InternalMessageInfo
+
+func (m *ContainerInfo) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ContainerInfo) GetLogExcerpt() string {
+	if m != nil {
+		return m.LogExcerpt
+	}
+	return ""
+}
+
+type JobEventInfo struct {
+	Created      time.Time `protobuf:"bytes,1,opt,name=created,proto3,stdtime" json:"created"`
+	Type         string    `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Cluster      string    `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	KubernetesId string    `protobuf:"bytes,4,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	PodNumber    int32     `protobuf:"varint,5,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	Node         string    `protobuf:"bytes,6,opt,name=node,proto3" json:"node,omitempty"`
+	Reason       string    `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Requestor    string    `protobuf:"bytes,8,opt,name=requestor,proto3" json:"requestor,omitempty"`
+}
+
+func (m *JobEventInfo) Reset()      { *m = JobEventInfo{} }
+func (*JobEventInfo) ProtoMessage() {}
+func (*JobEventInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{4}
+}
+func (m *JobEventInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobEventInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobEventInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobEventInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobEventInfo.Merge(m, src)
+}
+func (m *JobEventInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobEventInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobEventInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobEventInfo proto.InternalMessageInfo
+
+func (m *JobEventInfo) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobEventInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *JobEventInfo) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *JobEventInfo) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
+	}
+	return ""
+}
+
+func (m *JobEventInfo) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
+}
+
+func (m *JobEventInfo) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *JobEventInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobEventInfo) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
 type QueueInfo struct {
 	Queue                  string          `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobsQueued             uint32          `protobuf:"varint,2,opt,name=jobs_queued,json=jobsQueued,proto3" json:"jobsQueued,omitempty"`
@@ -293,7 +460,7 @@ type QueueInfo struct {
 func (m *QueueInfo) Reset()      { *m = QueueInfo{} }
 func (*QueueInfo) ProtoMessage() {}
 func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{3}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{5}
 }
 func (m *QueueInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +561,7 @@ type JobSetInfo struct {
 func (m *JobSetInfo) Reset()      { *m = JobSetInfo{} }
 func (*JobSetInfo) ProtoMessage() {}
 func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{4}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{6}
 }
 func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +672,7 @@ type DurationStats struct {
 func (m *DurationStats) Reset()      { *m = DurationStats{} }
 func (*DurationStats) ProtoMessage() {}
 func (*DurationStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{5}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{7}
 }
 func (m *DurationStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +752,7 @@ type GetJobSetsRequest struct {
 func (m *GetJobSetsRequest) Reset()      { *m = GetJobSetsRequest{} }
 func (*GetJobSetsRequest) ProtoMessage() {}
 func (*GetJobSetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{6}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{8}
 }
 func (m *GetJobSetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +809,7 @@ type GetJobSetsResponse struct {
 func (m *GetJobSetsResponse) Reset()      { *m = GetJobSetsResponse{} }
 func (*GetJobSetsResponse) ProtoMessage() {}
 func (*GetJobSetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{7}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{9}
 }
 func (m *GetJobSetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,12 +855,27 @@ type GetJobsRequest struct {
 	JobId           string            `protobuf:"bytes,7,opt,name=jobId,proto3" json:"jobId,omitempty"`
 	Owner           string            `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	UserAnnotations map[string]string `protobuf:"bytes,9,rep,name=user_annotations,json=userAnnotations,proto3" json:"userAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Submitted       *TimeRange        `protobuf:"bytes,10,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	Started         *TimeRange        `protobuf:"bytes,11,opt,name=started,proto3" json:"started,omitempty"`
+	Finished        *TimeRange        `protobuf:"bytes,12,opt,name=finished,proto3" json:"finished,omitempty"`
+	Cluster         string            `protobuf:"bytes,13,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Node            string            `protobuf:"bytes,14,opt,name=node,proto3" json:"node,omitempty"`
+	Priority        *PriorityRange    `protobuf:"bytes,15,opt,name=priority,proto3" json:"priority,omitempty"`
+	ExitCodes       []int32           `protobuf:"varint,16,rep,packed,name=exit_codes,json=exitCodes,proto3" json:"exitCodes,omitempty"`
+	ErrorContains   string            `protobuf:"bytes,17,opt,name=error_contains,json=errorContains,proto3" json:"errorContains,omitempty"`
+	// One of job_id, submitted, started, finished, priority, cluster, node, exit_code and error, job_id by default.
+	// Jobs are sorted in descending order if newest_first is set.
+	OrderBy string `protobuf:"bytes,18,opt,name=order_by,json=orderBy,proto3" json:"orderBy,omitempty"`
+	// Cursor returned with the previous page, the page continues after the last job of the previous page.
+	// Cursors are only returned when ordering by job_id or submitted, the priority and the runs of jobs the other
+	// orderings depend on change while paging.
+	Cursor string `protobuf:"bytes,19,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (m *GetJobsRequest) Reset()      { *m = GetJobsRequest{} }
 func (*GetJobsRequest) ProtoMessage() {}
 func (*GetJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{8}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{10}
 }
 func (m *GetJobsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,14 +967,190 @@ func (m *GetJobsRequest) GetUserAnnotations() map[string]string {
 	return nil
 }
 
+func (m *GetJobsRequest) GetSubmitted() *TimeRange {
+	if m != nil {
+		return m.Submitted
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetStarted() *TimeRange {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetFinished() *TimeRange {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetPriority() *PriorityRange {
+	if m != nil {
+		return m.Priority
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetExitCodes() []int32 {
+	if m != nil {
+		return m.ExitCodes
+	}
+	return nil
+}
+
+func (m *GetJobsRequest) GetErrorContains() string {
+	if m != nil {
+		return m.ErrorContains
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *GetJobsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+// TimeRange matches times from from (inclusive) to to (exclusive), either bound can be left out.
+type TimeRange struct {
+	From *time.Time `protobuf:"bytes,1,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	To   *time.Time `protobuf:"bytes,2,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+}
+
+func (m *TimeRange) Reset()      { *m = TimeRange{} }
+func (*TimeRange) ProtoMessage() {}
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{11}
+}
+func (m *TimeRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeRange.Merge(m, src)
+}
+func (m *TimeRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeRange proto.InternalMessageInfo
+
+func (m *TimeRange) GetFrom() *time.Time {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *TimeRange) GetTo() *time.Time {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+// PriorityRange matches priorities from min to max (both inclusive), either bound can be left out.
+type PriorityRange struct {
+	Min *float64 `protobuf:"bytes,1,opt,name=min,proto3,wktptr" json:"min,omitempty"`
+	Max *float64 `protobuf:"bytes,2,opt,name=max,proto3,wktptr" json:"max,omitempty"`
+}
+
+func (m *PriorityRange) Reset()      { *m = PriorityRange{} }
+func (*PriorityRange) ProtoMessage() {}
+func (*PriorityRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{12}
+}
+func (m *PriorityRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriorityRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriorityRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriorityRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorityRange.Merge(m, src)
+}
+func (m *PriorityRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriorityRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorityRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriorityRange proto.InternalMessageInfo
+
+func (m *PriorityRange) GetMin() *float64 {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *PriorityRange) GetMax() *float64 {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
 type GetJobsResponse struct {
 	JobInfos []*JobInfo `protobuf:"bytes,1,rep,name=job_infos,json=jobInfos,proto3" json:"jobInfos,omitempty"`
+	// Cursor of the next page, empty if there are no more jobs.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"nextCursor,omitempty"`
 }
 
 func (m *GetJobsResponse) Reset()      { *m = GetJobsResponse{} }
 func (*GetJobsResponse) ProtoMessage() {}
 func (*GetJobsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ee7620a6fb9cfb1, []int{9}
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{13}
 }
 func (m *GetJobsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,1500 +1186,10911 @@ func (m *GetJobsResponse) GetJobInfos() []*JobInfo {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SystemOverview)(nil), "lookout.SystemOverview")
-	proto.RegisterType((*JobInfo)(nil), "lookout.JobInfo")
-	proto.RegisterType((*RunInfo)(nil), "lookout.RunInfo")
-	proto.RegisterType((*QueueInfo)(nil), "lookout.QueueInfo")
-	proto.RegisterType((*JobSetInfo)(nil), "lookout.JobSetInfo")
-	proto.RegisterType((*DurationStats)(nil), "lookout.DurationStats")
-	proto.RegisterType((*GetJobSetsRequest)(nil), "lookout.GetJobSetsRequest")
-	proto.RegisterType((*GetJobSetsResponse)(nil), "lookout.GetJobSetsResponse")
-	proto.RegisterType((*GetJobsRequest)(nil), "lookout.GetJobsRequest")
-	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.UserAnnotationsEntry")
-	proto.RegisterType((*GetJobsResponse)(nil), "lookout.GetJobsResponse")
+func (m *GetJobsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("pkg/api/lookout/lookout.proto", fileDescriptor_6ee7620a6fb9cfb1) }
-
-var fileDescriptor_6ee7620a6fb9cfb1 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
-	0x10, 0x36, 0xf5, 0xe6, 0x28, 0x4e, 0x9c, 0x8d, 0x6b, 0x33, 0x4a, 0x22, 0x2b, 0x44, 0x0b, 0xb8,
-	0x41, 0x22, 0xc3, 0x36, 0x8a, 0x1a, 0x46, 0x50, 0xb8, 0x46, 0x93, 0xc2, 0x46, 0x5b, 0xb7, 0x54,
-	0x8a, 0x9e, 0x02, 0x81, 0x14, 0x57, 0x32, 0x25, 0x6a, 0x57, 0xe6, 0x2e, 0x65, 0xe8, 0x56, 0xf4,
-	0x17, 0x04, 0xe8, 0x5f, 0xe8, 0xb9, 0x87, 0xde, 0xfa, 0x0b, 0x9a, 0x63, 0x80, 0x5e, 0x72, 0xea,
-	0xc3, 0xee, 0x9f, 0xe8, 0xad, 0xd8, 0x07, 0xa9, 0x87, 0x1d, 0x19, 0x46, 0x4f, 0xdc, 0x9d, 0xf9,
-	0xe6, 0xb1, 0xf3, 0xcd, 0x2c, 0x17, 0x1e, 0x0c, 0x7a, 0x9d, 0x0d, 0x77, 0x10, 0x6c, 0x84, 0x94,
-	0xf6, 0x68, 0xcc, 0x93, 0x6f, 0x7d, 0x10, 0x51, 0x4e, 0x51, 0x51, 0x6f, 0x2b, 0x6b, 0x1d, 0x4a,
-	0x3b, 0x21, 0xde, 0x90, 0x62, 0x2f, 0x6e, 0x6f, 0xf0, 0xa0, 0x8f, 0x19, 0x77, 0xfb, 0x03, 0x85,
-	0xac, 0x54, 0x67, 0x01, 0x7e, 0x1c, 0xb9, 0x3c, 0xa0, 0x44, 0xeb, 0xef, 0xcd, 0xea, 0x71, 0x7f,
-	0xc0, 0x47, 0x5a, 0x79, 0x5f, 0x2b, 0x45, 0x22, 0x2e, 0x21, 0x94, 0x4b, 0x4b, 0xa6, 0xb5, 0x4f,
-	0x3a, 0x01, 0x3f, 0x8e, 0xbd, 0x7a, 0x8b, 0xf6, 0x37, 0x3a, 0xb4, 0x43, 0xc7, 0x3e, 0xc4, 0x4e,
-	0x6e, 0xe4, 0x4a, 0xc3, 0xef, 0x24, 0x47, 0x3a, 0x89, 0x71, 0x8c, 0x95, 0xd0, 0x7e, 0x0a, 0x37,
-	0x1b, 0x23, 0xc6, 0x71, 0xff, 0x68, 0x88, 0xa3, 0x61, 0x80, 0x4f, 0xd1, 0x23, 0x28, 0x48, 0x00,
-	0xb3, 0x8c, 0x5a, 0x76, 0xbd, 0xbc, 0x85, 0xea, 0xc9, 0xd1, 0xbf, 0x11, 0xe2, 0x03, 0xd2, 0xa6,
-	0x8e, 0x46, 0xd8, 0xbf, 0x19, 0x50, 0x3c, 0xa4, 0x9e, 0x90, 0xa1, 0x0a, 0x64, 0xbb, 0xd4, 0xb3,
-	0x8c, 0x9a, 0xb1, 0x5e, 0xde, 0x2a, 0xd5, 0xdd, 0x41, 0x50, 0x3f, 0xa4, 0x9e, 0x23, 0x84, 0xe8,
-	0x7d, 0xc8, 0x45, 0x31, 0x61, 0x56, 0x46, 0x7a, 0x5c, 0x4a, 0x3d, 0x3a, 0x31, 0x91, 0xfe, 0xa4,
-	0x16, 0xed, 0x83, 0xd9, 0x72, 0x49, 0x0b, 0x87, 0x21, 0xf6, 0xad, 0xac, 0xf4, 0x53, 0xa9, 0xab,
-	0x0a, 0xd4, 0x93, 0xa3, 0xd5, 0x5f, 0x24, 0xf5, 0xdd, 0x2f, 0xbd, 0xfe, 0x63, 0xcd, 0x78, 0xf5,
-	0xe7, 0x9a, 0xe1, 0x8c, 0xcd, 0xd0, 0x3d, 0x30, 0xbb, 0xd4, 0x6b, 0x32, 0xee, 0x72, 0x6c, 0xe5,
-	0x6a, 0xc6, 0xba, 0xe9, 0x94, 0xba, 0xd4, 0x6b, 0x88, 0x3d, 0xba, 0x0b, 0x62, 0xdd, 0xec, 0x32,
-	0x4a, 0xac, 0xbc, 0xd4, 0x15, 0xbb, 0xd4, 0x3b, 0x64, 0x94, 0xd8, 0x3f, 0x67, 0xa1, 0xa8, 0xb3,
-	0x41, 0xef, 0x41, 0xa1, 0xb7, 0xc3, 0x9a, 0x81, 0x2f, 0x0f, 0x63, 0x3a, 0xf9, 0xde, 0x0e, 0x3b,
-	0xf0, 0x91, 0x05, 0xc5, 0x56, 0x18, 0x33, 0x8e, 0x23, 0x2b, 0xa3, 0x8c, 0xf5, 0x16, 0x21, 0xc8,
-	0x11, 0xea, 0x63, 0x99, 0xb3, 0xe9, 0xc8, 0x35, 0xba, 0x0f, 0x26, 0x8b, 0x5b, 0x2d, 0x8c, 0x7d,
-	0xec, 0xcb, 0x44, 0x4a, 0xce, 0x58, 0x80, 0x96, 0x21, 0x8f, 0xa3, 0x88, 0x46, 0x3a, 0x0d, 0xb5,
-	0x41, 0x9f, 0x40, 0xb1, 0x15, 0x61, 0x97, 0x63, 0xdf, 0x2a, 0x5c, 0xe3, 0xf8, 0x89, 0x91, 0xb0,
-	0x67, 0xdc, 0x8d, 0x84, 0x7d, 0xf1, 0x3a, 0xf6, 0xda, 0x08, 0xed, 0x41, 0xa9, 0x1d, 0x90, 0x80,
-	0x1d, 0x63, 0xdf, 0x2a, 0x5d, 0xc3, 0x41, 0x6a, 0x85, 0x1e, 0x00, 0x0c, 0xa8, 0xdf, 0x24, 0x71,
-	0xdf, 0xc3, 0x91, 0x65, 0xd6, 0x8c, 0xf5, 0xbc, 0x63, 0x0e, 0xa8, 0xff, 0x95, 0x14, 0x08, 0x76,
-	0xa2, 0x98, 0x68, 0x76, 0x40, 0xb1, 0x13, 0xc5, 0x44, 0xb1, 0xf3, 0x18, 0x50, 0x4c, 0x5c, 0x2f,
-	0xc4, 0x4d, 0x4e, 0x9b, 0xac, 0x75, 0x8c, 0xfd, 0x38, 0xc4, 0x56, 0x59, 0x96, 0x6e, 0x49, 0x69,
-	0x5e, 0xd0, 0x86, 0x96, 0x0b, 0xc2, 0xcc, 0xb4, 0x21, 0x45, 0x3d, 0x65, 0x4b, 0x26, 0x8c, 0xc9,
-	0x0d, 0x5a, 0x83, 0x72, 0x97, 0x7a, 0xac, 0x29, 0x77, 0xbe, 0x64, 0x6d, 0xd1, 0x01, 0x21, 0x92,
-	0x96, 0x3e, 0x7a, 0x08, 0x37, 0x24, 0x60, 0x80, 0x89, 0x1f, 0x90, 0x8e, 0x24, 0x70, 0xd1, 0x91,
-	0x46, 0x5f, 0x2b, 0x51, 0x0a, 0x89, 0x62, 0x42, 0x04, 0x24, 0x37, 0x86, 0x38, 0x4a, 0x84, 0x9e,
-	0xc2, 0x6d, 0x1a, 0xfa, 0x98, 0x71, 0x1d, 0xa8, 0x29, 0xe6, 0x20, 0x2f, 0xeb, 0x37, 0x6e, 0x75,
-	0x3d, 0x26, 0xce, 0x2d, 0x05, 0x55, 0x09, 0x1c, 0x52, 0x0f, 0xed, 0xc1, 0x9d, 0x90, 0x92, 0x8e,
-	0x30, 0xd7, 0x31, 0xa4, 0x7d, 0xe1, 0x1d, 0xf6, 0xb7, 0x35, 0x58, 0x07, 0x17, 0x1e, 0x8e, 0x60,
-	0x65, 0x3a, 0x7e, 0x72, 0xc5, 0xe8, 0x2e, 0xb8, 0x7b, 0x81, 0xc4, 0xcf, 0x34, 0xc0, 0x59, 0x9e,
-	0xcc, 0x26, 0x91, 0xa2, 0x06, 0x58, 0xb3, 0x29, 0xa5, 0x2e, 0x4b, 0x57, 0xb9, 0x5c, 0x99, 0x4e,
-	0x30, 0x91, 0xdb, 0x3f, 0x65, 0x01, 0x0e, 0xa9, 0xd7, 0xc0, 0x7c, 0x0e, 0x63, 0xab, 0x50, 0x94,
-	0xe3, 0x8b, 0xb9, 0x9e, 0xb1, 0x42, 0x57, 0x9a, 0xcc, 0x52, 0x99, 0xbd, 0x92, 0xca, 0xdc, 0xd5,
-	0x54, 0xe6, 0x2f, 0x52, 0xf9, 0x01, 0xdc, 0x94, 0x90, 0xf1, 0xe8, 0x16, 0x24, 0x68, 0x51, 0x48,
-	0x1b, 0xe9, 0xf8, 0x26, 0xd9, 0xb4, 0xdd, 0x20, 0xd4, 0xc3, 0xa6, 0xb3, 0x79, 0x2e, 0x25, 0x68,
-	0x17, 0x6e, 0xe8, 0x28, 0xa2, 0xb7, 0x99, 0xae, 0xda, 0x4a, 0xca, 0x66, 0x52, 0x15, 0xa9, 0x75,
-	0xa6, 0xb0, 0x68, 0x07, 0xca, 0xea, 0x94, 0xca, 0xd4, 0x9c, 0x6b, 0x3a, 0x09, 0x15, 0x17, 0x28,
-	0x8b, 0xbd, 0x7e, 0xc0, 0xc5, 0x0d, 0x00, 0xd7, 0xb9, 0x40, 0x53, 0x33, 0xfb, 0xd7, 0x0c, 0x2c,
-	0x4e, 0x85, 0x40, 0x1f, 0x41, 0x89, 0x1d, 0xd3, 0x88, 0x63, 0xc6, 0xf5, 0xed, 0x3e, 0x87, 0xfd,
-	0x14, 0x8a, 0xb6, 0xa1, 0xa8, 0x3b, 0x41, 0x52, 0x39, 0xd7, 0x2a, 0x41, 0x0a, 0x23, 0x77, 0x88,
-	0x23, 0xb7, 0x83, 0xf5, 0x0f, 0x60, 0x9e, 0x91, 0x46, 0xa2, 0x4d, 0x28, 0xf4, 0xb1, 0x1f, 0xb8,
-	0x44, 0x92, 0x3e, 0xd7, 0x46, 0x03, 0xd1, 0x87, 0x90, 0x39, 0xd9, 0xd4, 0x33, 0x3a, 0x07, 0x9e,
-	0x39, 0xd9, 0x94, 0xd0, 0x6d, 0x3d, 0x8e, 0x73, 0xa1, 0xdb, 0x76, 0x1f, 0x6e, 0x7f, 0x8e, 0xb9,
-	0x6a, 0x72, 0xe6, 0xe0, 0x93, 0x58, 0x1c, 0xe9, 0xf2, 0x46, 0x7f, 0x08, 0x37, 0x08, 0x3e, 0x15,
-	0x13, 0xd6, 0x0e, 0x22, 0x5d, 0xa2, 0x92, 0x53, 0x56, 0xb2, 0xe7, 0x42, 0x24, 0x9a, 0xcc, 0x6d,
-	0xf1, 0x60, 0x88, 0x9b, 0x94, 0x84, 0x23, 0x59, 0x8f, 0x92, 0x03, 0x4a, 0x74, 0x44, 0xc2, 0x91,
-	0xfd, 0x25, 0xa0, 0xc9, 0x70, 0x6c, 0x40, 0x09, 0xc3, 0xe8, 0x63, 0x58, 0xd4, 0x23, 0xd4, 0x0c,
-	0x48, 0x9b, 0x26, 0xbf, 0xf1, 0x3b, 0x93, 0x37, 0x89, 0x1e, 0x42, 0xd9, 0xfb, 0x7a, 0xcd, 0xec,
-	0x7f, 0x33, 0x70, 0x53, 0xf9, 0xfb, 0xff, 0xb9, 0x3f, 0x00, 0x48, 0x7f, 0xc3, 0xcc, 0xca, 0xd6,
-	0xb2, 0xeb, 0xa6, 0x63, 0x26, 0xff, 0x61, 0x86, 0xaa, 0x72, 0x7e, 0x54, 0x8e, 0x3e, 0xb3, 0x72,
-	0x63, 0x3d, 0xe6, 0x07, 0x3e, 0x13, 0x3f, 0x54, 0xee, 0xf6, 0xb0, 0x9e, 0x50, 0xb9, 0x16, 0x32,
-	0xd6, 0x0b, 0x06, 0x7a, 0x20, 0xe5, 0x5a, 0xe4, 0xd7, 0xa5, 0xde, 0x81, 0x9a, 0x40, 0xd3, 0x51,
-	0x1b, 0x21, 0xa5, 0xa7, 0x04, 0x47, 0x72, 0xea, 0x4c, 0x47, 0x6d, 0xd0, 0x77, 0xb0, 0x14, 0x33,
-	0x1c, 0x35, 0x27, 0xde, 0x51, 0x96, 0x29, 0x4b, 0xf3, 0x38, 0x2d, 0xcd, 0xf4, 0xf1, 0xeb, 0xdf,
-	0x32, 0x1c, 0x7d, 0x3a, 0x86, 0x3f, 0x23, 0x3c, 0x1a, 0x39, 0xb7, 0xe2, 0x69, 0x69, 0x65, 0x1f,
-	0x96, 0x2f, 0x03, 0xa2, 0x25, 0xc8, 0xf6, 0xf0, 0x48, 0x97, 0x4e, 0x2c, 0x45, 0x62, 0x43, 0x37,
-	0x8c, 0xb1, 0xbe, 0xdb, 0xd4, 0x66, 0x37, 0xb3, 0x63, 0xd8, 0x7b, 0x70, 0x2b, 0x8d, 0xad, 0x79,
-	0x7c, 0xa2, 0x5e, 0x32, 0x93, 0x1c, 0x5e, 0xfc, 0x1b, 0x88, 0xf7, 0x8c, 0x64, 0x6f, 0xeb, 0x97,
-	0x0c, 0x14, 0xbf, 0x50, 0x5a, 0xf4, 0x12, 0x4a, 0xe9, 0x73, 0x6e, 0xe5, 0x42, 0xcb, 0x3e, 0x13,
-	0x0f, 0xcc, 0xca, 0x6a, 0xea, 0x6b, 0xfa, 0xfd, 0x67, 0xd7, 0x7e, 0xf8, 0xfd, 0x9f, 0x1f, 0x33,
-	0x15, 0x64, 0xc9, 0xb7, 0xe2, 0x70, 0x33, 0x7d, 0x01, 0xd3, 0xc4, 0x65, 0x00, 0x30, 0xee, 0x3b,
-	0x54, 0x99, 0xa9, 0xde, 0x44, 0xef, 0x57, 0xee, 0x5d, 0xaa, 0x53, 0x07, 0xb4, 0x6d, 0x19, 0xe8,
-	0xbe, 0xbd, 0x3a, 0x1b, 0x48, 0xdc, 0xa3, 0x98, 0xb3, 0x5d, 0xe3, 0x11, 0x7a, 0x09, 0x45, 0x5d,
-	0x17, 0xb4, 0xfa, 0x0e, 0x96, 0x2a, 0xd6, 0x45, 0x85, 0x8e, 0xb0, 0x26, 0x23, 0xdc, 0xb5, 0x97,
-	0x2f, 0x8b, 0xb0, 0x6b, 0x3c, 0xda, 0xaf, 0xbd, 0xfd, 0xbb, 0xba, 0xf0, 0xfd, 0x59, 0xd5, 0x78,
-	0x7d, 0x56, 0x35, 0xde, 0x9c, 0x55, 0x8d, 0xbf, 0xce, 0xaa, 0xc6, 0xab, 0xf3, 0xea, 0xc2, 0x9b,
-	0xf3, 0xea, 0xc2, 0xdb, 0xf3, 0xea, 0x82, 0x57, 0x90, 0x65, 0xdb, 0xfe, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0x4f, 0xaf, 0x4a, 0x7b, 0x10, 0x0c, 0x00, 0x00,
+type GetJobDetailsRequest struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetJobDetailsRequest) Reset()      { *m = GetJobDetailsRequest{} }
+func (*GetJobDetailsRequest) ProtoMessage() {}
+func (*GetJobDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{14}
+}
+func (m *GetJobDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetJobDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobDetailsRequest.Merge(m, src)
+}
+func (m *GetJobDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobDetailsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GetJobDetailsRequest proto.InternalMessageInfo
 
-// LookoutClient is the client API for Lookout service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LookoutClient interface {
-	Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error)
-	GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error)
-	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+func (m *GetJobDetailsRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
 }
 
-type lookoutClient struct {
-	cc *grpc.ClientConn
+type GetJobDetailsResponse struct {
+	JobInfo    *JobInfo         `protobuf:"bytes,1,opt,name=job_info,json=jobInfo,proto3" json:"jobInfo,omitempty"`
+	Containers []*ContainerInfo `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	Events     []*JobEventInfo  `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func NewLookoutClient(cc *grpc.ClientConn) LookoutClient {
-	return &lookoutClient{cc}
+func (m *GetJobDetailsResponse) Reset()      { *m = GetJobDetailsResponse{} }
+func (*GetJobDetailsResponse) ProtoMessage() {}
+func (*GetJobDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{15}
 }
-
-func (c *lookoutClient) Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error) {
-	out := new(SystemOverview)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/Overview", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetJobDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetJobDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobDetailsResponse.Merge(m, src)
+}
+func (m *GetJobDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobDetailsResponse.DiscardUnknown(m)
 }
 
-func (c *lookoutClient) GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error) {
-	out := new(GetJobSetsResponse)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetJobDetailsResponse proto.InternalMessageInfo
+
+func (m *GetJobDetailsResponse) GetJobInfo() *JobInfo {
+	if m != nil {
+		return m.JobInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *lookoutClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
-	out := new(GetJobsResponse)
-	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetJobDetailsResponse) GetContainers() []*ContainerInfo {
+	if m != nil {
+		return m.Containers
 	}
-	return out, nil
+	return nil
 }
 
-// LookoutServer is the server API for Lookout service.
-type LookoutServer interface {
-	Overview(context.Context, *types.Empty) (*SystemOverview, error)
-	GetJobSets(context.Context, *GetJobSetsRequest) (*GetJobSetsResponse, error)
-	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+func (m *GetJobDetailsResponse) GetEvents() []*JobEventInfo {
+	if m != nil {
+		return m.Events
+	}
+	return nil
 }
 
-// UnimplementedLookoutServer can be embedded to have forward compatible implementations.
-type UnimplementedLookoutServer struct {
+type GetUsageRequest struct {
+	From time.Time `protobuf:"bytes,1,opt,name=from,proto3,stdtime" json:"from"`
+	To   time.Time `protobuf:"bytes,2,opt,name=to,proto3,stdtime" json:"to"`
+	// One of queue, owner, cluster and annotation, queue by default.
+	GroupBy string `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"groupBy,omitempty"`
+	// User annotation the usage is grouped by when grouping by annotation, without the user annotation prefix.
+	AnnotationKey string `protobuf:"bytes,4,opt,name=annotation_key,json=annotationKey,proto3" json:"annotationKey,omitempty"`
 }
 
-func (*UnimplementedLookoutServer) Overview(ctx context.Context, req *types.Empty) (*SystemOverview, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Overview not implemented")
+func (m *GetUsageRequest) Reset()      { *m = GetUsageRequest{} }
+func (*GetUsageRequest) ProtoMessage() {}
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{16}
 }
-func (*UnimplementedLookoutServer) GetJobSets(ctx context.Context, req *GetJobSetsRequest) (*GetJobSetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobSets not implemented")
+func (m *GetUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedLookoutServer) GetJobs(ctx context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+func (m *GetUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterLookoutServer(s *grpc.Server, srv LookoutServer) {
-	s.RegisterService(&_Lookout_serviceDesc, srv)
+func (m *GetUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageRequest.Merge(m, src)
+}
+func (m *GetUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageRequest.DiscardUnknown(m)
 }
 
-func _Lookout_Overview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(LookoutServer).Overview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lookout.Lookout/Overview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LookoutServer).Overview(ctx, req.(*types.Empty))
+var xxx_messageInfo_GetUsageRequest proto.InternalMessageInfo
+
+func (m *GetUsageRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Lookout_GetJobSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobSetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(LookoutServer).GetJobSets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lookout.Lookout/GetJobSets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LookoutServer).GetJobSets(ctx, req.(*GetJobSetsRequest))
+func (m *GetUsageRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Lookout_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJobsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetUsageRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
 	}
-	if interceptor == nil {
-		return srv.(LookoutServer).GetJobs(ctx, in)
+	return ""
+}
+
+func (m *GetUsageRequest) GetAnnotationKey() string {
+	if m != nil {
+		return m.AnnotationKey
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/lookout.Lookout/GetJobs",
+	return ""
+}
+
+// UsageInfo is the usage of a group of runs over the requested time range. Resources are given in resource-hours,
+// with memory and ephemeral storage in GiB-hours. The cost is calculated from the requested resources.
+type UsageInfo struct {
+	Group                  string             `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Runs                   uint32             `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"`
+	RequestedResourceHours map[string]float64 `protobuf:"bytes,3,rep,name=requested_resource_hours,json=requestedResourceHours,proto3" json:"requestedResourceHours,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	UsedResourceHours      map[string]float64 `protobuf:"bytes,4,rep,name=used_resource_hours,json=usedResourceHours,proto3" json:"usedResourceHours,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Cost                   map[string]float64 `protobuf:"bytes,5,rep,name=cost,proto3" json:"cost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	TotalCost              float64            `protobuf:"fixed64,6,opt,name=total_cost,json=totalCost,proto3" json:"totalCost,omitempty"`
+}
+
+func (m *UsageInfo) Reset()      { *m = UsageInfo{} }
+func (*UsageInfo) ProtoMessage() {}
+func (*UsageInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{17}
+}
+func (m *UsageInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LookoutServer).GetJobs(ctx, req.(*GetJobsRequest))
+}
+func (m *UsageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageInfo.Merge(m, src)
+}
+func (m *UsageInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageInfo proto.InternalMessageInfo
+
+func (m *UsageInfo) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Lookout_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "lookout.Lookout",
-	HandlerType: (*LookoutServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Overview",
-			Handler:    _Lookout_Overview_Handler,
-		},
-		{
-			MethodName: "GetJobSets",
-			Handler:    _Lookout_GetJobSets_Handler,
-		},
-		{
-			MethodName: "GetJobs",
-			Handler:    _Lookout_GetJobs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/lookout/lookout.proto",
+func (m *UsageInfo) GetRuns() uint32 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
 }
 
-func (m *SystemOverview) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UsageInfo) GetRequestedResourceHours() map[string]float64 {
+	if m != nil {
+		return m.RequestedResourceHours
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SystemOverview) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UsageInfo) GetUsedResourceHours() map[string]float64 {
+	if m != nil {
+		return m.UsedResourceHours
+	}
+	return nil
 }
 
-func (m *SystemOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *UsageInfo) GetCost() map[string]float64 {
+	if m != nil {
+		return m.Cost
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UsageInfo) GetTotalCost() float64 {
+	if m != nil {
+		return m.TotalCost
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetUsageResponse struct {
+	Usage []*UsageInfo `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
 }
 
-func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobJson) > 0 {
-		i -= len(m.JobJson)
-		copy(dAtA[i:], m.JobJson)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobJson)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.JobState) > 0 {
-		i -= len(m.JobState)
-		copy(dAtA[i:], m.JobState)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobState)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Cancelled != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Cancelled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintLookout(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Runs) > 0 {
-		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+func (m *GetUsageResponse) Reset()      { *m = GetUsageResponse{} }
+func (*GetUsageResponse) ProtoMessage() {}
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{18}
+}
+func (m *GetUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageResponse.Merge(m, src)
+}
+func (m *GetUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageResponse.DiscardUnknown(m)
 }
 
-func (m *RunInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetUsageResponse proto.InternalMessageInfo
+
+func (m *GetUsageResponse) GetUsage() []*UsageInfo {
+	if m != nil {
+		return m.Usage
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RunInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetQueueHistoryRequest struct {
+	// History of all queues if empty.
+	Queue string    `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	From  time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from"`
+	To    time.Time `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to"`
+	// Samples are downsampled to one point per resolution, returned as recorded if not set.
+	Resolution *types.Duration `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
 }
 
-func (m *RunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnableToSchedule {
-		i--
-		if m.UnableToSchedule {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *GetQueueHistoryRequest) Reset()      { *m = GetQueueHistoryRequest{} }
+func (*GetQueueHistoryRequest) ProtoMessage() {}
+func (*GetQueueHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{19}
+}
+func (m *GetQueueHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetQueueHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetQueueHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x58
+		return b[:n], nil
 	}
-	if len(m.RunState) > 0 {
-		i -= len(m.RunState)
-		copy(dAtA[i:], m.RunState)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.RunState)))
-		i--
-		dAtA[i] = 0x52
+}
+func (m *GetQueueHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueueHistoryRequest.Merge(m, src)
+}
+func (m *GetQueueHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetQueueHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueueHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueueHistoryRequest proto.InternalMessageInfo
+
+func (m *GetQueueHistoryRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if m.PodNumber != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *GetQueueHistoryRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
 	}
-	if m.Finished != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Finished, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintLookout(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x42
+	return time.Time{}
+}
+
+func (m *GetQueueHistoryRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
 	}
-	if m.Started != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintLookout(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x3a
+	return time.Time{}
+}
+
+func (m *GetQueueHistoryRequest) GetResolution() *types.Duration {
+	if m != nil {
+		return m.Resolution
 	}
-	if m.Created != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
-		if err5 != nil {
-			return 0, err5
+	return nil
+}
+
+// QueueHistoryPoint gives the average number of jobs in each state over the samples of the point,
+// and the number of jobs which finished during the time covered by the point.
+type QueueHistoryPoint struct {
+	Time      time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	Queued    float64   `protobuf:"fixed64,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Pending   float64   `protobuf:"fixed64,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Running   float64   `protobuf:"fixed64,4,opt,name=running,proto3" json:"running,omitempty"`
+	Succeeded uint32    `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    uint32    `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *QueueHistoryPoint) Reset()      { *m = QueueHistoryPoint{} }
+func (*QueueHistoryPoint) ProtoMessage() {}
+func (*QueueHistoryPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{20}
+}
+func (m *QueueHistoryPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueHistoryPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueHistoryPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n5
-		i = encodeVarintLookout(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *QueueHistoryPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueHistoryPoint.Merge(m, src)
+}
+func (m *QueueHistoryPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueHistoryPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueHistoryPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueHistoryPoint proto.InternalMessageInfo
+
+func (m *QueueHistoryPoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.Succeeded {
-		i--
-		if m.Succeeded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	return time.Time{}
+}
+
+func (m *QueueHistoryPoint) GetQueued() float64 {
+	if m != nil {
+		return m.Queued
 	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *QueueHistoryPoint) GetPending() float64 {
+	if m != nil {
+		return m.Pending
 	}
-	if len(m.Cluster) > 0 {
-		i -= len(m.Cluster)
-		copy(dAtA[i:], m.Cluster)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *QueueHistoryPoint) GetRunning() float64 {
+	if m != nil {
+		return m.Running
 	}
-	if len(m.K8SId) > 0 {
-		i -= len(m.K8SId)
-		copy(dAtA[i:], m.K8SId)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.K8SId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QueueHistoryPoint) GetSucceeded() uint32 {
+	if m != nil {
+		return m.Succeeded
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueueHistoryPoint) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueueHistory struct {
+	Queue  string               `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Points []*QueueHistoryPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
 }
 
-func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LongestRunningDuration != nil {
-		{
-			size, err := m.LongestRunningDuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.OldestQueuedDuration != nil {
-		{
-			size, err := m.OldestQueuedDuration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.LongestRunningJob != nil {
-		{
-			size, err := m.LongestRunningJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.OldestQueuedJob != nil {
-		{
-			size, err := m.OldestQueuedJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+func (m *QueueHistory) Reset()      { *m = QueueHistory{} }
+func (*QueueHistory) ProtoMessage() {}
+func (*QueueHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{21}
+}
+func (m *QueueHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.JobsRunning != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.JobsPending != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *QueueHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueHistory.Merge(m, src)
+}
+func (m *QueueHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueHistory proto.InternalMessageInfo
+
+func (m *QueueHistory) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if m.JobsQueued != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *QueueHistory) GetPoints() []*QueueHistoryPoint {
+	if m != nil {
+		return m.Points
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+type GetQueueHistoryResponse struct {
+	Queues []*QueueHistory `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *GetQueueHistoryResponse) Reset()      { *m = GetQueueHistoryResponse{} }
+func (*GetQueueHistoryResponse) ProtoMessage() {}
+func (*GetQueueHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{22}
+}
+func (m *GetQueueHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetQueueHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetQueueHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetQueueHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueueHistoryResponse.Merge(m, src)
+}
+func (m *GetQueueHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetQueueHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueueHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetQueueHistoryResponse proto.InternalMessageInfo
+
+func (m *GetQueueHistoryResponse) GetQueues() []*QueueHistory {
+	if m != nil {
+		return m.Queues
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetFailureClustersRequest struct {
+	// Failures of all queues if empty.
+	Queue string    `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	From  time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from"`
+	To    time.Time `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to"`
+	// Maximum number of clusters returned, all clusters if zero.
+	Take uint32 `protobuf:"varint,4,opt,name=take,proto3" json:"take,omitempty"`
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Submitted != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Submitted, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Submitted):])
-		if err10 != nil {
-			return 0, err10
+func (m *GetFailureClustersRequest) Reset()      { *m = GetFailureClustersRequest{} }
+func (*GetFailureClustersRequest) ProtoMessage() {}
+func (*GetFailureClustersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{23}
+}
+func (m *GetFailureClustersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailureClustersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailureClustersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n10
-		i = encodeVarintLookout(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.QueuedStats != nil {
-		{
-			size, err := m.QueuedStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *GetFailureClustersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailureClustersRequest.Merge(m, src)
+}
+func (m *GetFailureClustersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailureClustersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailureClustersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFailureClustersRequest proto.InternalMessageInfo
+
+func (m *GetFailureClustersRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if m.RunningStats != nil {
-		{
-			size, err := m.RunningStats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *GetFailureClustersRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
 	}
-	if m.JobsFailed != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsFailed))
-		i--
-		dAtA[i] = 0x38
+	return time.Time{}
+}
+
+func (m *GetFailureClustersRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
 	}
-	if m.JobsSucceeded != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsSucceeded))
-		i--
-		dAtA[i] = 0x30
+	return time.Time{}
+}
+
+func (m *GetFailureClustersRequest) GetTake() uint32 {
+	if m != nil {
+		return m.Take
 	}
-	if m.JobsRunning != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+// FailureCluster is a group of failed runs with the same error signature, exit code and cause. The signature is the
+// error of the runs with ids, numbers and pod names replaced by placeholders.
+type FailureCluster struct {
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	ExitCode  int32  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
+	Cause     string `protobuf:"bytes,3,opt,name=cause,proto3" json:"cause,omitempty"`
+	Count     uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Fraction of all failed runs in the time range which are in the cluster.
+	Share         float64  `protobuf:"fixed64,5,opt,name=share,proto3" json:"share,omitempty"`
+	ExampleError  string   `protobuf:"bytes,6,opt,name=example_error,json=exampleError,proto3" json:"exampleError,omitempty"`
+	ExampleJobIds []string `protobuf:"bytes,7,rep,name=example_job_ids,json=exampleJobIds,proto3" json:"exampleJobIds,omitempty"`
+	JobSets       []string `protobuf:"bytes,8,rep,name=job_sets,json=jobSets,proto3" json:"jobSets,omitempty"`
+	Clusters      []string `protobuf:"bytes,9,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Nodes         []string `protobuf:"bytes,10,rep,name=nodes,proto3" json:"nodes,omitempty"`
+}
+
+func (m *FailureCluster) Reset()      { *m = FailureCluster{} }
+func (*FailureCluster) ProtoMessage() {}
+func (*FailureCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{24}
+}
+func (m *FailureCluster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailureCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailureCluster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.JobsPending != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *FailureCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailureCluster.Merge(m, src)
+}
+func (m *FailureCluster) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailureCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailureCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailureCluster proto.InternalMessageInfo
+
+func (m *FailureCluster) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	if m.JobsQueued != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *FailureCluster) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
 	}
-	if len(m.JobSet) > 0 {
-		i -= len(m.JobSet)
-		copy(dAtA[i:], m.JobSet)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *FailureCluster) GetCause() string {
+	if m != nil {
+		return m.Cause
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *FailureCluster) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DurationStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FailureCluster) GetShare() float64 {
+	if m != nil {
+		return m.Share
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DurationStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FailureCluster) GetExampleError() string {
+	if m != nil {
+		return m.ExampleError
+	}
+	return ""
 }
 
-func (m *DurationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Q3 != nil {
-		{
-			size, err := m.Q3.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *FailureCluster) GetExampleJobIds() []string {
+	if m != nil {
+		return m.ExampleJobIds
 	}
-	if m.Q1 != nil {
-		{
-			size, err := m.Q1.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *FailureCluster) GetJobSets() []string {
+	if m != nil {
+		return m.JobSets
 	}
-	if m.Median != nil {
-		{
-			size, err := m.Median.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *FailureCluster) GetClusters() []string {
+	if m != nil {
+		return m.Clusters
 	}
-	if m.Average != nil {
-		{
-			size, err := m.Average.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *FailureCluster) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
 	}
-	if m.Longest != nil {
-		{
-			size, err := m.Longest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
+	return nil
+}
+
+type GetFailureClustersResponse struct {
+	// Largest clusters first.
+	Clusters      []*FailureCluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	TotalFailures uint32            `protobuf:"varint,2,opt,name=total_failures,json=totalFailures,proto3" json:"totalFailures,omitempty"`
+}
+
+func (m *GetFailureClustersResponse) Reset()      { *m = GetFailureClustersResponse{} }
+func (*GetFailureClustersResponse) ProtoMessage() {}
+func (*GetFailureClustersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{25}
+}
+func (m *GetFailureClustersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFailureClustersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFailureClustersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Shortest != nil {
-		{
-			size, err := m.Shortest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLookout(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GetFailureClustersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFailureClustersResponse.Merge(m, src)
+}
+func (m *GetFailureClustersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFailureClustersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFailureClustersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFailureClustersResponse proto.InternalMessageInfo
+
+func (m *GetFailureClustersResponse) GetClusters() []*FailureCluster {
+	if m != nil {
+		return m.Clusters
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetJobSetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetFailureClustersResponse) GetTotalFailures() uint32 {
+	if m != nil {
+		return m.TotalFailures
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetJobSetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetJobSetAnalyticsRequest struct {
+	Queue     string   `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetIds []string `protobuf:"bytes,2,rep,name=job_set_ids,json=jobSetIds,proto3" json:"jobSetIds,omitempty"`
+	// Number of buckets of the duration histograms, 10 if not set.
+	HistogramBuckets uint32 `protobuf:"varint,3,opt,name=histogram_buckets,json=histogramBuckets,proto3" json:"histogramBuckets,omitempty"`
+	// Outcomes of jobs are counted for each resolution interval over time if set.
+	Resolution *types.Duration `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// Compares every other job set to the first one.
+	Compare bool `protobuf:"varint,5,opt,name=compare,proto3" json:"compare,omitempty"`
 }
 
-func (m *GetJobSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActiveOnly {
-		i--
-		if m.ActiveOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NewestFirst {
-		i--
-		if m.NewestFirst {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *GetJobSetAnalyticsRequest) Reset()      { *m = GetJobSetAnalyticsRequest{} }
+func (*GetJobSetAnalyticsRequest) ProtoMessage() {}
+func (*GetJobSetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{26}
+}
+func (m *GetJobSetAnalyticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobSetAnalyticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobSetAnalyticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GetJobSetAnalyticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobSetAnalyticsRequest.Merge(m, src)
+}
+func (m *GetJobSetAnalyticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobSetAnalyticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobSetAnalyticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobSetAnalyticsRequest proto.InternalMessageInfo
+
+func (m *GetJobSetAnalyticsRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetJobSetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetJobSetAnalyticsRequest) GetJobSetIds() []string {
+	if m != nil {
+		return m.JobSetIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetJobSetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetJobSetAnalyticsRequest) GetHistogramBuckets() uint32 {
+	if m != nil {
+		return m.HistogramBuckets
+	}
+	return 0
 }
 
-func (m *GetJobSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobSetInfos) > 0 {
-		for iNdEx := len(m.JobSetInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobSetInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *GetJobSetAnalyticsRequest) GetResolution() *types.Duration {
+	if m != nil {
+		return m.Resolution
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetJobsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetJobSetAnalyticsRequest) GetCompare() bool {
+	if m != nil {
+		return m.Compare
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *GetJobsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HistogramBucket counts the durations from lower up to upper, the last bucket includes its upper bound.
+type HistogramBucket struct {
+	Lower *types.Duration `protobuf:"bytes,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper *types.Duration `protobuf:"bytes,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Count uint32          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *GetJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserAnnotations) > 0 {
-		for k := range m.UserAnnotations {
-			v := m.UserAnnotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintLookout(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
+func (m *HistogramBucket) Reset()      { *m = HistogramBucket{} }
+func (*HistogramBucket) ProtoMessage() {}
+func (*HistogramBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{27}
+}
+func (m *HistogramBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistogramBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistogramBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *HistogramBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistogramBucket.Merge(m, src)
+}
+func (m *HistogramBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistogramBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistogramBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistogramBucket proto.InternalMessageInfo
+
+func (m *HistogramBucket) GetLower() *types.Duration {
+	if m != nil {
+		return m.Lower
 	}
-	if m.Skip != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.Skip))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *HistogramBucket) GetUpper() *types.Duration {
+	if m != nil {
+		return m.Upper
 	}
-	if m.Take != 0 {
-		i = encodeVarintLookout(dAtA, i, uint64(m.Take))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *HistogramBucket) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.JobSetIds) > 0 {
-		for iNdEx := len(m.JobSetIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobSetIds[iNdEx])
-			copy(dAtA[i:], m.JobSetIds[iNdEx])
-			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+type DurationDistribution struct {
+	Count     uint32             `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Min       *types.Duration    `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max       *types.Duration    `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	Mean      *types.Duration    `protobuf:"bytes,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	P50       *types.Duration    `protobuf:"bytes,5,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90       *types.Duration    `protobuf:"bytes,6,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99       *types.Duration    `protobuf:"bytes,7,opt,name=p99,proto3" json:"p99,omitempty"`
+	Histogram []*HistogramBucket `protobuf:"bytes,8,rep,name=histogram,proto3" json:"histogram,omitempty"`
+}
+
+func (m *DurationDistribution) Reset()      { *m = DurationDistribution{} }
+func (*DurationDistribution) ProtoMessage() {}
+func (*DurationDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{28}
+}
+func (m *DurationDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DurationDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DurationDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.JobStates) > 0 {
-		for iNdEx := len(m.JobStates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobStates[iNdEx])
-			copy(dAtA[i:], m.JobStates[iNdEx])
-			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobStates[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *DurationDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurationDistribution.Merge(m, src)
+}
+func (m *DurationDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *DurationDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurationDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurationDistribution proto.InternalMessageInfo
+
+func (m *DurationDistribution) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if m.NewestFirst {
-		i--
-		if m.NewestFirst {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *DurationDistribution) GetMin() *types.Duration {
+	if m != nil {
+		return m.Min
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *DurationDistribution) GetMax() *types.Duration {
+	if m != nil {
+		return m.Max
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetJobsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DurationDistribution) GetMean() *types.Duration {
+	if m != nil {
+		return m.Mean
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetJobsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DurationDistribution) GetP50() *types.Duration {
+	if m != nil {
+		return m.P50
+	}
+	return nil
 }
 
-func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobInfos) > 0 {
-		for iNdEx := len(m.JobInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLookout(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *DurationDistribution) GetP90() *types.Duration {
+	if m != nil {
+		return m.P90
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintLookout(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLookout(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DurationDistribution) GetP99() *types.Duration {
+	if m != nil {
+		return m.P99
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *SystemOverview) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DurationDistribution) GetHistogram() []*HistogramBucket {
+	if m != nil {
+		return m.Histogram
 	}
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for _, e := range m.Queues {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
+	return nil
+}
+
+type ClusterAnalytics struct {
+	Cluster   string                `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Runs      uint32                `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"`
+	Succeeded uint32                `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    uint32                `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	RunTime   *DurationDistribution `protobuf:"bytes,5,opt,name=run_time,json=runTime,proto3" json:"runTime,omitempty"`
+}
+
+func (m *ClusterAnalytics) Reset()      { *m = ClusterAnalytics{} }
+func (*ClusterAnalytics) ProtoMessage() {}
+func (*ClusterAnalytics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{29}
+}
+func (m *ClusterAnalytics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterAnalytics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterAnalytics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ClusterAnalytics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterAnalytics.Merge(m, src)
+}
+func (m *ClusterAnalytics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterAnalytics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterAnalytics.DiscardUnknown(m)
 }
 
-func (m *JobInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovLookout(uint64(l))
+var xxx_messageInfo_ClusterAnalytics proto.InternalMessageInfo
+
+func (m *ClusterAnalytics) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	if len(m.Runs) > 0 {
-		for _, e := range m.Runs {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	return ""
+}
+
+func (m *ClusterAnalytics) GetRuns() uint32 {
+	if m != nil {
+		return m.Runs
 	}
-	if m.Cancelled != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled)
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *ClusterAnalytics) GetSucceeded() uint32 {
+	if m != nil {
+		return m.Succeeded
 	}
-	l = len(m.JobState)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *ClusterAnalytics) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
 	}
-	l = len(m.JobJson)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *ClusterAnalytics) GetRunTime() *DurationDistribution {
+	if m != nil {
+		return m.RunTime
 	}
-	return n
+	return nil
 }
 
-func (m *RunInfo) Size() (n int) {
-	if m == nil {
-		return 0
+// OutcomePoint counts the jobs which finished in the resolution interval starting at time.
+type OutcomePoint struct {
+	Time      time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	Succeeded uint32    `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    uint32    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled uint32    `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *OutcomePoint) Reset()      { *m = OutcomePoint{} }
+func (*OutcomePoint) ProtoMessage() {}
+func (*OutcomePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{30}
+}
+func (m *OutcomePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutcomePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutcomePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.K8SId)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+}
+func (m *OutcomePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutcomePoint.Merge(m, src)
+}
+func (m *OutcomePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutcomePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutcomePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutcomePoint proto.InternalMessageInfo
+
+func (m *OutcomePoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return time.Time{}
+}
+
+func (m *OutcomePoint) GetSucceeded() uint32 {
+	if m != nil {
+		return m.Succeeded
 	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Succeeded {
-		n += 2
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Created != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Started != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started)
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.Finished != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished)
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.PodNumber != 0 {
-		n += 1 + sovLookout(uint64(m.PodNumber))
-	}
-	l = len(m.RunState)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.UnableToSchedule {
-		n += 2
-	}
-	return n
+	return 0
 }
 
-func (m *QueueInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OutcomePoint) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
 	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *OutcomePoint) GetCancelled() uint32 {
+	if m != nil {
+		return m.Cancelled
 	}
-	if m.JobsQueued != 0 {
-		n += 1 + sovLookout(uint64(m.JobsQueued))
+	return 0
+}
+
+type JobSetAnalytics struct {
+	Queue     string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSet    string `protobuf:"bytes,2,opt,name=job_set,json=jobSet,proto3" json:"jobSet,omitempty"`
+	Jobs      uint32 `protobuf:"varint,3,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	Succeeded uint32 `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    uint32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled uint32 `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Fractions of the finished jobs which succeeded, failed or were cancelled.
+	SuccessRatio   float64 `protobuf:"fixed64,7,opt,name=success_ratio,json=successRatio,proto3" json:"successRatio,omitempty"`
+	FailureRatio   float64 `protobuf:"fixed64,8,opt,name=failure_ratio,json=failureRatio,proto3" json:"failureRatio,omitempty"`
+	CancelledRatio float64 `protobuf:"fixed64,9,opt,name=cancelled_ratio,json=cancelledRatio,proto3" json:"cancelledRatio,omitempty"`
+	// Runs after the first run of each job, and the number of jobs with more than one run.
+	// Runs which couldn't be scheduled are not counted.
+	Retries     uint32 `protobuf:"varint,10,opt,name=retries,proto3" json:"retries,omitempty"`
+	JobsRetried uint32 `protobuf:"varint,11,opt,name=jobs_retried,json=jobsRetried,proto3" json:"jobsRetried,omitempty"`
+	// Time from submission until the first run of the job started.
+	WaitTime *DurationDistribution `protobuf:"bytes,12,opt,name=wait_time,json=waitTime,proto3" json:"waitTime,omitempty"`
+	// Time from start until finish of finished runs.
+	RunTime  *DurationDistribution `protobuf:"bytes,13,opt,name=run_time,json=runTime,proto3" json:"runTime,omitempty"`
+	Clusters []*ClusterAnalytics   `protobuf:"bytes,14,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Outcomes []*OutcomePoint       `protobuf:"bytes,15,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+}
+
+func (m *JobSetAnalytics) Reset()      { *m = JobSetAnalytics{} }
+func (*JobSetAnalytics) ProtoMessage() {}
+func (*JobSetAnalytics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{31}
+}
+func (m *JobSetAnalytics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetAnalytics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetAnalytics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.JobsPending != 0 {
-		n += 1 + sovLookout(uint64(m.JobsPending))
+}
+func (m *JobSetAnalytics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetAnalytics.Merge(m, src)
+}
+func (m *JobSetAnalytics) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetAnalytics) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetAnalytics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetAnalytics proto.InternalMessageInfo
+
+func (m *JobSetAnalytics) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	if m.JobsRunning != 0 {
-		n += 1 + sovLookout(uint64(m.JobsRunning))
+	return ""
+}
+
+func (m *JobSetAnalytics) GetJobSet() string {
+	if m != nil {
+		return m.JobSet
 	}
-	if m.OldestQueuedJob != nil {
-		l = m.OldestQueuedJob.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return ""
+}
+
+func (m *JobSetAnalytics) GetJobs() uint32 {
+	if m != nil {
+		return m.Jobs
 	}
-	if m.LongestRunningJob != nil {
-		l = m.LongestRunningJob.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetSucceeded() uint32 {
+	if m != nil {
+		return m.Succeeded
 	}
-	if m.OldestQueuedDuration != nil {
-		l = m.OldestQueuedDuration.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetFailed() uint32 {
+	if m != nil {
+		return m.Failed
 	}
-	if m.LongestRunningDuration != nil {
-		l = m.LongestRunningDuration.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetCancelled() uint32 {
+	if m != nil {
+		return m.Cancelled
 	}
-	return n
+	return 0
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetAnalytics) GetSuccessRatio() float64 {
+	if m != nil {
+		return m.SuccessRatio
 	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetFailureRatio() float64 {
+	if m != nil {
+		return m.FailureRatio
 	}
-	l = len(m.JobSet)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetCancelledRatio() float64 {
+	if m != nil {
+		return m.CancelledRatio
 	}
-	if m.JobsQueued != 0 {
-		n += 1 + sovLookout(uint64(m.JobsQueued))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
 	}
-	if m.JobsPending != 0 {
-		n += 1 + sovLookout(uint64(m.JobsPending))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetJobsRetried() uint32 {
+	if m != nil {
+		return m.JobsRetried
 	}
-	if m.JobsRunning != 0 {
-		n += 1 + sovLookout(uint64(m.JobsRunning))
+	return 0
+}
+
+func (m *JobSetAnalytics) GetWaitTime() *DurationDistribution {
+	if m != nil {
+		return m.WaitTime
 	}
-	if m.JobsSucceeded != 0 {
-		n += 1 + sovLookout(uint64(m.JobsSucceeded))
+	return nil
+}
+
+func (m *JobSetAnalytics) GetRunTime() *DurationDistribution {
+	if m != nil {
+		return m.RunTime
 	}
-	if m.JobsFailed != 0 {
-		n += 1 + sovLookout(uint64(m.JobsFailed))
+	return nil
+}
+
+func (m *JobSetAnalytics) GetClusters() []*ClusterAnalytics {
+	if m != nil {
+		return m.Clusters
 	}
-	if m.RunningStats != nil {
-		l = m.RunningStats.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return nil
+}
+
+func (m *JobSetAnalytics) GetOutcomes() []*OutcomePoint {
+	if m != nil {
+		return m.Outcomes
 	}
-	if m.QueuedStats != nil {
-		l = m.QueuedStats.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return nil
+}
+
+// JobSetComparison gives the differences of a job set to the baseline job set, as the value of the job set minus
+// the value of the baseline. Durations are not set if either job set has no durations.
+type JobSetComparison struct {
+	JobSet              string          `protobuf:"bytes,1,opt,name=job_set,json=jobSet,proto3" json:"jobSet,omitempty"`
+	SuccessRatioDelta   float64         `protobuf:"fixed64,2,opt,name=success_ratio_delta,json=successRatioDelta,proto3" json:"successRatioDelta,omitempty"`
+	FailureRatioDelta   float64         `protobuf:"fixed64,3,opt,name=failure_ratio_delta,json=failureRatioDelta,proto3" json:"failureRatioDelta,omitempty"`
+	CancelledRatioDelta float64         `protobuf:"fixed64,4,opt,name=cancelled_ratio_delta,json=cancelledRatioDelta,proto3" json:"cancelledRatioDelta,omitempty"`
+	RetriesPerJobDelta  float64         `protobuf:"fixed64,5,opt,name=retries_per_job_delta,json=retriesPerJobDelta,proto3" json:"retriesPerJobDelta,omitempty"`
+	WaitTimeP50Delta    *types.Duration `protobuf:"bytes,6,opt,name=wait_time_p50_delta,json=waitTimeP50Delta,proto3" json:"waitTimeP50Delta,omitempty"`
+	WaitTimeP90Delta    *types.Duration `protobuf:"bytes,7,opt,name=wait_time_p90_delta,json=waitTimeP90Delta,proto3" json:"waitTimeP90Delta,omitempty"`
+	RunTimeP50Delta     *types.Duration `protobuf:"bytes,8,opt,name=run_time_p50_delta,json=runTimeP50Delta,proto3" json:"runTimeP50Delta,omitempty"`
+	RunTimeP90Delta     *types.Duration `protobuf:"bytes,9,opt,name=run_time_p90_delta,json=runTimeP90Delta,proto3" json:"runTimeP90Delta,omitempty"`
+	RunTimeP99Delta     *types.Duration `protobuf:"bytes,10,opt,name=run_time_p99_delta,json=runTimeP99Delta,proto3" json:"runTimeP99Delta,omitempty"`
+}
+
+func (m *JobSetComparison) Reset()      { *m = JobSetComparison{} }
+func (*JobSetComparison) ProtoMessage() {}
+func (*JobSetComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{32}
+}
+func (m *JobSetComparison) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetComparison.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Submitted != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Submitted)
-		n += 1 + l + sovLookout(uint64(l))
+}
+func (m *JobSetComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetComparison.Merge(m, src)
+}
+func (m *JobSetComparison) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetComparison proto.InternalMessageInfo
+
+func (m *JobSetComparison) GetJobSet() string {
+	if m != nil {
+		return m.JobSet
 	}
-	return n
+	return ""
 }
 
-func (m *DurationStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetComparison) GetSuccessRatioDelta() float64 {
+	if m != nil {
+		return m.SuccessRatioDelta
 	}
-	var l int
-	_ = l
-	if m.Shortest != nil {
-		l = m.Shortest.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetComparison) GetFailureRatioDelta() float64 {
+	if m != nil {
+		return m.FailureRatioDelta
 	}
-	if m.Longest != nil {
-		l = m.Longest.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetComparison) GetCancelledRatioDelta() float64 {
+	if m != nil {
+		return m.CancelledRatioDelta
 	}
-	if m.Average != nil {
-		l = m.Average.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetComparison) GetRetriesPerJobDelta() float64 {
+	if m != nil {
+		return m.RetriesPerJobDelta
 	}
-	if m.Median != nil {
-		l = m.Median.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return 0
+}
+
+func (m *JobSetComparison) GetWaitTimeP50Delta() *types.Duration {
+	if m != nil {
+		return m.WaitTimeP50Delta
 	}
-	if m.Q1 != nil {
-		l = m.Q1.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return nil
+}
+
+func (m *JobSetComparison) GetWaitTimeP90Delta() *types.Duration {
+	if m != nil {
+		return m.WaitTimeP90Delta
 	}
-	if m.Q3 != nil {
-		l = m.Q3.Size()
-		n += 1 + l + sovLookout(uint64(l))
+	return nil
+}
+
+func (m *JobSetComparison) GetRunTimeP50Delta() *types.Duration {
+	if m != nil {
+		return m.RunTimeP50Delta
 	}
-	return n
+	return nil
 }
 
-func (m *GetJobSetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetComparison) GetRunTimeP90Delta() *types.Duration {
+	if m != nil {
+		return m.RunTimeP90Delta
 	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
-	}
-	if m.NewestFirst {
-		n += 2
-	}
-	if m.ActiveOnly {
-		n += 2
-	}
-	return n
+	return nil
 }
 
-func (m *GetJobSetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetComparison) GetRunTimeP99Delta() *types.Duration {
+	if m != nil {
+		return m.RunTimeP99Delta
 	}
-	var l int
-	_ = l
-	if len(m.JobSetInfos) > 0 {
-		for _, e := range m.JobSetInfos {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
+	return nil
+}
+
+type GetJobSetAnalyticsResponse struct {
+	// In the order the job sets were requested.
+	JobSets []*JobSetAnalytics `protobuf:"bytes,1,rep,name=job_sets,json=jobSets,proto3" json:"jobSets,omitempty"`
+	// Job set the others are compared to, if a comparison was requested.
+	Baseline    string              `protobuf:"bytes,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Comparisons []*JobSetComparison `protobuf:"bytes,3,rep,name=comparisons,proto3" json:"comparisons,omitempty"`
+}
+
+func (m *GetJobSetAnalyticsResponse) Reset()      { *m = GetJobSetAnalyticsResponse{} }
+func (*GetJobSetAnalyticsResponse) ProtoMessage() {}
+func (*GetJobSetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ee7620a6fb9cfb1, []int{33}
+}
+func (m *GetJobSetAnalyticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJobSetAnalyticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJobSetAnalyticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GetJobSetAnalyticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobSetAnalyticsResponse.Merge(m, src)
+}
+func (m *GetJobSetAnalyticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJobSetAnalyticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobSetAnalyticsResponse.DiscardUnknown(m)
 }
 
-func (m *GetJobsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+var xxx_messageInfo_GetJobSetAnalyticsResponse proto.InternalMessageInfo
+
+func (m *GetJobSetAnalyticsResponse) GetJobSets() []*JobSetAnalytics {
+	if m != nil {
+		return m.JobSets
 	}
-	if m.NewestFirst {
-		n += 2
+	return nil
+}
+
+func (m *GetJobSetAnalyticsResponse) GetBaseline() string {
+	if m != nil {
+		return m.Baseline
 	}
-	if len(m.JobStates) > 0 {
-		for _, s := range m.JobStates {
-			l = len(s)
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	return ""
+}
+
+func (m *GetJobSetAnalyticsResponse) GetComparisons() []*JobSetComparison {
+	if m != nil {
+		return m.Comparisons
 	}
-	if len(m.JobSetIds) > 0 {
-		for _, s := range m.JobSetIds {
-			l = len(s)
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SystemOverview)(nil), "lookout.SystemOverview")
+	proto.RegisterType((*JobInfo)(nil), "lookout.JobInfo")
+	proto.RegisterType((*RunInfo)(nil), "lookout.RunInfo")
+	proto.RegisterType((*ContainerInfo)(nil), "lookout.ContainerInfo")
+	proto.RegisterType((*JobEventInfo)(nil), "lookout.JobEventInfo")
+	proto.RegisterType((*QueueInfo)(nil), "lookout.QueueInfo")
+	proto.RegisterType((*JobSetInfo)(nil), "lookout.JobSetInfo")
+	proto.RegisterType((*DurationStats)(nil), "lookout.DurationStats")
+	proto.RegisterType((*GetJobSetsRequest)(nil), "lookout.GetJobSetsRequest")
+	proto.RegisterType((*GetJobSetsResponse)(nil), "lookout.GetJobSetsResponse")
+	proto.RegisterType((*GetJobsRequest)(nil), "lookout.GetJobsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "lookout.GetJobsRequest.UserAnnotationsEntry")
+	proto.RegisterType((*TimeRange)(nil), "lookout.TimeRange")
+	proto.RegisterType((*PriorityRange)(nil), "lookout.PriorityRange")
+	proto.RegisterType((*GetJobsResponse)(nil), "lookout.GetJobsResponse")
+	proto.RegisterType((*GetJobDetailsRequest)(nil), "lookout.GetJobDetailsRequest")
+	proto.RegisterType((*GetJobDetailsResponse)(nil), "lookout.GetJobDetailsResponse")
+	proto.RegisterType((*GetUsageRequest)(nil), "lookout.GetUsageRequest")
+	proto.RegisterType((*UsageInfo)(nil), "lookout.UsageInfo")
+	proto.RegisterMapType((map[string]float64)(nil), "lookout.UsageInfo.CostEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "lookout.UsageInfo.RequestedResourceHoursEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "lookout.UsageInfo.UsedResourceHoursEntry")
+	proto.RegisterType((*GetUsageResponse)(nil), "lookout.GetUsageResponse")
+	proto.RegisterType((*GetQueueHistoryRequest)(nil), "lookout.GetQueueHistoryRequest")
+	proto.RegisterType((*QueueHistoryPoint)(nil), "lookout.QueueHistoryPoint")
+	proto.RegisterType((*QueueHistory)(nil), "lookout.QueueHistory")
+	proto.RegisterType((*GetQueueHistoryResponse)(nil), "lookout.GetQueueHistoryResponse")
+	proto.RegisterType((*GetFailureClustersRequest)(nil), "lookout.GetFailureClustersRequest")
+	proto.RegisterType((*FailureCluster)(nil), "lookout.FailureCluster")
+	proto.RegisterType((*GetFailureClustersResponse)(nil), "lookout.GetFailureClustersResponse")
+	proto.RegisterType((*GetJobSetAnalyticsRequest)(nil), "lookout.GetJobSetAnalyticsRequest")
+	proto.RegisterType((*HistogramBucket)(nil), "lookout.HistogramBucket")
+	proto.RegisterType((*DurationDistribution)(nil), "lookout.DurationDistribution")
+	proto.RegisterType((*ClusterAnalytics)(nil), "lookout.ClusterAnalytics")
+	proto.RegisterType((*OutcomePoint)(nil), "lookout.OutcomePoint")
+	proto.RegisterType((*JobSetAnalytics)(nil), "lookout.JobSetAnalytics")
+	proto.RegisterType((*JobSetComparison)(nil), "lookout.JobSetComparison")
+	proto.RegisterType((*GetJobSetAnalyticsResponse)(nil), "lookout.GetJobSetAnalyticsResponse")
+}
+
+func init() { proto.RegisterFile("pkg/api/lookout/lookout.proto", fileDescriptor_6ee7620a6fb9cfb1) }
+
+var fileDescriptor_6ee7620a6fb9cfb1 = []byte{
+	// 3016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x11, 0xd6, 0xec, 0x7b, 0x8b, 0x5c, 0x3e, 0x9a, 0x22, 0x35, 0x5a, 0x49, 0x14, 0x35, 0x8a, 0x6d,
+	0xd9, 0x96, 0x48, 0x89, 0xb2, 0x6c, 0x51, 0x31, 0x02, 0x9b, 0x92, 0x6c, 0x49, 0x79, 0x48, 0x19,
+	0x59, 0x79, 0x1c, 0x8c, 0xc1, 0xec, 0x6e, 0x73, 0x39, 0xe4, 0xee, 0xf4, 0x6a, 0x7a, 0x46, 0xe2,
+	0xc2, 0x30, 0x10, 0xf8, 0x96, 0xe4, 0x62, 0x20, 0xc8, 0x2d, 0x08, 0x72, 0x08, 0x10, 0x20, 0x01,
+	0x82, 0x5c, 0x02, 0x24, 0xe7, 0x1c, 0xe2, 0x5b, 0x0c, 0xf8, 0xe2, 0x5c, 0x9c, 0x44, 0xf6, 0x4f,
+	0xc8, 0x0f, 0x08, 0xaa, 0x1f, 0xf3, 0xda, 0x5d, 0xad, 0x68, 0x5d, 0x72, 0xe2, 0x74, 0xf5, 0x57,
+	0x8f, 0xee, 0xaa, 0xae, 0xae, 0xae, 0x25, 0x9c, 0x1a, 0xec, 0x77, 0x37, 0xdc, 0x81, 0xb7, 0xd1,
+	0x63, 0x6c, 0x9f, 0x45, 0xa1, 0xfe, 0xbb, 0x3e, 0x08, 0x58, 0xc8, 0x48, 0x55, 0x0d, 0x9b, 0xa7,
+	0xbb, 0x8c, 0x75, 0x7b, 0x74, 0x43, 0x90, 0x5b, 0xd1, 0xce, 0x46, 0xe8, 0xf5, 0x29, 0x0f, 0xdd,
+	0xfe, 0x40, 0x22, 0x9b, 0xab, 0x79, 0x40, 0x27, 0x0a, 0xdc, 0xd0, 0x63, 0xbe, 0x9a, 0x3f, 0x91,
+	0x9f, 0xa7, 0xfd, 0x41, 0x38, 0x9c, 0xc4, 0xfc, 0x38, 0x70, 0x07, 0x03, 0x1a, 0x70, 0x35, 0x7f,
+	0x52, 0xcd, 0xa3, 0xa1, 0xae, 0xef, 0xb3, 0x50, 0x48, 0xd6, 0xb3, 0x17, 0xba, 0x5e, 0xb8, 0x1b,
+	0xb5, 0xd6, 0xdb, 0xac, 0xbf, 0xd1, 0x65, 0x5d, 0x96, 0x88, 0xc1, 0x91, 0x18, 0x88, 0x2f, 0x05,
+	0x5f, 0xd2, 0x4b, 0x7e, 0x18, 0xd1, 0x88, 0x4a, 0xa2, 0xf5, 0x26, 0xcc, 0xdd, 0x1f, 0xf2, 0x90,
+	0xf6, 0xef, 0x3e, 0xa2, 0xc1, 0x23, 0x8f, 0x3e, 0x26, 0xaf, 0x40, 0x45, 0x00, 0xb8, 0x69, 0xac,
+	0x15, 0xcf, 0xcd, 0x6c, 0x92, 0x75, 0xbd, 0x35, 0xdf, 0x47, 0xf2, 0x6d, 0x7f, 0x87, 0xd9, 0x0a,
+	0x61, 0xfd, 0xdd, 0x80, 0xea, 0x1d, 0xd6, 0x42, 0x1a, 0x69, 0x42, 0x71, 0x8f, 0xb5, 0x4c, 0x63,
+	0xcd, 0x38, 0x37, 0xb3, 0x59, 0x5b, 0x77, 0x07, 0xde, 0xfa, 0x1d, 0xd6, 0xb2, 0x91, 0x48, 0xbe,
+	0x01, 0xa5, 0x20, 0xf2, 0xb9, 0x59, 0x10, 0x12, 0x17, 0x62, 0x89, 0x76, 0xe4, 0x0b, 0x79, 0x62,
+	0x96, 0x6c, 0x43, 0xbd, 0xed, 0xfa, 0x6d, 0xda, 0xeb, 0xd1, 0x8e, 0x59, 0x14, 0x72, 0x9a, 0xeb,
+	0x72, 0x07, 0xd6, 0xf5, 0xd2, 0xd6, 0xdf, 0xd3, 0xfb, 0xbf, 0x5d, 0xfb, 0xe4, 0x8b, 0xd3, 0xc6,
+	0xc7, 0xff, 0x3a, 0x6d, 0xd8, 0x09, 0x1b, 0x39, 0x01, 0xf5, 0x3d, 0xd6, 0x72, 0x78, 0xe8, 0x86,
+	0xd4, 0x2c, 0xad, 0x19, 0xe7, 0xea, 0x76, 0x6d, 0x8f, 0xb5, 0xee, 0xe3, 0x98, 0x1c, 0x07, 0xfc,
+	0x76, 0xf6, 0x38, 0xf3, 0xcd, 0xb2, 0x98, 0xab, 0xee, 0xb1, 0xd6, 0x1d, 0xce, 0x7c, 0xeb, 0x8f,
+	0x45, 0xa8, 0x2a, 0x6b, 0xc8, 0x32, 0x54, 0xf6, 0xaf, 0x72, 0xc7, 0xeb, 0x88, 0xc5, 0xd4, 0xed,
+	0xf2, 0xfe, 0x55, 0x7e, 0xbb, 0x43, 0x4c, 0xa8, 0xb6, 0x7b, 0x11, 0x0f, 0x69, 0x60, 0x16, 0x24,
+	0xb3, 0x1a, 0x12, 0x02, 0x25, 0x9f, 0x75, 0xa8, 0xb0, 0xb9, 0x6e, 0x8b, 0x6f, 0x72, 0x12, 0xea,
+	0x3c, 0x6a, 0xb7, 0x29, 0xed, 0xd0, 0x8e, 0x30, 0xa4, 0x66, 0x27, 0x04, 0x72, 0x14, 0xca, 0x34,
+	0x08, 0x58, 0xa0, 0xcc, 0x90, 0x03, 0xf2, 0x2d, 0xa8, 0xb6, 0x03, 0xea, 0x86, 0xb4, 0x63, 0x56,
+	0x0e, 0xb1, 0x7c, 0xcd, 0x84, 0xfc, 0x3c, 0x74, 0x03, 0xe4, 0xaf, 0x1e, 0x86, 0x5f, 0x31, 0x91,
+	0xb7, 0xa0, 0xb6, 0xe3, 0xf9, 0x1e, 0xdf, 0xa5, 0x1d, 0xb3, 0x76, 0x08, 0x01, 0x31, 0x17, 0x39,
+	0x05, 0x30, 0x60, 0x1d, 0xc7, 0x8f, 0xfa, 0x2d, 0x1a, 0x98, 0xf5, 0x35, 0xe3, 0x5c, 0xd9, 0xae,
+	0x0f, 0x58, 0xe7, 0x7b, 0x82, 0x80, 0xde, 0x09, 0x22, 0x5f, 0x79, 0x07, 0xa4, 0x77, 0x82, 0xc8,
+	0x97, 0xde, 0x39, 0x0f, 0x24, 0xf2, 0xdd, 0x56, 0x8f, 0x3a, 0x21, 0x73, 0x78, 0x7b, 0x97, 0x76,
+	0xa2, 0x1e, 0x35, 0x67, 0xc4, 0xd6, 0x2d, 0xc8, 0x99, 0xf7, 0xd8, 0x7d, 0x45, 0xb7, 0x0e, 0xa0,
+	0x71, 0x9d, 0xf9, 0xa1, 0xeb, 0xf9, 0x34, 0xd0, 0x5e, 0x43, 0xd9, 0x89, 0xd7, 0x82, 0xc8, 0xbf,
+	0xdd, 0x11, 0xbe, 0x71, 0xfb, 0x54, 0xb9, 0x4c, 0x7c, 0xa3, 0x19, 0xf4, 0xc0, 0x0b, 0x9d, 0xb6,
+	0x76, 0x5a, 0xd9, 0xae, 0x21, 0xe1, 0x3a, 0x3a, 0xee, 0x34, 0xcc, 0xf4, 0x58, 0xd7, 0xa1, 0x07,
+	0x6d, 0x1a, 0x0c, 0x42, 0x15, 0x43, 0xd0, 0x63, 0xdd, 0x9b, 0x92, 0x62, 0xfd, 0xac, 0x00, 0xb3,
+	0x77, 0x58, 0xeb, 0xe6, 0x23, 0xea, 0x87, 0x42, 0x73, 0xca, 0x6d, 0xc6, 0x33, 0xed, 0xda, 0x91,
+	0xac, 0xdb, 0x08, 0x94, 0xc2, 0xe1, 0x20, 0x36, 0x11, 0xbf, 0xd3, 0xc1, 0x56, 0xcc, 0x06, 0xdb,
+	0x59, 0x68, 0xec, 0x47, 0x2d, 0x1a, 0xf8, 0x34, 0xa4, 0x22, 0x48, 0xa5, 0x85, 0xb3, 0x09, 0xf1,
+	0x76, 0xde, 0x0f, 0xe5, 0xbc, 0x1f, 0x74, 0xc0, 0x56, 0x52, 0x01, 0xbb, 0x02, 0x95, 0x80, 0xba,
+	0x78, 0x34, 0xaa, 0x82, 0xaa, 0x46, 0x18, 0xc8, 0x01, 0x7d, 0x18, 0x51, 0x1e, 0xb2, 0x40, 0x44,
+	0x45, 0xdd, 0x4e, 0x08, 0x78, 0x6e, 0xea, 0x71, 0x5e, 0xc0, 0xb0, 0x16, 0x99, 0x41, 0xbb, 0x40,
+	0x0c, 0x70, 0x47, 0xf7, 0x58, 0x8b, 0x3b, 0x62, 0xd4, 0x11, 0xcb, 0x6c, 0xd8, 0x80, 0x24, 0xc1,
+	0xd9, 0x21, 0x67, 0x60, 0x56, 0x00, 0x06, 0xd4, 0xef, 0x78, 0x7e, 0x57, 0xac, 0xb8, 0x61, 0x0b,
+	0xa6, 0x7b, 0x92, 0x14, 0x43, 0x82, 0xc8, 0xf7, 0x11, 0x52, 0x4a, 0x20, 0xb6, 0x24, 0x91, 0x37,
+	0x61, 0x91, 0xf5, 0x3a, 0x94, 0x87, 0x4a, 0x91, 0x83, 0xe9, 0xa8, 0xbc, 0x66, 0x64, 0x32, 0x8e,
+	0xca, 0x56, 0xf6, 0xbc, 0x84, 0x4a, 0x03, 0xee, 0xb0, 0x16, 0x79, 0x0b, 0x96, 0x7a, 0xcc, 0xef,
+	0x22, 0xbb, 0xd2, 0x21, 0xf8, 0x2b, 0x13, 0xf8, 0x17, 0x15, 0x58, 0x29, 0x47, 0x09, 0x77, 0x61,
+	0x25, 0xab, 0x5f, 0xdf, 0x04, 0xea, 0x30, 0x1e, 0x1f, 0x89, 0x8a, 0x1b, 0x0a, 0x60, 0x1f, 0x4d,
+	0x5b, 0xa3, 0xa9, 0xe4, 0x3e, 0x98, 0x79, 0x93, 0x62, 0x91, 0xb5, 0x69, 0x22, 0x57, 0xb2, 0x06,
+	0x6a, 0xba, 0xf5, 0xdb, 0x22, 0xc0, 0x1d, 0xd6, 0xba, 0x4f, 0xc3, 0xa7, 0x78, 0xec, 0x18, 0x54,
+	0x45, 0x16, 0xa5, 0xa1, 0x0a, 0xca, 0xca, 0x9e, 0x60, 0xc9, 0xbb, 0xb2, 0x38, 0xd5, 0x95, 0xa5,
+	0xe9, 0xae, 0x2c, 0x8f, 0xba, 0xf2, 0x05, 0x98, 0x13, 0x90, 0x24, 0x83, 0x56, 0x04, 0xa8, 0x81,
+	0xd4, 0xfb, 0x9a, 0x18, 0x5b, 0xb3, 0xe3, 0x7a, 0x3d, 0x95, 0xf3, 0x94, 0x35, 0xef, 0x08, 0x0a,
+	0xb9, 0x06, 0xb3, 0x4a, 0x0b, 0xa6, 0x18, 0xae, 0x76, 0x6d, 0x25, 0xf6, 0xa6, 0xde, 0x15, 0x31,
+	0x6b, 0x67, 0xb0, 0xe4, 0x2a, 0xcc, 0xc8, 0x55, 0x4a, 0xd6, 0xfa, 0x53, 0x59, 0xd3, 0x50, 0xbc,
+	0xc7, 0x78, 0xd4, 0xea, 0x7b, 0x21, 0x66, 0x04, 0x38, 0xcc, 0x3d, 0x16, 0xb3, 0x59, 0x7f, 0x2d,
+	0x40, 0x23, 0xa3, 0x82, 0x5c, 0x81, 0x1a, 0xdf, 0x65, 0x41, 0x48, 0x79, 0x68, 0x1a, 0xd3, 0xbc,
+	0x1f, 0x43, 0xc9, 0x65, 0xa8, 0xaa, 0x48, 0x30, 0x0b, 0xd3, 0xb8, 0x34, 0x12, 0x99, 0xdc, 0x47,
+	0x34, 0x70, 0xbb, 0xd4, 0x2c, 0x4e, 0x65, 0x52, 0x48, 0x72, 0x09, 0x2a, 0x7d, 0xda, 0xf1, 0x5c,
+	0xdf, 0x2c, 0x4d, 0xe3, 0x51, 0x40, 0xf2, 0x32, 0x14, 0x1e, 0x5e, 0x32, 0xcb, 0xd3, 0xe0, 0x85,
+	0x87, 0x97, 0x04, 0xf4, 0xb2, 0x59, 0x99, 0x0e, 0xbd, 0x6c, 0xf5, 0x61, 0xf1, 0x5d, 0x1a, 0xca,
+	0x20, 0xe7, 0xb6, 0x4c, 0x55, 0x13, 0x02, 0xfd, 0x0c, 0xcc, 0xfa, 0xf4, 0x31, 0x9e, 0xb0, 0x1d,
+	0x2f, 0x50, 0x5b, 0x54, 0xb3, 0x67, 0x24, 0xed, 0x1d, 0x24, 0x61, 0x90, 0xb9, 0xed, 0xd0, 0x7b,
+	0x44, 0x1d, 0xe6, 0xf7, 0x86, 0x62, 0x3f, 0x6a, 0x36, 0x48, 0xd2, 0x5d, 0xbf, 0x37, 0xb4, 0xbe,
+	0x0b, 0x24, 0xad, 0x8e, 0x0f, 0x98, 0xcf, 0x29, 0x79, 0x03, 0x1a, 0xea, 0x08, 0x39, 0x9e, 0xbf,
+	0xc3, 0x74, 0x35, 0xb5, 0x94, 0xce, 0x24, 0xea, 0x10, 0x8a, 0xd8, 0x57, 0xdf, 0xdc, 0xfa, 0xaa,
+	0x0c, 0x73, 0x52, 0xde, 0xf3, 0xdb, 0x7e, 0x0a, 0x20, 0xae, 0x86, 0xb8, 0x59, 0x5c, 0x2b, 0x62,
+	0xf2, 0xd6, 0xe5, 0x10, 0x27, 0xab, 0x30, 0x13, 0xdb, 0xd8, 0xe1, 0x66, 0x29, 0x99, 0xa7, 0xe1,
+	0xed, 0x0e, 0x17, 0x17, 0x93, 0xbb, 0x4f, 0xd5, 0x09, 0x15, 0xdf, 0x48, 0xe3, 0xfb, 0xde, 0x40,
+	0x1d, 0x48, 0xf1, 0x8d, 0xf6, 0xed, 0xb1, 0xd6, 0xed, 0x8e, 0xba, 0x39, 0xe4, 0x00, 0xa9, 0xec,
+	0xb1, 0x4f, 0xf5, 0xa5, 0x21, 0x07, 0xe4, 0x87, 0xb0, 0x10, 0x71, 0x1a, 0x38, 0xa9, 0x72, 0xd6,
+	0xac, 0x8b, 0xad, 0x39, 0x1f, 0x6f, 0x4d, 0x76, 0xf9, 0xeb, 0x0f, 0x38, 0x0d, 0xde, 0x4e, 0xe0,
+	0x37, 0xfd, 0x30, 0x18, 0xda, 0xf3, 0x51, 0x96, 0x4a, 0x2e, 0x8e, 0x9e, 0xba, 0xa4, 0x74, 0xc5,
+	0xd3, 0x66, 0xbb, 0x7e, 0x97, 0xa6, 0xce, 0x18, 0x39, 0x9f, 0x94, 0x4b, 0x33, 0x13, 0xf1, 0x1a,
+	0x42, 0xd6, 0x53, 0xc5, 0xd1, 0xec, 0x44, 0x78, 0x8c, 0x49, 0xdf, 0xe0, 0x8d, 0xf1, 0xe5, 0xe2,
+	0x5c, 0xea, 0xf6, 0xdd, 0x84, 0xda, 0x20, 0xf0, 0x58, 0xe0, 0x85, 0x43, 0x73, 0x3e, 0x97, 0x6a,
+	0xee, 0xa9, 0x09, 0xa5, 0x41, 0xe3, 0xd0, 0xbb, 0x71, 0x19, 0xc3, 0xcd, 0x85, 0xb5, 0x22, 0x5e,
+	0xf2, 0xba, 0x8e, 0xe1, 0x98, 0x44, 0x45, 0x59, 0xe9, 0xb4, 0x65, 0x9d, 0xc4, 0xcd, 0x45, 0xa1,
+	0xb0, 0x21, 0xa8, 0xaa, 0x78, 0xe2, 0x58, 0x14, 0xb3, 0xa0, 0x43, 0x03, 0xa7, 0x35, 0x34, 0x89,
+	0x34, 0x54, 0x8c, 0xb7, 0x87, 0x58, 0x12, 0xb4, 0xa3, 0x80, 0xb3, 0xc0, 0x5c, 0x92, 0xb7, 0x80,
+	0x1c, 0x35, 0xb7, 0xe1, 0xe8, 0x38, 0x9f, 0x90, 0x05, 0x28, 0xee, 0xd3, 0xa1, 0x8a, 0x52, 0xfc,
+	0xc4, 0x18, 0x78, 0xe4, 0xf6, 0x22, 0x5d, 0xdb, 0xc8, 0xc1, 0xb5, 0xc2, 0x55, 0xc3, 0xfa, 0x00,
+	0xea, 0xf1, 0xae, 0x91, 0xab, 0x50, 0xda, 0x09, 0x58, 0xdf, 0x34, 0x0e, 0x91, 0x2c, 0x05, 0x07,
+	0x79, 0x0d, 0x0a, 0x21, 0x33, 0x0b, 0x87, 0xe0, 0x2b, 0x84, 0xcc, 0xfa, 0x00, 0x1a, 0x99, 0x4d,
+	0x25, 0xaf, 0x41, 0xb1, 0xef, 0xf9, 0x4a, 0xff, 0xc9, 0xd1, 0xf4, 0xc2, 0xa2, 0x56, 0x8f, 0xfe,
+	0x00, 0x8d, 0xdf, 0x2e, 0xfd, 0x06, 0xa5, 0x20, 0x5c, 0x70, 0xb9, 0x07, 0x66, 0xe1, 0x10, 0x5c,
+	0xee, 0x81, 0xe5, 0xc2, 0x7c, 0x1c, 0xe0, 0x2a, 0x59, 0x5c, 0x90, 0xaf, 0x96, 0x74, 0xa2, 0x18,
+	0x2d, 0x39, 0x6a, 0x7b, 0xf2, 0x83, 0x63, 0x4a, 0xf2, 0xe9, 0x41, 0xe8, 0x28, 0xe7, 0xc8, 0xbd,
+	0x05, 0x24, 0x5d, 0x17, 0x14, 0xeb, 0x02, 0x1c, 0x95, 0x2a, 0x6e, 0xd0, 0xd0, 0xf5, 0x7a, 0x71,
+	0x22, 0x59, 0x86, 0x8a, 0xd0, 0x13, 0xd7, 0xc8, 0xe2, 0xa4, 0x5a, 0x7f, 0x30, 0x60, 0x39, 0x87,
+	0x57, 0x86, 0xbd, 0x0a, 0x35, 0x6d, 0x98, 0xda, 0x9c, 0x51, 0xbb, 0xaa, 0xca, 0x2e, 0xf2, 0x3a,
+	0x40, 0x5b, 0x97, 0xe4, 0xfa, 0xad, 0x97, 0x44, 0x71, 0xa6, 0x5a, 0xb7, 0x53, 0x48, 0x72, 0x01,
+	0x2a, 0x14, 0x8b, 0x69, 0x99, 0xa1, 0x66, 0x36, 0x97, 0xd3, 0x2a, 0xe2, 0x32, 0xdb, 0x56, 0x20,
+	0xeb, 0x6f, 0x86, 0xd8, 0xc0, 0x07, 0xdc, 0xed, 0x52, 0xbd, 0xb0, 0xc3, 0x05, 0xd0, 0x91, 0xaf,
+	0x19, 0x40, 0x47, 0x74, 0x00, 0xe1, 0xa1, 0xe9, 0x06, 0x2c, 0x1a, 0x38, 0x2d, 0x79, 0x23, 0xd4,
+	0xed, 0xaa, 0x18, 0x6f, 0x0f, 0xf1, 0xd8, 0x25, 0xb9, 0xcd, 0xc1, 0xf3, 0x20, 0x0b, 0xf4, 0x46,
+	0x42, 0xfd, 0x36, 0x1d, 0x5a, 0xbf, 0x2c, 0x41, 0x5d, 0x2c, 0x41, 0x97, 0x61, 0x82, 0x5f, 0xfb,
+	0x45, 0x0c, 0x30, 0x51, 0xa8, 0x67, 0xb3, 0xc8, 0xb5, 0xf8, 0x4d, 0x76, 0xc1, 0x54, 0xd5, 0x37,
+	0xed, 0x38, 0x01, 0xe5, 0x2c, 0x0a, 0xda, 0xd4, 0xd9, 0x65, 0x51, 0xa0, 0xb7, 0x6f, 0x3d, 0xde,
+	0xbe, 0x58, 0xfe, 0xba, 0xad, 0x59, 0x6c, 0xc5, 0x71, 0x0b, 0x19, 0x64, 0x26, 0x5d, 0x09, 0xc6,
+	0x4e, 0x92, 0x1f, 0xc3, 0x52, 0xc4, 0x47, 0x95, 0x94, 0x84, 0x92, 0x97, 0xc7, 0x28, 0x79, 0xc0,
+	0xc7, 0xca, 0x5f, 0x8c, 0xf2, 0x74, 0x72, 0x11, 0x4a, 0x6d, 0xc6, 0x43, 0xb3, 0x2c, 0x64, 0x9d,
+	0x1c, 0x23, 0xeb, 0x3a, 0xe3, 0xa1, 0x64, 0x17, 0x48, 0xcc, 0x75, 0x21, 0x0b, 0xdd, 0x9e, 0x23,
+	0xf8, 0xf0, 0xf2, 0x31, 0xec, 0xba, 0xa0, 0x20, 0xb2, 0x79, 0x1b, 0x4e, 0x3c, 0x65, 0x89, 0xd3,
+	0x12, 0x93, 0x91, 0x4a, 0x4c, 0xcd, 0x1b, 0xb0, 0xf2, 0x80, 0x3f, 0xb7, 0x94, 0x37, 0xa0, 0x1e,
+	0x2f, 0xe1, 0x30, 0x8c, 0xd6, 0x9b, 0xb0, 0x90, 0x04, 0xb7, 0x3a, 0x85, 0xe7, 0xa0, 0x1c, 0x21,
+	0x61, 0xa4, 0x23, 0x13, 0xef, 0x97, 0x2d, 0x01, 0xd6, 0x3f, 0x0d, 0x58, 0x79, 0x97, 0xca, 0x87,
+	0xc4, 0x2d, 0x0f, 0x5f, 0x68, 0xc3, 0xa7, 0x17, 0x11, 0xfa, 0xe0, 0x14, 0xbe, 0xe6, 0xc1, 0x29,
+	0x1e, 0xf2, 0xe0, 0x6c, 0x01, 0x60, 0x3c, 0xf5, 0x22, 0xf1, 0x8a, 0x99, 0x5a, 0x28, 0xa6, 0xc0,
+	0xd6, 0x3f, 0x0c, 0x58, 0x4c, 0x2f, 0xec, 0x1e, 0xf3, 0x7c, 0x71, 0xf2, 0xb1, 0x25, 0x77, 0xb8,
+	0x93, 0x8f, 0x1c, 0x78, 0xbb, 0xa5, 0x5e, 0xa4, 0x86, 0x6a, 0x6a, 0x89, 0x8b, 0x3b, 0xfd, 0x10,
+	0x35, 0x6c, 0x3d, 0xc4, 0x99, 0xf4, 0xfb, 0xd3, 0xb0, 0xf5, 0x30, 0xdb, 0xed, 0x91, 0xe5, 0x52,
+	0x42, 0x40, 0x4d, 0xea, 0x89, 0x22, 0xab, 0x26, 0x35, 0xb2, 0x7e, 0x04, 0xb3, 0xe9, 0x05, 0x4d,
+	0x70, 0xd1, 0x26, 0x54, 0x06, 0xb8, 0x54, 0x9d, 0x52, 0x9b, 0xd9, 0x86, 0x5c, 0x7a, 0x37, 0x6c,
+	0x85, 0xb4, 0x6e, 0xc1, 0xb1, 0x91, 0x30, 0x88, 0xef, 0x9a, 0x6c, 0x7f, 0x6f, 0x79, 0xac, 0xb8,
+	0xb8, 0xc5, 0xf7, 0x67, 0x03, 0x8e, 0xbf, 0x4b, 0x43, 0x7c, 0x50, 0x45, 0x01, 0xbd, 0x2e, 0x4b,
+	0x18, 0xfe, 0xff, 0x15, 0x54, 0xba, 0x4e, 0x2d, 0x25, 0x75, 0xaa, 0xf5, 0xbb, 0x02, 0xcc, 0x65,
+	0x8d, 0x16, 0x4e, 0xf2, 0xba, 0xbe, 0x1b, 0x46, 0x81, 0x36, 0x38, 0x21, 0x64, 0x9b, 0x42, 0x85,
+	0x5c, 0x53, 0xe8, 0x28, 0x94, 0xdb, 0x6e, 0xc4, 0x75, 0x8b, 0x4f, 0x0e, 0x04, 0x95, 0x45, 0x7e,
+	0xa8, 0x14, 0xcb, 0x01, 0x52, 0xf9, 0xae, 0x1b, 0xc8, 0xb2, 0xd9, 0xb0, 0xe5, 0x00, 0xdb, 0x36,
+	0xf4, 0xc0, 0xed, 0x0f, 0x7a, 0xd4, 0x91, 0x9d, 0x3f, 0xd9, 0x7b, 0x99, 0x55, 0xc4, 0x9b, 0x48,
+	0x23, 0x2f, 0xc2, 0xbc, 0x06, 0xc9, 0x7b, 0x9a, 0x9b, 0x55, 0x51, 0x94, 0x6b, 0x5e, 0xbc, 0x73,
+	0x3b, 0x5c, 0x37, 0x32, 0x39, 0x15, 0x6f, 0xda, 0xa2, 0x6a, 0x64, 0xe2, 0xfb, 0x83, 0x34, 0xa1,
+	0xa6, 0xea, 0x4c, 0x59, 0x57, 0xd7, 0xed, 0x78, 0x8c, 0x96, 0xf9, 0xa2, 0x56, 0x04, 0x31, 0x21,
+	0x07, 0xd6, 0x01, 0x34, 0xc7, 0x39, 0x58, 0x85, 0xcb, 0xe5, 0x94, 0x3c, 0x19, 0x30, 0xc7, 0xe2,
+	0x80, 0xc9, 0xf2, 0xa4, 0x14, 0xbd, 0x00, 0x73, 0x32, 0x5b, 0xef, 0x48, 0x84, 0xbe, 0xc2, 0x1a,
+	0x82, 0xaa, 0xd8, 0xb8, 0xf5, 0x99, 0x8c, 0x2d, 0xf9, 0x12, 0x7a, 0xdb, 0x77, 0x7b, 0xc3, 0xd0,
+	0x6b, 0x4f, 0x89, 0xad, 0xdc, 0x9b, 0xa5, 0x90, 0x7f, 0xb3, 0xbc, 0x0a, 0x8b, 0xbb, 0x18, 0xc2,
+	0xdd, 0xc0, 0xed, 0x3b, 0xad, 0xa8, 0xbd, 0x4f, 0x45, 0x5d, 0x81, 0xda, 0x17, 0xe2, 0x89, 0x6d,
+	0x49, 0x7f, 0x8e, 0x6c, 0x24, 0xca, 0x7b, 0xd6, 0x1f, 0x68, 0x3f, 0xd7, 0x6c, 0x3d, 0xb4, 0x7e,
+	0x6a, 0xc0, 0xfc, 0xad, 0xac, 0x26, 0xb2, 0x01, 0xe5, 0x1e, 0x7b, 0x4c, 0x83, 0xe9, 0x2f, 0x77,
+	0x89, 0x43, 0x86, 0x08, 0x7f, 0x09, 0x98, 0xfe, 0x68, 0x97, 0xb8, 0x24, 0x16, 0x8b, 0xa9, 0x58,
+	0xb4, 0xfe, 0x5b, 0x80, 0xa3, 0x1a, 0x79, 0xc3, 0xe3, 0x61, 0xe0, 0xb5, 0xa4, 0xf9, 0x31, 0xdc,
+	0x48, 0x87, 0xee, 0xab, 0xb2, 0x0c, 0x9e, 0xaa, 0x13, 0x51, 0x02, 0xec, 0x1e, 0x4c, 0x6f, 0x10,
+	0x20, 0x8a, 0x5c, 0x80, 0x52, 0x9f, 0x3e, 0x4b, 0x6b, 0x40, 0xc0, 0x50, 0xf6, 0xe0, 0xca, 0xc5,
+	0xe9, 0x9d, 0x01, 0x44, 0x09, 0xf0, 0xd6, 0xc5, 0xe9, 0xbd, 0x01, 0x44, 0x49, 0xf0, 0xd6, 0xf4,
+	0x96, 0x1c, 0xa2, 0xc8, 0xeb, 0x50, 0x8f, 0x63, 0x46, 0x1c, 0xb4, 0x99, 0x4d, 0x33, 0x8e, 0xfe,
+	0x9c, 0x8f, 0xed, 0x04, 0x6a, 0xfd, 0xc9, 0x80, 0x05, 0x75, 0x2a, 0xe2, 0xb0, 0x4e, 0x3f, 0x08,
+	0x8d, 0x91, 0x07, 0xe1, 0x48, 0x9d, 0x97, 0xb9, 0x51, 0x8a, 0x93, 0x6f, 0x94, 0x52, 0xfa, 0x46,
+	0x21, 0x57, 0x01, 0xfb, 0xe9, 0x8e, 0xb8, 0x11, 0xe5, 0xe6, 0x9d, 0x1a, 0xe9, 0x58, 0xa5, 0xe3,
+	0x40, 0xdc, 0x60, 0x98, 0x56, 0xad, 0x5f, 0x19, 0x30, 0x7b, 0x37, 0x0a, 0xdb, 0xac, 0x4f, 0x9f,
+	0xf7, 0x62, 0xcd, 0x98, 0x5e, 0x98, 0x6c, 0x7a, 0x31, 0x63, 0xfa, 0xc9, 0xf4, 0xaf, 0x3f, 0x72,
+	0x55, 0x09, 0xc1, 0xfa, 0x4b, 0x09, 0xe6, 0x73, 0x79, 0xe2, 0xb0, 0xbd, 0x4b, 0x02, 0x25, 0x6c,
+	0x0d, 0x2a, 0xb5, 0xe2, 0x7b, 0xf4, 0x57, 0x9a, 0x09, 0xa6, 0x96, 0x27, 0x9b, 0x5a, 0xc9, 0x99,
+	0x8a, 0x99, 0x5e, 0x88, 0xe0, 0xdc, 0x11, 0x1b, 0x2e, 0x62, 0xcd, 0xb0, 0x67, 0x15, 0xd1, 0x46,
+	0x1a, 0x82, 0x54, 0x6e, 0x54, 0xa0, 0x9a, 0x04, 0x29, 0xa2, 0x04, 0xbd, 0x04, 0xf3, 0xb1, 0x58,
+	0x05, 0xab, 0x0b, 0xd8, 0x5c, 0x4c, 0x96, 0x40, 0x2c, 0x4c, 0x68, 0x18, 0x78, 0x22, 0xb5, 0xa3,
+	0x39, 0x7a, 0x98, 0x34, 0x5b, 0xc5, 0x58, 0x36, 0x3a, 0x74, 0xb3, 0x55, 0x92, 0xc8, 0x35, 0xa8,
+	0x3f, 0x76, 0xbd, 0x50, 0x06, 0xcd, 0xec, 0xb3, 0x04, 0x4d, 0x0d, 0xf1, 0xe8, 0xff, 0x4c, 0xbc,
+	0x35, 0x0e, 0x13, 0x6f, 0xd8, 0xce, 0x8c, 0xef, 0x95, 0x39, 0x71, 0xb2, 0x8e, 0x27, 0x4f, 0xc5,
+	0xdc, 0xd1, 0x49, 0xdd, 0x2c, 0x97, 0xa0, 0xc6, 0x64, 0x94, 0x72, 0x73, 0x3e, 0x57, 0xbf, 0xa4,
+	0xc3, 0xd7, 0x8e, 0x61, 0xd6, 0x17, 0x25, 0x58, 0x90, 0xa1, 0x73, 0x5d, 0x64, 0x68, 0x0f, 0x7f,
+	0xd5, 0x48, 0x45, 0x89, 0x91, 0x89, 0x92, 0x75, 0x58, 0xca, 0x78, 0xcf, 0xe9, 0xd0, 0x5e, 0xe8,
+	0xaa, 0x12, 0x71, 0x31, 0xed, 0xc3, 0x1b, 0x38, 0x81, 0xf8, 0x8c, 0x23, 0x15, 0x5e, 0x56, 0x8e,
+	0x8b, 0x69, 0x77, 0x4a, 0xfc, 0x26, 0x2c, 0xe7, 0x7c, 0xaa, 0x38, 0x64, 0x45, 0xb9, 0x94, 0xf5,
+	0xac, 0xe4, 0xb9, 0x04, 0xcb, 0xca, 0x9f, 0xce, 0x80, 0x06, 0xa2, 0x34, 0x90, 0x3c, 0xb2, 0xc2,
+	0x20, 0x6a, 0xf2, 0x1e, 0x0d, 0xc4, 0x13, 0x1e, 0x59, 0x6e, 0xc1, 0x52, 0xec, 0x54, 0x67, 0x70,
+	0xe5, 0xa2, 0x62, 0x98, 0x9a, 0x23, 0x17, 0xb4, 0x6b, 0xef, 0x5d, 0xb9, 0x38, 0x4e, 0xd2, 0x96,
+	0x96, 0x54, 0x7d, 0x76, 0x49, 0x5b, 0x4a, 0xd2, 0x3b, 0x40, 0x74, 0xb0, 0xa4, 0x4c, 0x9a, 0xfa,
+	0x4b, 0xc6, 0xbc, 0x0a, 0x99, 0x7b, 0x57, 0xc6, 0xc9, 0x89, 0x0d, 0xaa, 0x3f, 0xb3, 0x9c, 0xb1,
+	0xf6, 0x6c, 0x6d, 0x29, 0x39, 0xf0, 0xec, 0x72, 0xb6, 0x84, 0x1c, 0xeb, 0xf7, 0x86, 0xa8, 0xa0,
+	0x46, 0xca, 0x98, 0xa4, 0x82, 0x8a, 0x8b, 0x35, 0x23, 0x77, 0x87, 0xe4, 0x79, 0xd2, 0x65, 0x5c,
+	0xcb, 0xe5, 0xb4, 0xe7, 0xf9, 0xba, 0x77, 0x16, 0x8f, 0xc9, 0x37, 0x61, 0xa6, 0x1d, 0x47, 0xb2,
+	0x7e, 0xf5, 0x1f, 0xcf, 0xc9, 0x4c, 0x62, 0xdd, 0x4e, 0xa3, 0x37, 0x7f, 0x5d, 0x85, 0xea, 0x77,
+	0x24, 0x92, 0xbc, 0x0f, 0xb5, 0xf8, 0x67, 0xff, 0x95, 0x91, 0x05, 0xdf, 0xc4, 0x7f, 0x54, 0x68,
+	0x26, 0xd5, 0x5e, 0xf6, 0xff, 0x04, 0xac, 0xb5, 0x8f, 0x3e, 0xfb, 0xea, 0x17, 0x85, 0x26, 0x31,
+	0xc5, 0xff, 0x14, 0x3c, 0xba, 0x14, 0xff, 0x27, 0x05, 0xd3, 0x22, 0x3d, 0x80, 0xa4, 0x31, 0x4e,
+	0x9a, 0xb9, 0xf6, 0x6e, 0xaa, 0x39, 0xdf, 0x3c, 0x31, 0x76, 0x4e, 0xee, 0x9f, 0x65, 0x09, 0x45,
+	0x27, 0xaf, 0x19, 0xaf, 0x58, 0xc7, 0xf2, 0xba, 0x30, 0x8f, 0xa1, 0xf0, 0xf7, 0xa1, 0x2a, 0x39,
+	0x39, 0x39, 0x36, 0xa1, 0x8d, 0xdc, 0x34, 0x47, 0x27, 0x94, 0x86, 0xd3, 0x42, 0xc3, 0x71, 0xeb,
+	0xe8, 0x38, 0xf1, 0xd7, 0x8c, 0x57, 0x48, 0x04, 0x8d, 0x4c, 0x7f, 0x8c, 0x9c, 0xca, 0xc9, 0xca,
+	0xf6, 0xd9, 0x9a, 0xab, 0x93, 0xa6, 0x95, 0xc2, 0x17, 0x84, 0xc2, 0xd3, 0xe4, 0xd4, 0x38, 0x85,
+	0x1b, 0x1f, 0xc8, 0xda, 0xff, 0x43, 0xe2, 0x42, 0x4d, 0xf7, 0x02, 0x48, 0xc6, 0xfa, 0x74, 0xef,
+	0xab, 0x79, 0x7c, 0xcc, 0x8c, 0xd2, 0xa3, 0x7c, 0x64, 0x2d, 0xe7, 0xf5, 0x88, 0x6e, 0x01, 0xae,
+	0xec, 0x43, 0xd1, 0x4b, 0xcb, 0xbc, 0x42, 0x4f, 0xa7, 0xe5, 0x8d, 0xe9, 0x24, 0x34, 0xd7, 0x26,
+	0x03, 0x94, 0xde, 0x97, 0x84, 0xde, 0x33, 0xe8, 0xb2, 0x93, 0x79, 0xd5, 0xe2, 0x96, 0xde, 0x55,
+	0xba, 0x3e, 0x32, 0xc4, 0x8f, 0x27, 0xb9, 0xc7, 0x07, 0xb1, 0xd2, 0x1a, 0xc6, 0x3f, 0x3d, 0x9b,
+	0x67, 0x9f, 0x8a, 0x51, 0x86, 0x9c, 0x15, 0x86, 0x9c, 0xb2, 0x46, 0x82, 0x54, 0x3f, 0x4c, 0x70,
+	0x0f, 0x7e, 0x6e, 0xa4, 0x7e, 0xc1, 0x49, 0xca, 0x0b, 0x6b, 0x34, 0x28, 0xf3, 0x6f, 0x94, 0xe6,
+	0xd9, 0xa7, 0x62, 0x94, 0x11, 0xe7, 0x85, 0x11, 0x2f, 0xe2, 0x6e, 0x9c, 0x99, 0x10, 0xc0, 0x1b,
+	0xae, 0xe6, 0xda, 0x5e, 0xfb, 0xfc, 0x3f, 0xab, 0x47, 0x7e, 0xf2, 0x64, 0xd5, 0xf8, 0xe4, 0xc9,
+	0xaa, 0xf1, 0xe9, 0x93, 0x55, 0xe3, 0xdf, 0x4f, 0x56, 0x8d, 0x8f, 0xbf, 0x5c, 0x3d, 0xf2, 0xe9,
+	0x97, 0xab, 0x47, 0x3e, 0xff, 0x72, 0xf5, 0x48, 0xab, 0x22, 0x8e, 0xe8, 0xe5, 0xff, 0x0d, 0x00,
+	0xf6, 0xe9, 0x8a, 0xa3, 0xc4, 0x24, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LookoutClient is the client API for Lookout service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LookoutClient interface {
+	Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error)
+	GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error)
+	GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error)
+	GetJobDetails(ctx context.Context, in *GetJobDetailsRequest, opts ...grpc.CallOption) (*GetJobDetailsResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	GetQueueHistory(ctx context.Context, in *GetQueueHistoryRequest, opts ...grpc.CallOption) (*GetQueueHistoryResponse, error)
+	GetFailureClusters(ctx context.Context, in *GetFailureClustersRequest, opts ...grpc.CallOption) (*GetFailureClustersResponse, error)
+	GetJobSetAnalytics(ctx context.Context, in *GetJobSetAnalyticsRequest, opts ...grpc.CallOption) (*GetJobSetAnalyticsResponse, error)
+}
+
+type lookoutClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLookoutClient(cc *grpc.ClientConn) LookoutClient {
+	return &lookoutClient{cc}
+}
+
+func (c *lookoutClient) Overview(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SystemOverview, error) {
+	out := new(SystemOverview)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/Overview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Take != 0 {
-		n += 1 + sovLookout(uint64(m.Take))
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobSets(ctx context.Context, in *GetJobSetsRequest, opts ...grpc.CallOption) (*GetJobSetsResponse, error) {
+	out := new(GetJobSetsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Skip != 0 {
-		n += 1 + sovLookout(uint64(m.Skip))
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobs(ctx context.Context, in *GetJobsRequest, opts ...grpc.CallOption) (*GetJobsResponse, error) {
+	out := new(GetJobsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobDetails(ctx context.Context, in *GetJobDetailsRequest, opts ...grpc.CallOption) (*GetJobDetailsResponse, error) {
+	out := new(GetJobDetailsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLookout(uint64(l))
+	return out, nil
+}
+
+func (c *lookoutClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UserAnnotations) > 0 {
-		for k, v := range m.UserAnnotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + len(v) + sovLookout(uint64(len(v)))
-			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *lookoutClient) GetQueueHistory(ctx context.Context, in *GetQueueHistoryRequest, opts ...grpc.CallOption) (*GetQueueHistoryResponse, error) {
+	out := new(GetQueueHistoryResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetQueueHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetJobsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *lookoutClient) GetFailureClusters(ctx context.Context, in *GetFailureClustersRequest, opts ...grpc.CallOption) (*GetFailureClustersResponse, error) {
+	out := new(GetFailureClustersResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetFailureClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.JobInfos) > 0 {
-		for _, e := range m.JobInfos {
-			l = e.Size()
-			n += 1 + l + sovLookout(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *lookoutClient) GetJobSetAnalytics(ctx context.Context, in *GetJobSetAnalyticsRequest, opts ...grpc.CallOption) (*GetJobSetAnalyticsResponse, error) {
+	out := new(GetJobSetAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/lookout.Lookout/GetJobSetAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovLookout(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// LookoutServer is the server API for Lookout service.
+type LookoutServer interface {
+	Overview(context.Context, *types.Empty) (*SystemOverview, error)
+	GetJobSets(context.Context, *GetJobSetsRequest) (*GetJobSetsResponse, error)
+	GetJobs(context.Context, *GetJobsRequest) (*GetJobsResponse, error)
+	GetJobDetails(context.Context, *GetJobDetailsRequest) (*GetJobDetailsResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	GetQueueHistory(context.Context, *GetQueueHistoryRequest) (*GetQueueHistoryResponse, error)
+	GetFailureClusters(context.Context, *GetFailureClustersRequest) (*GetFailureClustersResponse, error)
+	GetJobSetAnalytics(context.Context, *GetJobSetAnalyticsRequest) (*GetJobSetAnalyticsResponse, error)
 }
-func sozLookout(x uint64) (n int) {
-	return sovLookout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedLookoutServer can be embedded to have forward compatible implementations.
+type UnimplementedLookoutServer struct {
 }
-func (this *SystemOverview) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForQueues := "[]*QueueInfo{"
-	for _, f := range this.Queues {
-		repeatedStringForQueues += strings.Replace(f.String(), "QueueInfo", "QueueInfo", 1) + ","
-	}
-	repeatedStringForQueues += "}"
-	s := strings.Join([]string{`&SystemOverview{`,
-		`Queues:` + repeatedStringForQueues + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (*UnimplementedLookoutServer) Overview(ctx context.Context, req *types.Empty) (*SystemOverview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Overview not implemented")
 }
-func (this *JobInfo) String() string {
-	if this == nil {
-		return "nil"
+func (*UnimplementedLookoutServer) GetJobSets(ctx context.Context, req *GetJobSetsRequest) (*GetJobSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSets not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobs(ctx context.Context, req *GetJobsRequest) (*GetJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobDetails(ctx context.Context, req *GetJobDetailsRequest) (*GetJobDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobDetails not implemented")
+}
+func (*UnimplementedLookoutServer) GetUsage(ctx context.Context, req *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (*UnimplementedLookoutServer) GetQueueHistory(ctx context.Context, req *GetQueueHistoryRequest) (*GetQueueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueHistory not implemented")
+}
+func (*UnimplementedLookoutServer) GetFailureClusters(ctx context.Context, req *GetFailureClustersRequest) (*GetFailureClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailureClusters not implemented")
+}
+func (*UnimplementedLookoutServer) GetJobSetAnalytics(ctx context.Context, req *GetJobSetAnalyticsRequest) (*GetJobSetAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSetAnalytics not implemented")
+}
+
+func RegisterLookoutServer(s *grpc.Server, srv LookoutServer) {
+	s.RegisterService(&_Lookout_serviceDesc, srv)
+}
+
+func _Lookout_Overview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	repeatedStringForRuns := "[]*RunInfo{"
-	for _, f := range this.Runs {
-		repeatedStringForRuns += strings.Replace(f.String(), "RunInfo", "RunInfo", 1) + ","
+	if interceptor == nil {
+		return srv.(LookoutServer).Overview(ctx, in)
 	}
-	repeatedStringForRuns += "}"
-	s := strings.Join([]string{`&JobInfo{`,
-		`Job:` + strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "api.Job", 1) + `,`,
-		`Runs:` + repeatedStringForRuns + `,`,
-		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "Timestamp", "types.Timestamp", 1) + `,`,
-		`JobState:` + fmt.Sprintf("%v", this.JobState) + `,`,
-		`JobJson:` + fmt.Sprintf("%v", this.JobJson) + `,`,
-		`}`,
-	}, "")
-	return s
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/Overview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).Overview(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *RunInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetJobSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RunInfo{`,
-		`K8SId:` + fmt.Sprintf("%v", this.K8SId) + `,`,
-		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
-		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
-		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`Created:` + strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Started:` + strings.Replace(fmt.Sprintf("%v", this.Started), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Finished:` + strings.Replace(fmt.Sprintf("%v", this.Finished), "Timestamp", "types.Timestamp", 1) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`RunState:` + fmt.Sprintf("%v", this.RunState) + `,`,
-		`UnableToSchedule:` + fmt.Sprintf("%v", this.UnableToSchedule) + `,`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobSets(ctx, req.(*GetJobSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *QueueInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&QueueInfo{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
-		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
-		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
-		`OldestQueuedJob:` + strings.Replace(this.OldestQueuedJob.String(), "JobInfo", "JobInfo", 1) + `,`,
-		`LongestRunningJob:` + strings.Replace(this.LongestRunningJob.String(), "JobInfo", "JobInfo", 1) + `,`,
-		`OldestQueuedDuration:` + strings.Replace(fmt.Sprintf("%v", this.OldestQueuedDuration), "Duration", "types.Duration", 1) + `,`,
-		`LongestRunningDuration:` + strings.Replace(fmt.Sprintf("%v", this.LongestRunningDuration), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobs(ctx, req.(*GetJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *JobSetInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&JobSetInfo{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
-		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
-		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
-		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
-		`JobsSucceeded:` + fmt.Sprintf("%v", this.JobsSucceeded) + `,`,
-		`JobsFailed:` + fmt.Sprintf("%v", this.JobsFailed) + `,`,
-		`RunningStats:` + strings.Replace(this.RunningStats.String(), "DurationStats", "DurationStats", 1) + `,`,
-		`QueuedStats:` + strings.Replace(this.QueuedStats.String(), "DurationStats", "DurationStats", 1) + `,`,
-		`Submitted:` + strings.Replace(fmt.Sprintf("%v", this.Submitted), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobDetails(ctx, req.(*GetJobDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *DurationStats) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&DurationStats{`,
-		`Shortest:` + strings.Replace(fmt.Sprintf("%v", this.Shortest), "Duration", "types.Duration", 1) + `,`,
-		`Longest:` + strings.Replace(fmt.Sprintf("%v", this.Longest), "Duration", "types.Duration", 1) + `,`,
-		`Average:` + strings.Replace(fmt.Sprintf("%v", this.Average), "Duration", "types.Duration", 1) + `,`,
-		`Median:` + strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1) + `,`,
-		`Q1:` + strings.Replace(fmt.Sprintf("%v", this.Q1), "Duration", "types.Duration", 1) + `,`,
-		`Q3:` + strings.Replace(fmt.Sprintf("%v", this.Q3), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(LookoutServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *GetJobSetsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetQueueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GetJobSetsRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
-		`ActiveOnly:` + fmt.Sprintf("%v", this.ActiveOnly) + `,`,
-		`}`,
-	}, "")
-	return s
+	if interceptor == nil {
+		return srv.(LookoutServer).GetQueueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetQueueHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetQueueHistory(ctx, req.(*GetQueueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *GetJobSetsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetFailureClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFailureClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	repeatedStringForJobSetInfos := "[]*JobSetInfo{"
-	for _, f := range this.JobSetInfos {
-		repeatedStringForJobSetInfos += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	if interceptor == nil {
+		return srv.(LookoutServer).GetFailureClusters(ctx, in)
 	}
-	repeatedStringForJobSetInfos += "}"
-	s := strings.Join([]string{`&GetJobSetsResponse{`,
-		`JobSetInfos:` + repeatedStringForJobSetInfos + `,`,
-		`}`,
-	}, "")
-	return s
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetFailureClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetFailureClusters(ctx, req.(*GetFailureClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *GetJobsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func _Lookout_GetJobSetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobSetAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	keysForUserAnnotations := make([]string, 0, len(this.UserAnnotations))
-	for k, _ := range this.UserAnnotations {
-		keysForUserAnnotations = append(keysForUserAnnotations, k)
+	if interceptor == nil {
+		return srv.(LookoutServer).GetJobSetAnalytics(ctx, in)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForUserAnnotations)
-	mapStringForUserAnnotations := "map[string]string{"
-	for _, k := range keysForUserAnnotations {
-		mapStringForUserAnnotations += fmt.Sprintf("%v: %v,", k, this.UserAnnotations[k])
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lookout.Lookout/GetJobSetAnalytics",
 	}
-	mapStringForUserAnnotations += "}"
-	s := strings.Join([]string{`&GetJobsRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
-		`JobStates:` + fmt.Sprintf("%v", this.JobStates) + `,`,
-		`JobSetIds:` + fmt.Sprintf("%v", this.JobSetIds) + `,`,
-		`Take:` + fmt.Sprintf("%v", this.Take) + `,`,
-		`Skip:` + fmt.Sprintf("%v", this.Skip) + `,`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
-		`UserAnnotations:` + mapStringForUserAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LookoutServer).GetJobSetAnalytics(ctx, req.(*GetJobSetAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (this *GetJobsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+var _Lookout_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lookout.Lookout",
+	HandlerType: (*LookoutServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Overview",
+			Handler:    _Lookout_Overview_Handler,
+		},
+		{
+			MethodName: "GetJobSets",
+			Handler:    _Lookout_GetJobSets_Handler,
+		},
+		{
+			MethodName: "GetJobs",
+			Handler:    _Lookout_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetJobDetails",
+			Handler:    _Lookout_GetJobDetails_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Lookout_GetUsage_Handler,
+		},
+		{
+			MethodName: "GetQueueHistory",
+			Handler:    _Lookout_GetQueueHistory_Handler,
+		},
+		{
+			MethodName: "GetFailureClusters",
+			Handler:    _Lookout_GetFailureClusters_Handler,
+		},
+		{
+			MethodName: "GetJobSetAnalytics",
+			Handler:    _Lookout_GetJobSetAnalytics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/lookout/lookout.proto",
+}
+
+func (m *SystemOverview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForJobInfos := "[]*JobInfo{"
-	for _, f := range this.JobInfos {
-		repeatedStringForJobInfos += strings.Replace(f.String(), "JobInfo", "JobInfo", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *SystemOverview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SystemOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobJson) > 0 {
+		i -= len(m.JobJson)
+		copy(dAtA[i:], m.JobJson)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobJson)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JobState) > 0 {
+		i -= len(m.JobState)
+		copy(dAtA[i:], m.JobState)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Cancelled != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Cancelled, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLookout(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Runs) > 0 {
+		for iNdEx := len(m.Runs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnableToSchedule {
+		i--
+		if m.UnableToSchedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RunState) > 0 {
+		i -= len(m.RunState)
+		copy(dAtA[i:], m.RunState)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.RunState)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Finished != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Finished, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintLookout(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Started != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Started, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintLookout(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Created != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintLookout(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.K8SId) > 0 {
+		i -= len(m.K8SId)
+		copy(dAtA[i:], m.K8SId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.K8SId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContainerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogExcerpt) > 0 {
+		i -= len(m.LogExcerpt)
+		copy(dAtA[i:], m.LogExcerpt)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.LogExcerpt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobEventInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobEventInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobEventInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintLookout(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LongestRunningDuration != nil {
+		{
+			size, err := m.LongestRunningDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OldestQueuedDuration != nil {
+		{
+			size, err := m.OldestQueuedDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LongestRunningJob != nil {
+		{
+			size, err := m.LongestRunningJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OldestQueuedJob != nil {
+		{
+			size, err := m.OldestQueuedJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.JobsRunning != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobsPending != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JobsQueued != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Submitted != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Submitted, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Submitted):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintLookout(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.QueuedStats != nil {
+		{
+			size, err := m.QueuedStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RunningStats != nil {
+		{
+			size, err := m.RunningStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.JobsFailed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsFailed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.JobsSucceeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsSucceeded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.JobsRunning != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRunning))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JobsPending != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsPending))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JobsQueued != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsQueued))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JobSet) > 0 {
+		i -= len(m.JobSet)
+		copy(dAtA[i:], m.JobSet)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Q3 != nil {
+		{
+			size, err := m.Q3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Q1 != nil {
+		{
+			size, err := m.Q1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Median != nil {
+		{
+			size, err := m.Median.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Average != nil {
+		{
+			size, err := m.Average.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Longest != nil {
+		{
+			size, err := m.Longest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Shortest != nil {
+		{
+			size, err := m.Shortest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobSetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobSetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewestFirst {
+		i--
+		if m.NewestFirst {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobSetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobSetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSetInfos) > 0 {
+		for iNdEx := len(m.JobSetInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobSetInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ErrorContains) > 0 {
+		i -= len(m.ErrorContains)
+		copy(dAtA[i:], m.ErrorContains)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.ErrorContains)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ExitCodes) > 0 {
+		dAtA21 := make([]byte, len(m.ExitCodes)*10)
+		var j20 int
+		for _, num1 := range m.ExitCodes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintLookout(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Priority != nil {
+		{
+			size, err := m.Priority.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.UserAnnotations) > 0 {
+		for k := range m.UserAnnotations {
+			v := m.UserAnnotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLookout(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Skip != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Skip))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Take != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Take))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.JobSetIds) > 0 {
+		for iNdEx := len(m.JobSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobSetIds[iNdEx])
+			copy(dAtA[i:], m.JobSetIds[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.JobStates) > 0 {
+		for iNdEx := len(m.JobStates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobStates[iNdEx])
+			copy(dAtA[i:], m.JobStates[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobStates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NewestFirst {
+		i--
+		if m.NewestFirst {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.To):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintLookout(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.From != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.From):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintLookout(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriorityRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriorityRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriorityRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdDoubleMarshalTo(*m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDouble(*m.Max):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintLookout(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Min != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdDoubleMarshalTo(*m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDouble(*m.Min):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintLookout(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobInfos) > 0 {
+		for iNdEx := len(m.JobInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.JobInfo != nil {
+		{
+			size, err := m.JobInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnotationKey) > 0 {
+		i -= len(m.AnnotationKey)
+		copy(dAtA[i:], m.AnnotationKey)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.AnnotationKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.To):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintLookout(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x12
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.From):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintLookout(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalCost))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.Cost) > 0 {
+		for k := range m.Cost {
+			v := m.Cost[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UsedResourceHours) > 0 {
+		for k := range m.UsedResourceHours {
+			v := m.UsedResourceHours[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RequestedResourceHours) > 0 {
+		for k := range m.RequestedResourceHours {
+			v := m.RequestedResourceHours[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLookout(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLookout(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Runs != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetQueueHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetQueueHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetQueueHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolution != nil {
+		{
+			size, err := m.Resolution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.To):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintLookout(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x1a
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.From):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintLookout(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueHistoryPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueHistoryPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueHistoryPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Running != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Running))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Pending != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Pending))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Queued != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Queued))))
+		i--
+		dAtA[i] = 0x11
+	}
+	n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintLookout(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetQueueHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetQueueHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetQueueHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFailureClustersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFailureClustersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailureClustersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Take != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Take))
+		i--
+		dAtA[i] = 0x20
+	}
+	n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.To):])
+	if err37 != nil {
+		return 0, err37
+	}
+	i -= n37
+	i = encodeVarintLookout(dAtA, i, uint64(n37))
+	i--
+	dAtA[i] = 0x1a
+	n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.From):])
+	if err38 != nil {
+		return 0, err38
+	}
+	i -= n38
+	i = encodeVarintLookout(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailureCluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailureCluster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailureCluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nodes[iNdEx])
+			copy(dAtA[i:], m.Nodes[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.Nodes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.JobSets) > 0 {
+		for iNdEx := len(m.JobSets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobSets[iNdEx])
+			copy(dAtA[i:], m.JobSets[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSets[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ExampleJobIds) > 0 {
+		for iNdEx := len(m.ExampleJobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExampleJobIds[iNdEx])
+			copy(dAtA[i:], m.ExampleJobIds[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.ExampleJobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ExampleError) > 0 {
+		i -= len(m.ExampleError)
+		copy(dAtA[i:], m.ExampleError)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.ExampleError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Share != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Share))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Count != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cause) > 0 {
+		i -= len(m.Cause)
+		copy(dAtA[i:], m.Cause)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cause)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFailureClustersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFailureClustersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFailureClustersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalFailures != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.TotalFailures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobSetAnalyticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobSetAnalyticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobSetAnalyticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compare {
+		i--
+		if m.Compare {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Resolution != nil {
+		{
+			size, err := m.Resolution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HistogramBuckets != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.HistogramBuckets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JobSetIds) > 0 {
+		for iNdEx := len(m.JobSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobSetIds[iNdEx])
+			copy(dAtA[i:], m.JobSetIds[iNdEx])
+			i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistogramBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistogramBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistogramBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Upper != nil {
+		{
+			size, err := m.Upper.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Lower != nil {
+		{
+			size, err := m.Lower.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DurationDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DurationDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DurationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Histogram) > 0 {
+		for iNdEx := len(m.Histogram) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histogram[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.P99 != nil {
+		{
+			size, err := m.P99.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.P90 != nil {
+		{
+			size, err := m.P90.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.P50 != nil {
+		{
+			size, err := m.P50.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Mean != nil {
+		{
+			size, err := m.Mean.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Max != nil {
+		{
+			size, err := m.Max.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Min != nil {
+		{
+			size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterAnalytics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterAnalytics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterAnalytics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RunTime != nil {
+		{
+			size, err := m.RunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Failed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Runs != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutcomePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutcomePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutcomePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Cancelled))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Failed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x10
+	}
+	n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err49 != nil {
+		return 0, err49
+	}
+	i -= n49
+	i = encodeVarintLookout(dAtA, i, uint64(n49))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetAnalytics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetAnalytics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetAnalytics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outcomes) > 0 {
+		for iNdEx := len(m.Outcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.RunTime != nil {
+		{
+			size, err := m.RunTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.WaitTime != nil {
+		{
+			size, err := m.WaitTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.JobsRetried != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.JobsRetried))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Retries != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CancelledRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CancelledRatio))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.FailureRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FailureRatio))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.SuccessRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SuccessRatio))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.Cancelled != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Cancelled))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Failed != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jobs != 0 {
+		i = encodeVarintLookout(dAtA, i, uint64(m.Jobs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.JobSet) > 0 {
+		i -= len(m.JobSet)
+		copy(dAtA[i:], m.JobSet)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetComparison) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetComparison) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetComparison) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RunTimeP99Delta != nil {
+		{
+			size, err := m.RunTimeP99Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RunTimeP90Delta != nil {
+		{
+			size, err := m.RunTimeP90Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RunTimeP50Delta != nil {
+		{
+			size, err := m.RunTimeP50Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.WaitTimeP90Delta != nil {
+		{
+			size, err := m.WaitTimeP90Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WaitTimeP50Delta != nil {
+		{
+			size, err := m.WaitTimeP50Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLookout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RetriesPerJobDelta != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RetriesPerJobDelta))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.CancelledRatioDelta != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CancelledRatioDelta))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.FailureRatioDelta != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FailureRatioDelta))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.SuccessRatioDelta != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SuccessRatioDelta))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.JobSet) > 0 {
+		i -= len(m.JobSet)
+		copy(dAtA[i:], m.JobSet)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.JobSet)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetJobSetAnalyticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJobSetAnalyticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJobSetAnalyticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comparisons) > 0 {
+		for iNdEx := len(m.Comparisons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Comparisons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Baseline) > 0 {
+		i -= len(m.Baseline)
+		copy(dAtA[i:], m.Baseline)
+		i = encodeVarintLookout(dAtA, i, uint64(len(m.Baseline)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobSets) > 0 {
+		for iNdEx := len(m.JobSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLookout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLookout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLookout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SystemOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Runs) > 0 {
+		for _, e := range m.Runs {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.Cancelled != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Cancelled)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobState)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobJson)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *RunInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.K8SId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Started != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Started)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Finished != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Finished)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovLookout(uint64(m.PodNumber))
+	}
+	l = len(m.RunState)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.UnableToSchedule {
+		n += 2
+	}
+	return n
+}
+
+func (m *ContainerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovLookout(uint64(m.ExitCode))
+	}
+	l = len(m.LogExcerpt)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *JobEventInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovLookout(uint64(l))
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovLookout(uint64(m.PodNumber))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.JobsQueued != 0 {
+		n += 1 + sovLookout(uint64(m.JobsQueued))
+	}
+	if m.JobsPending != 0 {
+		n += 1 + sovLookout(uint64(m.JobsPending))
+	}
+	if m.JobsRunning != 0 {
+		n += 1 + sovLookout(uint64(m.JobsRunning))
+	}
+	if m.OldestQueuedJob != nil {
+		l = m.OldestQueuedJob.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.LongestRunningJob != nil {
+		l = m.LongestRunningJob.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.OldestQueuedDuration != nil {
+		l = m.OldestQueuedDuration.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.LongestRunningDuration != nil {
+		l = m.LongestRunningDuration.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobSet)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.JobsQueued != 0 {
+		n += 1 + sovLookout(uint64(m.JobsQueued))
+	}
+	if m.JobsPending != 0 {
+		n += 1 + sovLookout(uint64(m.JobsPending))
+	}
+	if m.JobsRunning != 0 {
+		n += 1 + sovLookout(uint64(m.JobsRunning))
+	}
+	if m.JobsSucceeded != 0 {
+		n += 1 + sovLookout(uint64(m.JobsSucceeded))
+	}
+	if m.JobsFailed != 0 {
+		n += 1 + sovLookout(uint64(m.JobsFailed))
+	}
+	if m.RunningStats != nil {
+		l = m.RunningStats.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.QueuedStats != nil {
+		l = m.QueuedStats.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Submitted != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Submitted)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *DurationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shortest != nil {
+		l = m.Shortest.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Longest != nil {
+		l = m.Longest.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Average != nil {
+		l = m.Average.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Median != nil {
+		l = m.Median.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Q1 != nil {
+		l = m.Q1.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Q3 != nil {
+		l = m.Q3.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobSetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.NewestFirst {
+		n += 2
+	}
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetJobSetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobSetInfos) > 0 {
+		for _, e := range m.JobSetInfos {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetJobsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.NewestFirst {
+		n += 2
+	}
+	if len(m.JobStates) > 0 {
+		for _, s := range m.JobStates {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.JobSetIds) > 0 {
+		for _, s := range m.JobSetIds {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.Take != 0 {
+		n += 1 + sovLookout(uint64(m.Take))
+	}
+	if m.Skip != 0 {
+		n += 1 + sovLookout(uint64(m.Skip))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.UserAnnotations) > 0 {
+		for k, v := range m.UserAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + len(v) + sovLookout(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	if m.Submitted != nil {
+		l = m.Submitted.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Priority != nil {
+		l = m.Priority.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.ExitCodes) > 0 {
+		l = 0
+		for _, e := range m.ExitCodes {
+			l += sovLookout(uint64(e))
+		}
+		n += 2 + sovLookout(uint64(l)) + l
+	}
+	l = len(m.ErrorContains)
+	if l > 0 {
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 2 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *TimeRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *PriorityRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDouble(*m.Min)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Max != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDouble(*m.Max)
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobInfos) > 0 {
+		for _, e := range m.JobInfos {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobInfo != nil {
+		l = m.JobInfo.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.From)
+	n += 1 + l + sovLookout(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.To)
+	n += 1 + l + sovLookout(uint64(l))
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.AnnotationKey)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *UsageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovLookout(uint64(m.Runs))
+	}
+	if len(m.RequestedResourceHours) > 0 {
+		for k, v := range m.RequestedResourceHours {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	if len(m.UsedResourceHours) > 0 {
+		for k, v := range m.UsedResourceHours {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Cost) > 0 {
+		for k, v := range m.Cost {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLookout(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovLookout(uint64(mapEntrySize))
+		}
+	}
+	if m.TotalCost != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GetUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetQueueHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.From)
+	n += 1 + l + sovLookout(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.To)
+	n += 1 + l + sovLookout(uint64(l))
+	if m.Resolution != nil {
+		l = m.Resolution.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueHistoryPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLookout(uint64(l))
+	if m.Queued != 0 {
+		n += 9
+	}
+	if m.Pending != 0 {
+		n += 9
+	}
+	if m.Running != 0 {
+		n += 9
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovLookout(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovLookout(uint64(m.Failed))
+	}
+	return n
+}
+
+func (m *QueueHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetQueueHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetFailureClustersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.From)
+	n += 1 + l + sovLookout(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.To)
+	n += 1 + l + sovLookout(uint64(l))
+	if m.Take != 0 {
+		n += 1 + sovLookout(uint64(m.Take))
+	}
+	return n
+}
+
+func (m *FailureCluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovLookout(uint64(m.ExitCode))
+	}
+	l = len(m.Cause)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovLookout(uint64(m.Count))
+	}
+	if m.Share != 0 {
+		n += 9
+	}
+	l = len(m.ExampleError)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.ExampleJobIds) > 0 {
+		for _, s := range m.ExampleJobIds {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.JobSets) > 0 {
+		for _, s := range m.JobSets {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for _, s := range m.Nodes {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetFailureClustersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.TotalFailures != 0 {
+		n += 1 + sovLookout(uint64(m.TotalFailures))
+	}
+	return n
+}
+
+func (m *GetJobSetAnalyticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.JobSetIds) > 0 {
+		for _, s := range m.JobSetIds {
+			l = len(s)
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if m.HistogramBuckets != 0 {
+		n += 1 + sovLookout(uint64(m.HistogramBuckets))
+	}
+	if m.Resolution != nil {
+		l = m.Resolution.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Compare {
+		n += 2
+	}
+	return n
+}
+
+func (m *HistogramBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lower != nil {
+		l = m.Lower.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Upper != nil {
+		l = m.Upper.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovLookout(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *DurationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovLookout(uint64(m.Count))
+	}
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Mean != nil {
+		l = m.Mean.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.P50 != nil {
+		l = m.P50.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.P90 != nil {
+		l = m.P90.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.P99 != nil {
+		l = m.P99.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Histogram) > 0 {
+		for _, e := range m.Histogram {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterAnalytics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovLookout(uint64(m.Runs))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovLookout(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovLookout(uint64(m.Failed))
+	}
+	if m.RunTime != nil {
+		l = m.RunTime.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *OutcomePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLookout(uint64(l))
+	if m.Succeeded != 0 {
+		n += 1 + sovLookout(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovLookout(uint64(m.Failed))
+	}
+	if m.Cancelled != 0 {
+		n += 1 + sovLookout(uint64(m.Cancelled))
+	}
+	return n
+}
+
+func (m *JobSetAnalytics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	l = len(m.JobSet)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.Jobs != 0 {
+		n += 1 + sovLookout(uint64(m.Jobs))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovLookout(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovLookout(uint64(m.Failed))
+	}
+	if m.Cancelled != 0 {
+		n += 1 + sovLookout(uint64(m.Cancelled))
+	}
+	if m.SuccessRatio != 0 {
+		n += 9
+	}
+	if m.FailureRatio != 0 {
+		n += 9
+	}
+	if m.CancelledRatio != 0 {
+		n += 9
+	}
+	if m.Retries != 0 {
+		n += 1 + sovLookout(uint64(m.Retries))
+	}
+	if m.JobsRetried != 0 {
+		n += 1 + sovLookout(uint64(m.JobsRetried))
+	}
+	if m.WaitTime != nil {
+		l = m.WaitTime.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.RunTime != nil {
+		l = m.RunTime.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	if len(m.Outcomes) > 0 {
+		for _, e := range m.Outcomes {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobSetComparison) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobSet)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.SuccessRatioDelta != 0 {
+		n += 9
+	}
+	if m.FailureRatioDelta != 0 {
+		n += 9
+	}
+	if m.CancelledRatioDelta != 0 {
+		n += 9
+	}
+	if m.RetriesPerJobDelta != 0 {
+		n += 9
+	}
+	if m.WaitTimeP50Delta != nil {
+		l = m.WaitTimeP50Delta.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.WaitTimeP90Delta != nil {
+		l = m.WaitTimeP90Delta.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.RunTimeP50Delta != nil {
+		l = m.RunTimeP50Delta.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.RunTimeP90Delta != nil {
+		l = m.RunTimeP90Delta.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if m.RunTimeP99Delta != nil {
+		l = m.RunTimeP99Delta.Size()
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	return n
+}
+
+func (m *GetJobSetAnalyticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobSets) > 0 {
+		for _, e := range m.JobSets {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	l = len(m.Baseline)
+	if l > 0 {
+		n += 1 + l + sovLookout(uint64(l))
+	}
+	if len(m.Comparisons) > 0 {
+		for _, e := range m.Comparisons {
+			l = e.Size()
+			n += 1 + l + sovLookout(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLookout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLookout(x uint64) (n int) {
+	return sovLookout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SystemOverview) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*QueueInfo{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(f.String(), "QueueInfo", "QueueInfo", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&SystemOverview{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRuns := "[]*RunInfo{"
+	for _, f := range this.Runs {
+		repeatedStringForRuns += strings.Replace(f.String(), "RunInfo", "RunInfo", 1) + ","
+	}
+	repeatedStringForRuns += "}"
+	s := strings.Join([]string{`&JobInfo{`,
+		`Job:` + strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "api.Job", 1) + `,`,
+		`Runs:` + repeatedStringForRuns + `,`,
+		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "Timestamp", "types.Timestamp", 1) + `,`,
+		`JobState:` + fmt.Sprintf("%v", this.JobState) + `,`,
+		`JobJson:` + fmt.Sprintf("%v", this.JobJson) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RunInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RunInfo{`,
+		`K8SId:` + fmt.Sprintf("%v", this.K8SId) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Created:` + strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Started:` + strings.Replace(fmt.Sprintf("%v", this.Started), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Finished:` + strings.Replace(fmt.Sprintf("%v", this.Finished), "Timestamp", "types.Timestamp", 1) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`RunState:` + fmt.Sprintf("%v", this.RunState) + `,`,
+		`UnableToSchedule:` + fmt.Sprintf("%v", this.UnableToSchedule) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerInfo{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`LogExcerpt:` + fmt.Sprintf("%v", this.LogExcerpt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobEventInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobEventInfo{`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueInfo{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
+		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
+		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
+		`OldestQueuedJob:` + strings.Replace(this.OldestQueuedJob.String(), "JobInfo", "JobInfo", 1) + `,`,
+		`LongestRunningJob:` + strings.Replace(this.LongestRunningJob.String(), "JobInfo", "JobInfo", 1) + `,`,
+		`OldestQueuedDuration:` + strings.Replace(fmt.Sprintf("%v", this.OldestQueuedDuration), "Duration", "types.Duration", 1) + `,`,
+		`LongestRunningDuration:` + strings.Replace(fmt.Sprintf("%v", this.LongestRunningDuration), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetInfo{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
+		`JobsQueued:` + fmt.Sprintf("%v", this.JobsQueued) + `,`,
+		`JobsPending:` + fmt.Sprintf("%v", this.JobsPending) + `,`,
+		`JobsRunning:` + fmt.Sprintf("%v", this.JobsRunning) + `,`,
+		`JobsSucceeded:` + fmt.Sprintf("%v", this.JobsSucceeded) + `,`,
+		`JobsFailed:` + fmt.Sprintf("%v", this.JobsFailed) + `,`,
+		`RunningStats:` + strings.Replace(this.RunningStats.String(), "DurationStats", "DurationStats", 1) + `,`,
+		`QueuedStats:` + strings.Replace(this.QueuedStats.String(), "DurationStats", "DurationStats", 1) + `,`,
+		`Submitted:` + strings.Replace(fmt.Sprintf("%v", this.Submitted), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DurationStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DurationStats{`,
+		`Shortest:` + strings.Replace(fmt.Sprintf("%v", this.Shortest), "Duration", "types.Duration", 1) + `,`,
+		`Longest:` + strings.Replace(fmt.Sprintf("%v", this.Longest), "Duration", "types.Duration", 1) + `,`,
+		`Average:` + strings.Replace(fmt.Sprintf("%v", this.Average), "Duration", "types.Duration", 1) + `,`,
+		`Median:` + strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1) + `,`,
+		`Q1:` + strings.Replace(fmt.Sprintf("%v", this.Q1), "Duration", "types.Duration", 1) + `,`,
+		`Q3:` + strings.Replace(fmt.Sprintf("%v", this.Q3), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobSetsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJobSetsRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
+		`ActiveOnly:` + fmt.Sprintf("%v", this.ActiveOnly) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobSetsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobSetInfos := "[]*JobSetInfo{"
+	for _, f := range this.JobSetInfos {
+		repeatedStringForJobSetInfos += strings.Replace(f.String(), "JobSetInfo", "JobSetInfo", 1) + ","
+	}
+	repeatedStringForJobSetInfos += "}"
+	s := strings.Join([]string{`&GetJobSetsResponse{`,
+		`JobSetInfos:` + repeatedStringForJobSetInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUserAnnotations := make([]string, 0, len(this.UserAnnotations))
+	for k, _ := range this.UserAnnotations {
+		keysForUserAnnotations = append(keysForUserAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUserAnnotations)
+	mapStringForUserAnnotations := "map[string]string{"
+	for _, k := range keysForUserAnnotations {
+		mapStringForUserAnnotations += fmt.Sprintf("%v: %v,", k, this.UserAnnotations[k])
+	}
+	mapStringForUserAnnotations += "}"
+	s := strings.Join([]string{`&GetJobsRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`NewestFirst:` + fmt.Sprintf("%v", this.NewestFirst) + `,`,
+		`JobStates:` + fmt.Sprintf("%v", this.JobStates) + `,`,
+		`JobSetIds:` + fmt.Sprintf("%v", this.JobSetIds) + `,`,
+		`Take:` + fmt.Sprintf("%v", this.Take) + `,`,
+		`Skip:` + fmt.Sprintf("%v", this.Skip) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Owner:` + fmt.Sprintf("%v", this.Owner) + `,`,
+		`UserAnnotations:` + mapStringForUserAnnotations + `,`,
+		`Submitted:` + strings.Replace(this.Submitted.String(), "TimeRange", "TimeRange", 1) + `,`,
+		`Started:` + strings.Replace(this.Started.String(), "TimeRange", "TimeRange", 1) + `,`,
+		`Finished:` + strings.Replace(this.Finished.String(), "TimeRange", "TimeRange", 1) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`Priority:` + strings.Replace(this.Priority.String(), "PriorityRange", "PriorityRange", 1) + `,`,
+		`ExitCodes:` + fmt.Sprintf("%v", this.ExitCodes) + `,`,
+		`ErrorContains:` + fmt.Sprintf("%v", this.ErrorContains) + `,`,
+		`OrderBy:` + fmt.Sprintf("%v", this.OrderBy) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeRange{`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PriorityRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PriorityRange{`,
+		`Min:` + strings.Replace(fmt.Sprintf("%v", this.Min), "DoubleValue", "types.DoubleValue", 1) + `,`,
+		`Max:` + strings.Replace(fmt.Sprintf("%v", this.Max), "DoubleValue", "types.DoubleValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobInfos := "[]*JobInfo{"
+	for _, f := range this.JobInfos {
+		repeatedStringForJobInfos += strings.Replace(f.String(), "JobInfo", "JobInfo", 1) + ","
+	}
+	repeatedStringForJobInfos += "}"
+	s := strings.Join([]string{`&GetJobsResponse{`,
+		`JobInfos:` + repeatedStringForJobInfos + `,`,
+		`NextCursor:` + fmt.Sprintf("%v", this.NextCursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobDetailsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJobDetailsRequest{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobDetailsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]*ContainerInfo{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(f.String(), "ContainerInfo", "ContainerInfo", 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	repeatedStringForEvents := "[]*JobEventInfo{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(f.String(), "JobEventInfo", "JobEventInfo", 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&GetJobDetailsResponse{`,
+		`JobInfo:` + strings.Replace(this.JobInfo.String(), "JobInfo", "JobInfo", 1) + `,`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUsageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetUsageRequest{`,
+		`From:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`To:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`AnnotationKey:` + fmt.Sprintf("%v", this.AnnotationKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsageInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRequestedResourceHours := make([]string, 0, len(this.RequestedResourceHours))
+	for k, _ := range this.RequestedResourceHours {
+		keysForRequestedResourceHours = append(keysForRequestedResourceHours, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRequestedResourceHours)
+	mapStringForRequestedResourceHours := "map[string]float64{"
+	for _, k := range keysForRequestedResourceHours {
+		mapStringForRequestedResourceHours += fmt.Sprintf("%v: %v,", k, this.RequestedResourceHours[k])
+	}
+	mapStringForRequestedResourceHours += "}"
+	keysForUsedResourceHours := make([]string, 0, len(this.UsedResourceHours))
+	for k, _ := range this.UsedResourceHours {
+		keysForUsedResourceHours = append(keysForUsedResourceHours, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsedResourceHours)
+	mapStringForUsedResourceHours := "map[string]float64{"
+	for _, k := range keysForUsedResourceHours {
+		mapStringForUsedResourceHours += fmt.Sprintf("%v: %v,", k, this.UsedResourceHours[k])
+	}
+	mapStringForUsedResourceHours += "}"
+	keysForCost := make([]string, 0, len(this.Cost))
+	for k, _ := range this.Cost {
+		keysForCost = append(keysForCost, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCost)
+	mapStringForCost := "map[string]float64{"
+	for _, k := range keysForCost {
+		mapStringForCost += fmt.Sprintf("%v: %v,", k, this.Cost[k])
+	}
+	mapStringForCost += "}"
+	s := strings.Join([]string{`&UsageInfo{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Runs:` + fmt.Sprintf("%v", this.Runs) + `,`,
+		`RequestedResourceHours:` + mapStringForRequestedResourceHours + `,`,
+		`UsedResourceHours:` + mapStringForUsedResourceHours + `,`,
+		`Cost:` + mapStringForCost + `,`,
+		`TotalCost:` + fmt.Sprintf("%v", this.TotalCost) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUsageResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUsage := "[]*UsageInfo{"
+	for _, f := range this.Usage {
+		repeatedStringForUsage += strings.Replace(f.String(), "UsageInfo", "UsageInfo", 1) + ","
+	}
+	repeatedStringForUsage += "}"
+	s := strings.Join([]string{`&GetUsageResponse{`,
+		`Usage:` + repeatedStringForUsage + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetQueueHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetQueueHistoryRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`From:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`To:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Resolution:` + strings.Replace(fmt.Sprintf("%v", this.Resolution), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueHistoryPoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueHistoryPoint{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Queued:` + fmt.Sprintf("%v", this.Queued) + `,`,
+		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
+		`Running:` + fmt.Sprintf("%v", this.Running) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueueHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPoints := "[]*QueueHistoryPoint{"
+	for _, f := range this.Points {
+		repeatedStringForPoints += strings.Replace(f.String(), "QueueHistoryPoint", "QueueHistoryPoint", 1) + ","
+	}
+	repeatedStringForPoints += "}"
+	s := strings.Join([]string{`&QueueHistory{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Points:` + repeatedStringForPoints + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetQueueHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*QueueHistory{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(f.String(), "QueueHistory", "QueueHistory", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&GetQueueHistoryResponse{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetFailureClustersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetFailureClustersRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`From:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`To:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Take:` + fmt.Sprintf("%v", this.Take) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FailureCluster) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FailureCluster{`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Share:` + fmt.Sprintf("%v", this.Share) + `,`,
+		`ExampleError:` + fmt.Sprintf("%v", this.ExampleError) + `,`,
+		`ExampleJobIds:` + fmt.Sprintf("%v", this.ExampleJobIds) + `,`,
+		`JobSets:` + fmt.Sprintf("%v", this.JobSets) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetFailureClustersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClusters := "[]*FailureCluster{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(f.String(), "FailureCluster", "FailureCluster", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	s := strings.Join([]string{`&GetFailureClustersResponse{`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`TotalFailures:` + fmt.Sprintf("%v", this.TotalFailures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobSetAnalyticsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJobSetAnalyticsRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetIds:` + fmt.Sprintf("%v", this.JobSetIds) + `,`,
+		`HistogramBuckets:` + fmt.Sprintf("%v", this.HistogramBuckets) + `,`,
+		`Resolution:` + strings.Replace(fmt.Sprintf("%v", this.Resolution), "Duration", "types.Duration", 1) + `,`,
+		`Compare:` + fmt.Sprintf("%v", this.Compare) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistogramBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistogramBucket{`,
+		`Lower:` + strings.Replace(fmt.Sprintf("%v", this.Lower), "Duration", "types.Duration", 1) + `,`,
+		`Upper:` + strings.Replace(fmt.Sprintf("%v", this.Upper), "Duration", "types.Duration", 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DurationDistribution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistogram := "[]*HistogramBucket{"
+	for _, f := range this.Histogram {
+		repeatedStringForHistogram += strings.Replace(f.String(), "HistogramBucket", "HistogramBucket", 1) + ","
+	}
+	repeatedStringForHistogram += "}"
+	s := strings.Join([]string{`&DurationDistribution{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Min:` + strings.Replace(fmt.Sprintf("%v", this.Min), "Duration", "types.Duration", 1) + `,`,
+		`Max:` + strings.Replace(fmt.Sprintf("%v", this.Max), "Duration", "types.Duration", 1) + `,`,
+		`Mean:` + strings.Replace(fmt.Sprintf("%v", this.Mean), "Duration", "types.Duration", 1) + `,`,
+		`P50:` + strings.Replace(fmt.Sprintf("%v", this.P50), "Duration", "types.Duration", 1) + `,`,
+		`P90:` + strings.Replace(fmt.Sprintf("%v", this.P90), "Duration", "types.Duration", 1) + `,`,
+		`P99:` + strings.Replace(fmt.Sprintf("%v", this.P99), "Duration", "types.Duration", 1) + `,`,
+		`Histogram:` + repeatedStringForHistogram + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterAnalytics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterAnalytics{`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`Runs:` + fmt.Sprintf("%v", this.Runs) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`RunTime:` + strings.Replace(this.RunTime.String(), "DurationDistribution", "DurationDistribution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutcomePoint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutcomePoint{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Cancelled:` + fmt.Sprintf("%v", this.Cancelled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetAnalytics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClusters := "[]*ClusterAnalytics{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(f.String(), "ClusterAnalytics", "ClusterAnalytics", 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	repeatedStringForOutcomes := "[]*OutcomePoint{"
+	for _, f := range this.Outcomes {
+		repeatedStringForOutcomes += strings.Replace(f.String(), "OutcomePoint", "OutcomePoint", 1) + ","
+	}
+	repeatedStringForOutcomes += "}"
+	s := strings.Join([]string{`&JobSetAnalytics{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
+		`Jobs:` + fmt.Sprintf("%v", this.Jobs) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Cancelled:` + fmt.Sprintf("%v", this.Cancelled) + `,`,
+		`SuccessRatio:` + fmt.Sprintf("%v", this.SuccessRatio) + `,`,
+		`FailureRatio:` + fmt.Sprintf("%v", this.FailureRatio) + `,`,
+		`CancelledRatio:` + fmt.Sprintf("%v", this.CancelledRatio) + `,`,
+		`Retries:` + fmt.Sprintf("%v", this.Retries) + `,`,
+		`JobsRetried:` + fmt.Sprintf("%v", this.JobsRetried) + `,`,
+		`WaitTime:` + strings.Replace(this.WaitTime.String(), "DurationDistribution", "DurationDistribution", 1) + `,`,
+		`RunTime:` + strings.Replace(this.RunTime.String(), "DurationDistribution", "DurationDistribution", 1) + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`Outcomes:` + repeatedStringForOutcomes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetComparison) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetComparison{`,
+		`JobSet:` + fmt.Sprintf("%v", this.JobSet) + `,`,
+		`SuccessRatioDelta:` + fmt.Sprintf("%v", this.SuccessRatioDelta) + `,`,
+		`FailureRatioDelta:` + fmt.Sprintf("%v", this.FailureRatioDelta) + `,`,
+		`CancelledRatioDelta:` + fmt.Sprintf("%v", this.CancelledRatioDelta) + `,`,
+		`RetriesPerJobDelta:` + fmt.Sprintf("%v", this.RetriesPerJobDelta) + `,`,
+		`WaitTimeP50Delta:` + strings.Replace(fmt.Sprintf("%v", this.WaitTimeP50Delta), "Duration", "types.Duration", 1) + `,`,
+		`WaitTimeP90Delta:` + strings.Replace(fmt.Sprintf("%v", this.WaitTimeP90Delta), "Duration", "types.Duration", 1) + `,`,
+		`RunTimeP50Delta:` + strings.Replace(fmt.Sprintf("%v", this.RunTimeP50Delta), "Duration", "types.Duration", 1) + `,`,
+		`RunTimeP90Delta:` + strings.Replace(fmt.Sprintf("%v", this.RunTimeP90Delta), "Duration", "types.Duration", 1) + `,`,
+		`RunTimeP99Delta:` + strings.Replace(fmt.Sprintf("%v", this.RunTimeP99Delta), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJobSetAnalyticsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForJobSets := "[]*JobSetAnalytics{"
+	for _, f := range this.JobSets {
+		repeatedStringForJobSets += strings.Replace(f.String(), "JobSetAnalytics", "JobSetAnalytics", 1) + ","
+	}
+	repeatedStringForJobSets += "}"
+	repeatedStringForComparisons := "[]*JobSetComparison{"
+	for _, f := range this.Comparisons {
+		repeatedStringForComparisons += strings.Replace(f.String(), "JobSetComparison", "JobSetComparison", 1) + ","
+	}
+	repeatedStringForComparisons += "}"
+	s := strings.Join([]string{`&GetJobSetAnalyticsResponse{`,
+		`JobSets:` + repeatedStringForJobSets + `,`,
+		`Baseline:` + fmt.Sprintf("%v", this.Baseline) + `,`,
+		`Comparisons:` + repeatedStringForComparisons + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLookout(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SystemOverview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemOverview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemOverview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueueInfo{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &api.Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runs = append(m.Runs, &RunInfo{})
+			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cancelled == nil {
+				m.Cancelled = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Cancelled, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Finished, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnableToSchedule = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogExcerpt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogExcerpt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobEventInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobEventInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobEventInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+			}
+			m.JobsQueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsQueued |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			}
+			m.JobsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsPending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			}
+			m.JobsRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestQueuedJob == nil {
+				m.OldestQueuedJob = &JobInfo{}
+			}
+			if err := m.OldestQueuedJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LongestRunningJob == nil {
+				m.LongestRunningJob = &JobInfo{}
+			}
+			if err := m.LongestRunningJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestQueuedDuration == nil {
+				m.OldestQueuedDuration = &types.Duration{}
+			}
+			if err := m.OldestQueuedDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LongestRunningDuration == nil {
+				m.LongestRunningDuration = &types.Duration{}
+			}
+			if err := m.LongestRunningDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+			}
+			m.JobsQueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsQueued |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			}
+			m.JobsPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsPending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			}
+			m.JobsRunning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsRunning |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsSucceeded", wireType)
+			}
+			m.JobsSucceeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsSucceeded |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsFailed", wireType)
+			}
+			m.JobsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobsFailed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunningStats == nil {
+				m.RunningStats = &DurationStats{}
+			}
+			if err := m.RunningStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedStats == nil {
+				m.QueuedStats = &DurationStats{}
+			}
+			if err := m.QueuedStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Submitted == nil {
+				m.Submitted = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Submitted, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DurationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DurationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DurationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shortest == nil {
+				m.Shortest = &types.Duration{}
+			}
+			if err := m.Shortest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Longest == nil {
+				m.Longest = &types.Duration{}
+			}
+			if err := m.Longest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Average == nil {
+				m.Average = &types.Duration{}
+			}
+			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Median == nil {
+				m.Median = &types.Duration{}
+			}
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Q1 == nil {
+				m.Q1 = &types.Duration{}
+			}
+			if err := m.Q1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Q3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Q3 == nil {
+				m.Q3 = &types.Duration{}
+			}
+			if err := m.Q3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobSetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewestFirst = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobSetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetInfos = append(m.JobSetInfos, &JobSetInfo{})
+			if err := m.JobSetInfos[len(m.JobSetInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewestFirst = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobStates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobStates = append(m.JobStates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetIds = append(m.JobSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
+			}
+			m.Take = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Take |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			}
+			m.Skip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Skip |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UserAnnotations == nil {
+				m.UserAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLookout(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.UserAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Submitted == nil {
+				m.Submitted = &TimeRange{}
+			}
+			if err := m.Submitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &TimeRange{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &TimeRange{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Priority == nil {
+				m.Priority = &PriorityRange{}
+			}
+			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLookout
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLookout
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorContains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorContains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriorityRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriorityRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriorityRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = new(float64)
+			}
+			if err := github_com_gogo_protobuf_types.StdDoubleUnmarshal(m.Min, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = new(float64)
+			}
+			if err := github_com_gogo_protobuf_types.StdDoubleUnmarshal(m.Max, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobInfos = append(m.JobInfos, &JobInfo{})
+			if err := m.JobInfos[len(m.JobInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobInfo == nil {
+				m.JobInfo = &JobInfo{}
+			}
+			if err := m.JobInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, &ContainerInfo{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &JobEventInfo{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedResourceHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestedResourceHours == nil {
+				m.RequestedResourceHours = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLookout(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequestedResourceHours[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedResourceHours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UsedResourceHours == nil {
+				m.UsedResourceHours = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLookout(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.UsedResourceHours[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cost == nil {
+				m.Cost = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLookout
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLookout
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLookout(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLookout
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Cost[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalCost = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, &UsageInfo{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetQueueHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetQueueHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetQueueHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resolution == nil {
+				m.Resolution = &types.Duration{}
+			}
+			if err := m.Resolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueHistoryPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueHistoryPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueHistoryPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Queued = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Pending = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Running = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueueHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, &QueueHistoryPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetQueueHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetQueueHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetQueueHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &QueueHistory{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForJobInfos += "}"
-	s := strings.Join([]string{`&GetJobsResponse{`,
-		`JobInfos:` + repeatedStringForJobInfos + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringLookout(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SystemOverview) Unmarshal(dAtA []byte) error {
+func (m *GetFailureClustersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,15 +12113,47 @@ func (m *SystemOverview) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SystemOverview: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFailureClustersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemOverview: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFailureClustersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2379,11 +12180,62 @@ func (m *SystemOverview) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queues = append(m.Queues, &QueueInfo{})
-			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.To, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
+			}
+			m.Take = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Take |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -2405,7 +12257,7 @@ func (m *SystemOverview) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *FailureCluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2415,30 +12267,143 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowLookout
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailureCluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailureCluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cause = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Share = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2448,33 +12413,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &api.Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExampleError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExampleJobIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2484,31 +12445,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runs = append(m.Runs, &RunInfo{})
-			if err := m.Runs[len(m.Runs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExampleJobIds = append(m.ExampleJobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2518,31 +12477,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cancelled == nil {
-				m.Cancelled = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Cancelled, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSets = append(m.JobSets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2570,11 +12525,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobState = string(dAtA[iNdEx:postIndex])
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2602,7 +12557,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobJson = string(dAtA[iNdEx:postIndex])
+			m.Nodes = append(m.Nodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2625,7 +12580,7 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunInfo) Unmarshal(dAtA []byte) error {
+func (m *GetFailureClustersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2648,17 +12603,17 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFailureClustersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFailureClustersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2668,27 +12623,98 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SId = string(dAtA[iNdEx:postIndex])
+			m.Clusters = append(m.Clusters, &FailureCluster{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFailures", wireType)
+			}
+			m.TotalFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetJobSetAnalyticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetJobSetAnalyticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetJobSetAnalyticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2716,11 +12742,11 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2748,13 +12774,13 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Node = string(dAtA[iNdEx:postIndex])
+			m.JobSetIds = append(m.JobSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramBuckets", wireType)
 			}
-			var v int
+			m.HistogramBuckets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2764,17 +12790,16 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.HistogramBuckets |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Succeeded = bool(v != 0)
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2784,29 +12809,33 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if m.Resolution == nil {
+				m.Resolution = &types.Duration{}
+			}
+			if err := m.Resolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compare", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2816,31 +12845,65 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
+			m.Compare = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLookout
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistogramBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistogramBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistogramBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2867,16 +12930,16 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = new(time.Time)
+			if m.Lower == nil {
+				m.Lower = &types.Duration{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Started, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2903,69 +12966,18 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = new(time.Time)
+			if m.Upper == nil {
+				m.Upper = &types.Duration{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Finished, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunState", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -2975,12 +12987,11 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UnableToSchedule = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -3002,7 +13013,7 @@ func (m *RunInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueueInfo) Unmarshal(dAtA []byte) error {
+func (m *DurationDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3025,17 +13036,17 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueueInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DurationDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DurationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3045,29 +13056,16 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.JobsQueued = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3077,16 +13075,33 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsQueued |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Min == nil {
+				m.Min = &types.Duration{}
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			m.JobsPending = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3096,16 +13111,33 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsPending |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Max == nil {
+				m.Max = &types.Duration{}
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
 			}
-			m.JobsRunning = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3115,14 +13147,31 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsRunning |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mean == nil {
+				m.Mean = &types.Duration{}
+			}
+			if err := m.Mean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3149,16 +13198,16 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldestQueuedJob == nil {
-				m.OldestQueuedJob = &JobInfo{}
+			if m.P50 == nil {
+				m.P50 = &types.Duration{}
 			}
-			if err := m.OldestQueuedJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.P50.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3185,16 +13234,16 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LongestRunningJob == nil {
-				m.LongestRunningJob = &JobInfo{}
+			if m.P90 == nil {
+				m.P90 = &types.Duration{}
 			}
-			if err := m.LongestRunningJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.P90.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldestQueuedDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3221,16 +13270,16 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldestQueuedDuration == nil {
-				m.OldestQueuedDuration = &types.Duration{}
+			if m.P99 == nil {
+				m.P99 = &types.Duration{}
 			}
-			if err := m.OldestQueuedDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.P99.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LongestRunningDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3257,10 +13306,8 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LongestRunningDuration == nil {
-				m.LongestRunningDuration = &types.Duration{}
-			}
-			if err := m.LongestRunningDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Histogram = append(m.Histogram, &HistogramBucket{})
+			if err := m.Histogram[len(m.Histogram)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3285,7 +13332,7 @@ func (m *QueueInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
+func (m *ClusterAnalytics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,100 +13355,17 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterAnalytics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterAnalytics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobSet = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsQueued", wireType)
-			}
-			m.JobsQueued = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JobsQueued |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsPending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			m.JobsPending = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3411,16 +13375,29 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsPending |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsRunning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
 			}
-			m.JobsRunning = 0
+			m.Runs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3430,16 +13407,16 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsRunning |= uint32(b&0x7F) << shift
+				m.Runs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsSucceeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			m.JobsSucceeded = 0
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3449,16 +13426,16 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsSucceeded |= uint32(b&0x7F) << shift
+				m.Succeeded |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobsFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			m.JobsFailed = 0
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3468,14 +13445,14 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JobsFailed |= uint32(b&0x7F) << shift
+				m.Failed |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3502,16 +13479,66 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunningStats == nil {
-				m.RunningStats = &DurationStats{}
+			if m.RunTime == nil {
+				m.RunTime = &DurationDistribution{}
 			}
-			if err := m.RunningStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLookout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutcomePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLookout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutcomePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutcomePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3538,18 +13565,15 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedStats == nil {
-				m.QueuedStats = &DurationStats{}
-			}
-			if err := m.QueuedStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var msglen int
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3559,28 +13583,49 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Succeeded |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Submitted == nil {
-				m.Submitted = new(time.Time)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Submitted, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Cancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cancelled |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLookout(dAtA[iNdEx:])
@@ -3602,7 +13647,7 @@ func (m *JobSetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DurationStats) Unmarshal(dAtA []byte) error {
+func (m *JobSetAnalytics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3625,17 +13670,17 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DurationStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetAnalytics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DurationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetAnalytics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shortest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3645,33 +13690,29 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shortest == nil {
-				m.Shortest = &types.Duration{}
-			}
-			if err := m.Shortest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Longest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3681,33 +13722,29 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Longest == nil {
-				m.Longest = &types.Duration{}
-			}
-			if err := m.Longest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSet = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			var msglen int
+			m.Jobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3717,33 +13754,106 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Jobs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			m.Cancelled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cancelled |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Average == nil {
-				m.Average = &types.Duration{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SuccessRatio = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRatio", wireType)
 			}
-			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FailureRatio = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledRatio", wireType)
 			}
-			var msglen int
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CancelledRatio = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3753,33 +13863,16 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Retries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Median == nil {
-				m.Median = &types.Duration{}
-			}
-			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q1", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobsRetried", wireType)
 			}
-			var msglen int
+			m.JobsRetried = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3789,31 +13882,14 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JobsRetried |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLookout
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Q1 == nil {
-				m.Q1 = &types.Duration{}
-			}
-			if err := m.Q1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Q3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3840,68 +13916,18 @@ func (m *DurationStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Q3 == nil {
-				m.Q3 = &types.Duration{}
+			if m.WaitTime == nil {
+				m.WaitTime = &DurationDistribution{}
 			}
-			if err := m.Q3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WaitTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLookout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLookout
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLookout
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobSetsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3911,49 +13937,33 @@ func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			if m.RunTime == nil {
+				m.RunTime = &DurationDistribution{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RunTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NewestFirst = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -3963,65 +13973,29 @@ func (m *GetJobSetsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ActiveOnly = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLookout(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLookout
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Clusters = append(m.Clusters, &ClusterAnalytics{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobSetsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4048,8 +14022,8 @@ func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetInfos = append(m.JobSetInfos, &JobSetInfo{})
-			if err := m.JobSetInfos[len(m.JobSetInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outcomes = append(m.Outcomes, &OutcomePoint{})
+			if err := m.Outcomes[len(m.Outcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4074,7 +14048,7 @@ func (m *GetJobSetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSetComparison) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4097,15 +14071,15 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetComparison: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetComparison: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSet", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4133,65 +14107,57 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.JobSet = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRatioDelta", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NewestFirst = bool(v != 0)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SuccessRatioDelta = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobStates", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRatioDelta", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLookout
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FailureRatioDelta = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledRatioDelta", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLookout
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CancelledRatioDelta = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesPerJobDelta", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobStates = append(m.JobStates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RetriesPerJobDelta = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeP50Delta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -4201,29 +14167,33 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetIds = append(m.JobSetIds, string(dAtA[iNdEx:postIndex]))
+			if m.WaitTimeP50Delta == nil {
+				m.WaitTimeP50Delta = &types.Duration{}
+			}
+			if err := m.WaitTimeP50Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Take", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeP90Delta", wireType)
 			}
-			m.Take = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -4233,35 +14203,33 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Take |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
 			}
-			m.Skip = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLookout
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Skip |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WaitTimeP90Delta == nil {
+				m.WaitTimeP90Delta = &types.Duration{}
+			}
+			if err := m.WaitTimeP90Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTimeP50Delta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -4271,29 +14239,33 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			if m.RunTimeP50Delta == nil {
+				m.RunTimeP50Delta = &types.Duration{}
+			}
+			if err := m.RunTimeP50Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTimeP90Delta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLookout
@@ -4303,27 +14275,31 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLookout
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLookout
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.RunTimeP90Delta == nil {
+				m.RunTimeP90Delta = &types.Duration{}
+			}
+			if err := m.RunTimeP90Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTimeP99Delta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4350,103 +14326,12 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserAnnotations == nil {
-				m.UserAnnotations = make(map[string]string)
+			if m.RunTimeP99Delta == nil {
+				m.RunTimeP99Delta = &types.Duration{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowLookout
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLookout
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthLookout
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthLookout
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowLookout
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthLookout
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthLookout
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipLookout(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthLookout
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.RunTimeP99Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UserAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4469,7 +14354,7 @@ func (m *GetJobsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetJobSetAnalyticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4492,15 +14377,15 @@ func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJobsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJobSetAnalyticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJobsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJobSetAnalyticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4527,8 +14412,74 @@ func (m *GetJobsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfos = append(m.JobInfos, &JobInfo{})
-			if err := m.JobInfos[len(m.JobInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.JobSets = append(m.JobSets, &JobSetAnalytics{})
+			if err := m.JobSets[len(m.JobSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Baseline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparisons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLookout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLookout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLookout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comparisons = append(m.Comparisons, &JobSetComparison{})
+			if err := m.Comparisons[len(m.Comparisons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -210,6 +210,7 @@ type LeaseRequest struct {
 	ClusterLeasedReport ClusterLeasedReport          `protobuf:"bytes,4,opt,name=cluster_leased_report,json=clusterLeasedReport,proto3" json:"cluster_leased_report"`
 	MinimumJobSize      map[string]resource.Quantity `protobuf:"bytes,6,rep,name=minimum_job_size,json=minimumJobSize,proto3" json:"minimumJobSize,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Nodes               []NodeInfo                   `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes"`
+	Draining            bool                         `protobuf:"varint,9,opt,name=draining,proto3" json:"draining,omitempty"`
 }
 
 func (m *LeaseRequest) Reset()      { *m = LeaseRequest{} }
@@ -286,12 +287,20 @@ func (m *LeaseRequest) GetNodes() []NodeInfo {
 	return nil
 }
 
+func (m *LeaseRequest) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
 type NodeInfo struct {
 	Name                 string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Taints               []v1.Taint                   `protobuf:"bytes,2,rep,name=taints,proto3" json:"taints"`
 	Labels               map[string]string            `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	AllocatableResources map[string]resource.Quantity `protobuf:"bytes,4,rep,name=allocatable_resources,json=allocatableResources,proto3" json:"allocatableResources,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	AvailableResources   map[string]resource.Quantity `protobuf:"bytes,5,rep,name=available_resources,json=availableResources,proto3" json:"availableResources,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CachedImages         []string                     `protobuf:"bytes,6,rep,name=cached_images,json=cachedImages,proto3" json:"cachedImages,omitempty"`
 }
 
 func (m *NodeInfo) Reset()      { *m = NodeInfo{} }
@@ -361,6 +370,13 @@ func (m *NodeInfo) GetAvailableResources() map[string]resource.Quantity {
 	return nil
 }
 
+func (m *NodeInfo) GetCachedImages() []string {
+	if m != nil {
+		return m.CachedImages
+	}
+	return nil
+}
+
 type NodeType struct {
 	Taints               []v1.Taint                   `protobuf:"bytes,1,rep,name=taints,proto3" json:"taints"`
 	Labels               map[string]string            `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -427,6 +443,7 @@ type ClusterSchedulingInfoReport struct {
 	ReportTime     time.Time                    `protobuf:"bytes,2,opt,name=report_time,json=reportTime,proto3,stdtime" json:"report_time"`
 	NodeTypes      []*NodeType                  `protobuf:"bytes,5,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
 	MinimumJobSize map[string]resource.Quantity `protobuf:"bytes,6,rep,name=minimum_job_size,json=minimumJobSize,proto3" json:"minimumJobSize,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Draining       bool                         `protobuf:"varint,8,opt,name=draining,proto3" json:"draining,omitempty"`
 }
 
 func (m *ClusterSchedulingInfoReport) Reset()      { *m = ClusterSchedulingInfoReport{} }
@@ -496,6 +513,13 @@ func (m *ClusterSchedulingInfoReport) GetMinimumJobSize() map[string]resource.Qu
 	return nil
 }
 
+func (m *ClusterSchedulingInfoReport) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
 type QueueLeasedReport struct {
 	Name            string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	ResourcesLeased map[string]resource.Quantity `protobuf:"bytes,2,rep,name=resources_leased,json=resourcesLeased,proto3" json:"resourcesLeased,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -693,7 +717,8 @@ func (m *NodeLabeling) GetLabels() map[string]string {
 }
 
 type JobLease struct {
-	Job []*Job `protobuf:"bytes,1,rep,name=job,proto3" json:"job,omitempty"`
+	Job      []*Job `protobuf:"bytes,1,rep,name=job,proto3" json:"job,omitempty"`
+	Draining bool   `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
 }
 
 func (m *JobLease) Reset()      { *m = JobLease{} }
@@ -735,6 +760,13 @@ func (m *JobLease) GetJob() []*Job {
 	return nil
 }
 
+func (m *JobLease) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
 type IdList struct {
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -888,6 +920,57 @@ func (m *ReturnLeaseRequest) GetAvoidNodeLabels() *OrderedStringMap {
 	return nil
 }
 
+type ClusterDrainRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	Draining  bool   `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
+}
+
+func (m *ClusterDrainRequest) Reset()      { *m = ClusterDrainRequest{} }
+func (*ClusterDrainRequest) ProtoMessage() {}
+func (*ClusterDrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{13}
+}
+func (m *ClusterDrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterDrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterDrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterDrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterDrainRequest.Merge(m, src)
+}
+func (m *ClusterDrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterDrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterDrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterDrainRequest proto.InternalMessageInfo
+
+func (m *ClusterDrainRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *ClusterDrainRequest) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
 type StringKeyValuePair struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -896,7 +979,7 @@ type StringKeyValuePair struct {
 func (m *StringKeyValuePair) Reset()      { *m = StringKeyValuePair{} }
 func (*StringKeyValuePair) ProtoMessage() {}
 func (*StringKeyValuePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{13}
+	return fileDescriptor_d92c0c680df9617a, []int{14}
 }
 func (m *StringKeyValuePair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1029,7 @@ type OrderedStringMap struct {
 func (m *OrderedStringMap) Reset()      { *m = OrderedStringMap{} }
 func (*OrderedStringMap) ProtoMessage() {}
 func (*OrderedStringMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{14}
+	return fileDescriptor_d92c0c680df9617a, []int{15}
 }
 func (m *OrderedStringMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,6 +1093,7 @@ func init() {
 	proto.RegisterType((*IdList)(nil), "api.IdList")
 	proto.RegisterType((*RenewLeaseRequest)(nil), "api.RenewLeaseRequest")
 	proto.RegisterType((*ReturnLeaseRequest)(nil), "api.ReturnLeaseRequest")
+	proto.RegisterType((*ClusterDrainRequest)(nil), "api.ClusterDrainRequest")
 	proto.RegisterType((*StringKeyValuePair)(nil), "api.StringKeyValuePair")
 	proto.RegisterType((*OrderedStringMap)(nil), "api.OrderedStringMap")
 }
@@ -1017,98 +1101,102 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/queue.proto", fileDescriptor_d92c0c680df9617a) }
 
 var fileDescriptor_d92c0c680df9617a = []byte{
-	// 1446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xdd, 0x6e, 0xd4, 0xc6,
-	0x17, 0x8f, 0x77, 0x93, 0xfd, 0x38, 0x4b, 0xbe, 0x26, 0x09, 0x31, 0x1b, 0x58, 0x56, 0xfb, 0xd7,
-	0x9f, 0xa6, 0x2a, 0x78, 0x95, 0x94, 0xaa, 0x94, 0xb6, 0x48, 0x81, 0x44, 0x28, 0x29, 0x14, 0x70,
-	0x80, 0x2b, 0xa4, 0x95, 0xbd, 0x1e, 0x9c, 0x49, 0xbc, 0x1e, 0x33, 0xb6, 0x83, 0x96, 0x2b, 0x5e,
-	0xa0, 0x88, 0x27, 0xe8, 0x0b, 0xf4, 0x1d, 0x7a, 0xcd, 0x25, 0x97, 0x48, 0x95, 0xfa, 0x11, 0x1e,
-	0xa2, 0xea, 0x5d, 0x35, 0x1f, 0xf6, 0x7a, 0x3f, 0x52, 0x12, 0x68, 0x5a, 0xf5, 0xce, 0x33, 0xe7,
-	0x77, 0xce, 0x99, 0x33, 0xe7, 0x77, 0xce, 0x1c, 0xc3, 0x5c, 0xb0, 0xe7, 0x36, 0xad, 0x80, 0x34,
-	0x9f, 0xc4, 0x38, 0xc6, 0x46, 0xc0, 0x68, 0x44, 0x51, 0xde, 0x0a, 0x48, 0xf5, 0xbc, 0x4b, 0xa9,
-	0xeb, 0xe1, 0xa6, 0xd8, 0xb2, 0xe3, 0xc7, 0xcd, 0x88, 0x74, 0x70, 0x18, 0x59, 0x9d, 0x40, 0xa2,
-	0xaa, 0x8d, 0xbd, 0x2b, 0xa1, 0x41, 0xa8, 0xd0, 0x6e, 0x53, 0x86, 0x9b, 0xfb, 0x2b, 0x4d, 0x17,
-	0xfb, 0x98, 0x59, 0x11, 0x76, 0x14, 0xe6, 0x72, 0x0f, 0xd3, 0xb1, 0xda, 0x3b, 0xc4, 0xc7, 0xac,
-	0xdb, 0x4c, 0x5c, 0x32, 0x1c, 0xd2, 0x98, 0xb5, 0xf1, 0x90, 0xd6, 0x25, 0x97, 0x44, 0x3b, 0xb1,
-	0x6d, 0xb4, 0x69, 0xa7, 0xe9, 0x52, 0x97, 0xf6, 0xce, 0xc0, 0x57, 0x62, 0x21, 0xbe, 0x14, 0x7c,
-	0x69, 0xf0, 0xa4, 0xb8, 0x13, 0x44, 0x5d, 0x25, 0x9c, 0x4f, 0xbc, 0x85, 0xb1, 0xdd, 0x21, 0x91,
-	0xdc, 0x6d, 0xbc, 0x28, 0x42, 0x7e, 0x8b, 0xda, 0x68, 0x0a, 0x72, 0xc4, 0xd1, 0xb5, 0xba, 0xb6,
-	0x5c, 0x36, 0x73, 0xc4, 0x41, 0x4b, 0x50, 0x6e, 0x7b, 0x04, 0xfb, 0x51, 0x8b, 0x38, 0xfa, 0xa4,
-	0xd8, 0x2e, 0xc9, 0x8d, 0x4d, 0x07, 0x9d, 0x05, 0xd8, 0xa5, 0x76, 0x2b, 0xc4, 0x42, 0x9a, 0x93,
-	0xd2, 0x5d, 0x6a, 0x6f, 0x63, 0x2e, 0x9d, 0x87, 0x09, 0x71, 0x87, 0x7a, 0x5e, 0x08, 0xe4, 0x02,
-	0x9d, 0x85, 0xb2, 0x6f, 0x75, 0x70, 0x18, 0x58, 0x6d, 0xac, 0x17, 0x85, 0xa4, 0xb7, 0x81, 0x2e,
-	0x42, 0xc1, 0xb3, 0x6c, 0xec, 0x85, 0x7a, 0xb9, 0x9e, 0x5f, 0xae, 0xac, 0xce, 0x1b, 0x56, 0x40,
-	0x8c, 0x2d, 0x6a, 0x1b, 0xb7, 0xc4, 0xf6, 0x86, 0x1f, 0xb1, 0xae, 0xa9, 0x30, 0xe8, 0x4b, 0xa8,
-	0x58, 0xbe, 0x4f, 0x23, 0x2b, 0x22, 0xd4, 0x0f, 0x75, 0x10, 0x2a, 0x67, 0x52, 0x95, 0xb5, 0x9e,
-	0x4c, 0xea, 0x65, 0xd1, 0xe8, 0x21, 0xcc, 0x33, 0xfc, 0x24, 0x26, 0x0c, 0x3b, 0x2d, 0x9f, 0x3a,
-	0xb8, 0xa5, 0x1c, 0x57, 0x84, 0x95, 0x7a, 0x6a, 0xc5, 0x54, 0xa0, 0x6f, 0xa9, 0x83, 0x33, 0x87,
-	0xb8, 0x9e, 0xd3, 0x35, 0x13, 0xb1, 0x21, 0x21, 0x0f, 0x9b, 0x3e, 0xf5, 0x31, 0xd3, 0x4b, 0x32,
-	0x6c, 0xb1, 0x40, 0x5f, 0xc3, 0x92, 0x88, 0xbf, 0x25, 0x96, 0xe1, 0x0e, 0x09, 0x5a, 0x71, 0x88,
-	0x59, 0xcb, 0x65, 0x34, 0x0e, 0x42, 0x7d, 0xba, 0x9e, 0x5f, 0x2e, 0x9b, 0xba, 0x80, 0xdc, 0x49,
-	0x10, 0x0f, 0x42, 0xcc, 0x6e, 0x0a, 0x39, 0xaa, 0x42, 0x29, 0x60, 0x84, 0x32, 0x12, 0x75, 0xf5,
-	0xf1, 0xba, 0xb6, 0xac, 0x99, 0xe9, 0x1a, 0x5d, 0x85, 0x52, 0x40, 0x9d, 0x56, 0x18, 0xe0, 0xb6,
-	0x3e, 0x51, 0xd7, 0x96, 0x2b, 0xab, 0x4b, 0x86, 0x64, 0x99, 0x88, 0x81, 0x33, 0xd1, 0xd8, 0x5f,
-	0x31, 0xee, 0x52, 0x67, 0x3b, 0xc0, 0x6d, 0x71, 0xee, 0x62, 0x20, 0x17, 0xe8, 0x0a, 0x94, 0x13,
-	0xdd, 0x50, 0x3f, 0x25, 0x22, 0xff, 0x2b, 0x65, 0xb3, 0xa4, 0x14, 0x43, 0x74, 0x0d, 0x8a, 0x6d,
-	0x86, 0x39, 0x47, 0xf5, 0x82, 0x70, 0x5a, 0x35, 0x24, 0xeb, 0x8c, 0x84, 0x75, 0xc6, 0xfd, 0xa4,
-	0x3e, 0xae, 0x97, 0x5e, 0xfd, 0x7c, 0x7e, 0xec, 0xe5, 0x2f, 0xe7, 0x35, 0x33, 0x51, 0x42, 0x17,
-	0xa1, 0x48, 0x7c, 0x97, 0xe1, 0x30, 0xd4, 0xa7, 0x84, 0x5f, 0x24, 0x1c, 0x6e, 0xca, 0xbd, 0x1b,
-	0xd4, 0x7f, 0x4c, 0x5c, 0x33, 0x81, 0x20, 0x03, 0x4a, 0x21, 0x66, 0xfb, 0xa4, 0x8d, 0x43, 0x7d,
-	0x26, 0x03, 0xdf, 0x96, 0x9b, 0x0a, 0x9e, 0x62, 0xaa, 0x5f, 0x40, 0x25, 0x93, 0x2b, 0x34, 0x03,
-	0xf9, 0x3d, 0xdc, 0x55, 0xb4, 0xe6, 0x9f, 0x3c, 0x4b, 0xfb, 0x96, 0x17, 0x63, 0xc5, 0x5a, 0xb9,
-	0xb8, 0x9a, 0xbb, 0xa2, 0x55, 0xaf, 0xc1, 0xcc, 0x20, 0x71, 0x8e, 0xa5, 0xbf, 0x01, 0x8b, 0x87,
-	0x50, 0xe6, 0x38, 0x66, 0x1a, 0x3f, 0x8e, 0xc3, 0xa9, 0x5b, 0xd8, 0x0a, 0x31, 0x37, 0x86, 0xc3,
-	0x08, 0x9d, 0x03, 0x68, 0x7b, 0x71, 0x18, 0x61, 0xd6, 0x4a, 0x2b, 0xb4, 0xac, 0x76, 0x36, 0x1d,
-	0x84, 0x60, 0x3c, 0xa0, 0xd4, 0x53, 0xac, 0x13, 0xdf, 0x68, 0x1d, 0xca, 0x49, 0x4b, 0x09, 0xf5,
-	0x5c, 0x86, 0xd7, 0x59, 0xc3, 0x86, 0x99, 0x40, 0x24, 0xaf, 0xc7, 0x79, 0xae, 0xcc, 0x9e, 0x22,
-	0x32, 0x61, 0x21, 0x71, 0xec, 0x71, 0x3d, 0xa7, 0xc5, 0x70, 0x40, 0x59, 0x24, 0x88, 0x58, 0x59,
-	0xd5, 0x85, 0xc5, 0x1b, 0x12, 0x21, 0x0c, 0x3b, 0xa6, 0x90, 0x2b, 0x4b, 0x73, 0xed, 0x61, 0x11,
-	0x7a, 0x00, 0x33, 0x1d, 0xe2, 0x93, 0x4e, 0xdc, 0x69, 0x89, 0x0e, 0x42, 0x9e, 0x61, 0xbd, 0x20,
-	0x0e, 0xf8, 0xff, 0xe1, 0x03, 0xde, 0x96, 0xc8, 0x2d, 0x6a, 0x6f, 0x93, 0x67, 0x38, 0x7b, 0xca,
-	0xa9, 0x4e, 0x9f, 0x08, 0x7d, 0x0c, 0x13, 0xbc, 0x94, 0x43, 0xbd, 0x28, 0x6c, 0x4d, 0x0a, 0x5b,
-	0x3c, 0x0b, 0x9b, 0xfe, 0x63, 0xaa, 0x74, 0x24, 0xa2, 0xea, 0xc1, 0x54, 0x7f, 0xe0, 0x23, 0xb2,
-	0xb3, 0x9e, 0xcd, 0x4e, 0x65, 0xd5, 0xc8, 0x54, 0x46, 0xda, 0xbc, 0x8d, 0x60, 0xcf, 0x15, 0x6e,
-	0x92, 0x0b, 0x33, 0xee, 0xc5, 0x96, 0x1f, 0x91, 0xa8, 0x9b, 0x25, 0xc5, 0x13, 0x98, 0x1b, 0x11,
-	0xc5, 0x49, 0xba, 0x6c, 0xfc, 0x3e, 0x0e, 0xa5, 0x24, 0x74, 0xce, 0x0e, 0xde, 0x64, 0x95, 0x27,
-	0xf1, 0x8d, 0x3e, 0x87, 0x42, 0x64, 0x11, 0x3f, 0x4a, 0xa8, 0x71, 0x66, 0x54, 0xe1, 0xdf, 0xe7,
-	0x08, 0x75, 0x73, 0x0a, 0x8e, 0x56, 0xd2, 0x26, 0x9d, 0xcf, 0x74, 0xdc, 0xc4, 0xd7, 0xc8, 0x4e,
-	0x6d, 0xc3, 0x82, 0xe5, 0x79, 0xb4, 0x6d, 0x45, 0x96, 0xed, 0xe1, 0x56, 0x8f, 0x95, 0xe3, 0xc2,
-	0xc2, 0x47, 0xfd, 0x16, 0xd6, 0x7a, 0xd0, 0x91, 0xe4, 0x9c, 0xb7, 0x46, 0x00, 0xd0, 0x23, 0x98,
-	0xb3, 0xf6, 0x2d, 0xe2, 0x0d, 0x78, 0x98, 0xc8, 0xd0, 0xaa, 0xe7, 0x21, 0x01, 0x8e, 0xb4, 0x8f,
-	0xac, 0x21, 0xf1, 0x87, 0x74, 0x94, 0xa7, 0x70, 0xe6, 0xd0, 0x88, 0x4e, 0x94, 0x75, 0x31, 0x2c,
-	0x1e, 0x12, 0xe8, 0x89, 0x32, 0xef, 0x45, 0x5e, 0x32, 0xef, 0x7e, 0x37, 0xc8, 0xb2, 0x4c, 0x7b,
-	0x5f, 0x96, 0xe5, 0x06, 0x58, 0xc6, 0xed, 0x1e, 0x8f, 0x65, 0xf9, 0x01, 0x96, 0x09, 0x0b, 0xef,
-	0xc5, 0xb2, 0xff, 0x22, 0x0f, 0x1a, 0xdf, 0xe7, 0x61, 0x49, 0x35, 0xe8, 0xed, 0xf6, 0x0e, 0x76,
-	0x62, 0x8f, 0xf8, 0x2e, 0xaf, 0x03, 0xd5, 0x8d, 0x8f, 0xf8, 0xb4, 0x14, 0x33, 0x4f, 0xcb, 0x06,
-	0x54, 0xe4, 0x2b, 0xd0, 0xe2, 0x53, 0xb0, 0x3a, 0xe2, 0xd1, 0x46, 0x00, 0x90, 0x8a, 0x5c, 0x84,
-	0x2e, 0x02, 0x88, 0xd9, 0x2b, 0xea, 0x06, 0x69, 0xa9, 0x4e, 0xf6, 0xa5, 0xc9, 0x2c, 0xfb, 0xea,
-	0x2b, 0x44, 0xce, 0xa1, 0xaf, 0xc6, 0xe5, 0xec, 0x23, 0x34, 0x2a, 0xc6, 0xa3, 0x3f, 0x22, 0xff,
-	0x46, 0xaf, 0xfe, 0x43, 0x83, 0xd9, 0x7b, 0x7c, 0xf6, 0xeb, 0x7b, 0x24, 0x47, 0x35, 0xed, 0x47,
-	0x30, 0x93, 0xd2, 0x5a, 0x3d, 0xc7, 0xaa, 0x3e, 0x3e, 0x11, 0x6e, 0x86, 0xac, 0xf4, 0x9e, 0x77,
-	0xb9, 0x9b, 0x8d, 0x7c, 0x9a, 0xf5, 0xcb, 0xaa, 0x0c, 0xe6, 0x47, 0xc1, 0x4f, 0x34, 0xf6, 0x1f,
-	0x34, 0x98, 0x1b, 0x31, 0x3d, 0xbc, 0x8b, 0x94, 0x7f, 0x13, 0x01, 0x0d, 0x28, 0x88, 0xa1, 0x3b,
-	0xe9, 0x11, 0xa7, 0x47, 0xdf, 0xa2, 0xa9, 0x50, 0x8d, 0x57, 0x1a, 0x4c, 0xdf, 0xa0, 0x9d, 0x20,
-	0x8e, 0xd2, 0x02, 0x46, 0x37, 0xb3, 0x63, 0x96, 0xec, 0x72, 0xff, 0x93, 0x7c, 0xec, 0x07, 0xbe,
-	0x6b, 0xd2, 0xfa, 0x67, 0x67, 0x92, 0xc6, 0x73, 0x0d, 0x4e, 0xa5, 0x13, 0x2a, 0xf1, 0x5d, 0xf4,
-	0xd9, 0xc0, 0xbb, 0x7e, 0x2e, 0x2d, 0xc4, 0x04, 0x32, 0xaa, 0xeb, 0x7e, 0x40, 0x47, 0x6c, 0x5c,
-	0x80, 0xd2, 0x16, 0xb5, 0xc5, 0x45, 0xa3, 0x2a, 0xe4, 0x77, 0xa9, 0xad, 0xee, 0xaf, 0x94, 0xfc,
-	0x7e, 0x99, 0x7c, 0xb3, 0x51, 0x85, 0xc2, 0xa6, 0x73, 0x8b, 0x84, 0x11, 0xb7, 0x4e, 0x1c, 0x79,
-	0xcb, 0x65, 0x93, 0x7f, 0x36, 0xd6, 0x61, 0xd6, 0xc4, 0x3e, 0x7e, 0x7a, 0x9c, 0x61, 0x59, 0x59,
-	0xc9, 0xf5, 0xac, 0x7c, 0xa7, 0x01, 0x32, 0x71, 0x14, 0x33, 0xff, 0x38, 0x76, 0x16, 0xa0, 0xc0,
-	0x1b, 0x51, 0xfa, 0xf3, 0x3b, 0xb1, 0x4b, 0xed, 0x4d, 0x07, 0xad, 0xc1, 0xac, 0xb5, 0x4f, 0x49,
-	0xff, 0x7f, 0xa5, 0x9c, 0x96, 0x17, 0x44, 0x60, 0x77, 0x98, 0x83, 0x19, 0x76, 0xb6, 0x23, 0x46,
-	0x7c, 0xf7, 0xb6, 0x15, 0x98, 0xd3, 0x02, 0xdf, 0xfb, 0x5f, 0x68, 0x7c, 0x05, 0x48, 0x4a, 0xbf,
-	0xc1, 0xdd, 0x87, 0xfc, 0xbe, 0xee, 0x5a, 0x84, 0x1d, 0xf5, 0x6e, 0x1b, 0x1b, 0x30, 0x33, 0xe8,
-	0x02, 0xad, 0x40, 0x11, 0xfb, 0x11, 0x23, 0x29, 0x47, 0x17, 0xe5, 0x1f, 0xd4, 0x90, 0x17, 0x33,
-	0xc1, 0xad, 0xfe, 0xa4, 0xc1, 0xf4, 0x9a, 0xeb, 0x32, 0xec, 0xf2, 0x5f, 0x36, 0x51, 0x14, 0xe8,
-	0x12, 0x94, 0xc5, 0x0d, 0x6d, 0x51, 0x3b, 0x44, 0xb3, 0x43, 0xc3, 0x7a, 0x75, 0x32, 0xc9, 0x9c,
-	0xcc, 0xea, 0x0a, 0x40, 0x2f, 0x3b, 0x48, 0x56, 0xd7, 0x50, 0xba, 0xaa, 0x15, 0xf9, 0xef, 0x27,
-	0x53, 0x7c, 0x0d, 0x2a, 0x99, 0x4c, 0xa0, 0x45, 0xa5, 0x33, 0x98, 0x9b, 0xea, 0xe9, 0xa1, 0x62,
-	0xdf, 0xe8, 0x04, 0x51, 0x17, 0x5d, 0xe0, 0x2e, 0x79, 0xd1, 0xae, 0x53, 0x1f, 0xa3, 0xac, 0xe9,
-	0x3e, 0x3f, 0xd7, 0xeb, 0x6f, 0x7e, 0xab, 0x8d, 0x3d, 0x3f, 0xa8, 0x69, 0xaf, 0x0e, 0x6a, 0xda,
-	0xeb, 0x83, 0x9a, 0xf6, 0xeb, 0x41, 0x4d, 0x7b, 0xf9, 0xb6, 0x36, 0xf6, 0xfa, 0x6d, 0x6d, 0xec,
-	0xcd, 0xdb, 0xda, 0x98, 0x5d, 0x10, 0x96, 0x3f, 0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x1e,
-	0xee, 0x7b, 0x14, 0x12, 0x00, 0x00,
+	// 1519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdd, 0x6e, 0xd4, 0x46,
+	0x14, 0x8e, 0x77, 0x93, 0xfd, 0x39, 0x9b, 0xdf, 0x49, 0x42, 0xcc, 0x06, 0x96, 0xd5, 0xa2, 0xb6,
+	0xa9, 0x0a, 0x5e, 0x25, 0xa5, 0x2a, 0xa5, 0x2d, 0x6a, 0x42, 0x22, 0x94, 0x14, 0x0a, 0x38, 0xc0,
+	0x15, 0xd2, 0xca, 0x3f, 0x83, 0x33, 0x89, 0xd7, 0x63, 0xc6, 0x76, 0xd0, 0x72, 0xc5, 0x0b, 0x14,
+	0xf1, 0x00, 0xed, 0x13, 0xf4, 0x0d, 0xfa, 0x04, 0x5c, 0x72, 0x89, 0x7a, 0xd1, 0x9f, 0xf0, 0x14,
+	0xbd, 0xab, 0x66, 0xc6, 0xf6, 0x7a, 0x7f, 0x52, 0x58, 0x68, 0x5a, 0xf5, 0xce, 0x33, 0xe7, 0x3b,
+	0xe7, 0xcc, 0xcc, 0xf9, 0xce, 0x99, 0x33, 0x86, 0x79, 0xff, 0xc0, 0x69, 0x1a, 0x3e, 0x69, 0x3e,
+	0x8a, 0x70, 0x84, 0x35, 0x9f, 0xd1, 0x90, 0xa2, 0xbc, 0xe1, 0x93, 0xea, 0x39, 0x87, 0x52, 0xc7,
+	0xc5, 0x4d, 0x31, 0x65, 0x46, 0x0f, 0x9b, 0x21, 0x69, 0xe3, 0x20, 0x34, 0xda, 0xbe, 0x44, 0x55,
+	0x1b, 0x07, 0x97, 0x03, 0x8d, 0x50, 0xa1, 0x6d, 0x51, 0x86, 0x9b, 0x87, 0xab, 0x4d, 0x07, 0x7b,
+	0x98, 0x19, 0x21, 0xb6, 0x63, 0xcc, 0xa5, 0x2e, 0xa6, 0x6d, 0x58, 0x7b, 0xc4, 0xc3, 0xac, 0xd3,
+	0x4c, 0x5c, 0x32, 0x1c, 0xd0, 0x88, 0x59, 0x78, 0x40, 0xeb, 0xa2, 0x43, 0xc2, 0xbd, 0xc8, 0xd4,
+	0x2c, 0xda, 0x6e, 0x3a, 0xd4, 0xa1, 0xdd, 0x35, 0xf0, 0x91, 0x18, 0x88, 0xaf, 0x18, 0xbe, 0xdc,
+	0xbf, 0x52, 0xdc, 0xf6, 0xc3, 0x4e, 0x2c, 0x5c, 0x48, 0xbc, 0x05, 0x91, 0xd9, 0x26, 0xa1, 0x9c,
+	0x6d, 0x3c, 0x2b, 0x42, 0x7e, 0x87, 0x9a, 0x68, 0x1a, 0x72, 0xc4, 0x56, 0x95, 0xba, 0xb2, 0x52,
+	0xd6, 0x73, 0xc4, 0x46, 0xcb, 0x50, 0xb6, 0x5c, 0x82, 0xbd, 0xb0, 0x45, 0x6c, 0x75, 0x4a, 0x4c,
+	0x97, 0xe4, 0xc4, 0xb6, 0x8d, 0xce, 0x00, 0xec, 0x53, 0xb3, 0x15, 0x60, 0x21, 0xcd, 0x49, 0xe9,
+	0x3e, 0x35, 0x77, 0x31, 0x97, 0x2e, 0xc0, 0x84, 0x38, 0x43, 0x35, 0x2f, 0x04, 0x72, 0x80, 0xce,
+	0x40, 0xd9, 0x33, 0xda, 0x38, 0xf0, 0x0d, 0x0b, 0xab, 0x45, 0x21, 0xe9, 0x4e, 0xa0, 0x0b, 0x50,
+	0x70, 0x0d, 0x13, 0xbb, 0x81, 0x5a, 0xae, 0xe7, 0x57, 0x2a, 0x6b, 0x0b, 0x9a, 0xe1, 0x13, 0x6d,
+	0x87, 0x9a, 0xda, 0x0d, 0x31, 0xbd, 0xe5, 0x85, 0xac, 0xa3, 0xc7, 0x18, 0xf4, 0x25, 0x54, 0x0c,
+	0xcf, 0xa3, 0xa1, 0x11, 0x12, 0xea, 0x05, 0x2a, 0x08, 0x95, 0xd3, 0xa9, 0xca, 0x7a, 0x57, 0x26,
+	0xf5, 0xb2, 0x68, 0x74, 0x1f, 0x16, 0x18, 0x7e, 0x14, 0x11, 0x86, 0xed, 0x96, 0x47, 0x6d, 0xdc,
+	0x8a, 0x1d, 0x57, 0x84, 0x95, 0x7a, 0x6a, 0x45, 0x8f, 0x41, 0xdf, 0x51, 0x1b, 0x67, 0x16, 0xb1,
+	0x91, 0x53, 0x15, 0x1d, 0xb1, 0x01, 0x21, 0xdf, 0x36, 0x7d, 0xec, 0x61, 0xa6, 0x96, 0xe4, 0xb6,
+	0xc5, 0x00, 0x7d, 0x0d, 0xcb, 0x62, 0xff, 0x2d, 0x31, 0x0c, 0xf6, 0x88, 0xdf, 0x8a, 0x02, 0xcc,
+	0x5a, 0x0e, 0xa3, 0x91, 0x1f, 0xa8, 0x33, 0xf5, 0xfc, 0x4a, 0x59, 0x57, 0x05, 0xe4, 0x56, 0x82,
+	0xb8, 0x17, 0x60, 0x76, 0x5d, 0xc8, 0x51, 0x15, 0x4a, 0x3e, 0x23, 0x94, 0x91, 0xb0, 0xa3, 0x8e,
+	0xd7, 0x95, 0x15, 0x45, 0x4f, 0xc7, 0xe8, 0x0a, 0x94, 0x7c, 0x6a, 0xb7, 0x02, 0x1f, 0x5b, 0xea,
+	0x44, 0x5d, 0x59, 0xa9, 0xac, 0x2d, 0x6b, 0x92, 0x65, 0x62, 0x0f, 0x9c, 0x89, 0xda, 0xe1, 0xaa,
+	0x76, 0x9b, 0xda, 0xbb, 0x3e, 0xb6, 0xc4, 0xba, 0x8b, 0xbe, 0x1c, 0xa0, 0xcb, 0x50, 0x4e, 0x74,
+	0x03, 0x75, 0xb2, 0x9e, 0x7f, 0x83, 0xb2, 0x5e, 0x8a, 0x15, 0x03, 0x74, 0x15, 0x8a, 0x16, 0xc3,
+	0x9c, 0xa3, 0x6a, 0x41, 0x38, 0xad, 0x6a, 0x92, 0x75, 0x5a, 0xc2, 0x3a, 0xed, 0x6e, 0x92, 0x1f,
+	0x1b, 0xa5, 0x17, 0xbf, 0x9e, 0x1b, 0x7b, 0xfe, 0xdb, 0x39, 0x45, 0x4f, 0x94, 0xd0, 0x05, 0x28,
+	0x12, 0xcf, 0x61, 0x38, 0x08, 0xd4, 0x69, 0xe1, 0x17, 0x09, 0x87, 0xdb, 0x72, 0xee, 0x1a, 0xf5,
+	0x1e, 0x12, 0x47, 0x4f, 0x20, 0x48, 0x83, 0x52, 0x80, 0xd9, 0x21, 0xb1, 0x70, 0xa0, 0xce, 0x66,
+	0xe0, 0xbb, 0x72, 0x32, 0x86, 0xa7, 0x98, 0xea, 0x17, 0x50, 0xc9, 0xc4, 0x0a, 0xcd, 0x42, 0xfe,
+	0x00, 0x77, 0x62, 0x5a, 0xf3, 0x4f, 0x1e, 0xa5, 0x43, 0xc3, 0x8d, 0x70, 0xcc, 0x5a, 0x39, 0xb8,
+	0x92, 0xbb, 0xac, 0x54, 0xaf, 0xc2, 0x6c, 0x3f, 0x71, 0x46, 0xd2, 0xdf, 0x82, 0xa5, 0x63, 0x28,
+	0x33, 0x8a, 0x99, 0xc6, 0x2f, 0xe3, 0x30, 0x79, 0x03, 0x1b, 0x01, 0xe6, 0xc6, 0x70, 0x10, 0xa2,
+	0xb3, 0x00, 0x96, 0x1b, 0x05, 0x21, 0x66, 0xad, 0x34, 0x43, 0xcb, 0xf1, 0xcc, 0xb6, 0x8d, 0x10,
+	0x8c, 0xfb, 0x94, 0xba, 0x31, 0xeb, 0xc4, 0x37, 0xda, 0x84, 0x72, 0x52, 0x52, 0x02, 0x35, 0x97,
+	0xe1, 0x75, 0xd6, 0xb0, 0xa6, 0x27, 0x10, 0xc9, 0xeb, 0x71, 0x1e, 0x2b, 0xbd, 0xab, 0x88, 0x74,
+	0x58, 0x4c, 0x1c, 0xbb, 0x5c, 0xcf, 0x6e, 0x31, 0xec, 0x53, 0x16, 0x0a, 0x22, 0x56, 0xd6, 0x54,
+	0x61, 0xf1, 0x9a, 0x44, 0x08, 0xc3, 0xb6, 0x2e, 0xe4, 0xb1, 0xa5, 0x79, 0x6b, 0x50, 0x84, 0xee,
+	0xc1, 0x6c, 0x9b, 0x78, 0xa4, 0x1d, 0xb5, 0x5b, 0xa2, 0x82, 0x90, 0x27, 0x58, 0x2d, 0x88, 0x05,
+	0x7e, 0x30, 0xb8, 0xc0, 0x9b, 0x12, 0xb9, 0x43, 0xcd, 0x5d, 0xf2, 0x04, 0x67, 0x57, 0x39, 0xdd,
+	0xee, 0x11, 0xa1, 0x8f, 0x61, 0x82, 0xa7, 0x72, 0xa0, 0x16, 0x85, 0xad, 0x29, 0x61, 0x8b, 0x47,
+	0x61, 0xdb, 0x7b, 0x48, 0x63, 0x1d, 0x89, 0xe0, 0x19, 0x65, 0x33, 0x83, 0x78, 0xc4, 0x73, 0xd4,
+	0x72, 0x5d, 0x59, 0x29, 0xe9, 0xe9, 0xb8, 0xea, 0xc2, 0x74, 0xef, 0xa1, 0x0c, 0x89, 0xdc, 0x66,
+	0x36, 0x72, 0x95, 0x35, 0x2d, 0x93, 0x35, 0x69, 0x61, 0xd7, 0xfc, 0x03, 0x47, 0x2c, 0x21, 0x39,
+	0x4c, 0xed, 0x4e, 0x64, 0x78, 0x21, 0x09, 0x3b, 0x59, 0xc2, 0x3c, 0x82, 0xf9, 0x21, 0x3b, 0x3c,
+	0x49, 0x97, 0x8d, 0x1f, 0x26, 0xa0, 0x94, 0x1c, 0x0b, 0x67, 0x0e, 0x2f, 0xc0, 0xb1, 0x27, 0xf1,
+	0x8d, 0x3e, 0x87, 0x42, 0x68, 0x10, 0x2f, 0x4c, 0x68, 0x73, 0x7a, 0x58, 0x51, 0xb8, 0xcb, 0x11,
+	0xf1, 0xa9, 0xc6, 0x70, 0xb4, 0x9a, 0x16, 0xf0, 0x7c, 0xa6, 0x1a, 0x27, 0xbe, 0x86, 0x56, 0x71,
+	0x13, 0x16, 0x0d, 0xd7, 0xa5, 0x96, 0x11, 0x1a, 0xa6, 0x8b, 0x5b, 0x5d, 0xc6, 0x8e, 0x0b, 0x0b,
+	0x1f, 0xf5, 0x5a, 0x58, 0xef, 0x42, 0x87, 0x12, 0x77, 0xc1, 0x18, 0x02, 0x40, 0x0f, 0x60, 0xde,
+	0x38, 0x34, 0x88, 0xdb, 0xe7, 0x61, 0x22, 0x43, 0xb9, 0xae, 0x87, 0x04, 0x38, 0xd4, 0x3e, 0x32,
+	0x06, 0xc4, 0xe8, 0x3c, 0x4c, 0x59, 0x86, 0xb5, 0x87, 0xed, 0x16, 0x69, 0x1b, 0x0e, 0x0e, 0x04,
+	0x95, 0xcb, 0xfa, 0xa4, 0x9c, 0xdc, 0x16, 0x73, 0xef, 0x53, 0x92, 0x1e, 0xc3, 0xe9, 0x63, 0xb7,
+	0x7d, 0xa2, 0xd4, 0x8c, 0x60, 0xe9, 0x98, 0xd3, 0x38, 0x51, 0x7a, 0x3e, 0xcb, 0x4b, 0x7a, 0xde,
+	0xed, 0xf8, 0x59, 0x2a, 0x2a, 0xef, 0x4a, 0xc5, 0x5c, 0x1f, 0x15, 0xb9, 0xdd, 0xd1, 0xa8, 0x98,
+	0xef, 0xa3, 0xa2, 0xb0, 0xf0, 0x4e, 0x54, 0xfc, 0x3f, 0xf2, 0xa0, 0xf1, 0x73, 0x1e, 0x96, 0xe3,
+	0x0a, 0xbf, 0xcb, 0x29, 0x1d, 0xb9, 0xc4, 0x73, 0x78, 0xb2, 0xc4, 0xe5, 0xfc, 0x2d, 0xef, 0xa6,
+	0x62, 0xe6, 0x6e, 0xda, 0x82, 0x8a, 0xbc, 0x46, 0x5a, 0xbc, 0x8d, 0x56, 0x73, 0x23, 0xf4, 0x10,
+	0x20, 0x15, 0xb9, 0x08, 0x5d, 0x00, 0x10, 0xcd, 0x5b, 0xd8, 0xf1, 0xd3, 0x7c, 0x9e, 0xea, 0x09,
+	0x93, 0x5e, 0xf6, 0xe2, 0xaf, 0x00, 0xd9, 0xc7, 0x5e, 0x3b, 0x97, 0xb2, 0xb7, 0xd8, 0xb0, 0x3d,
+	0x8e, 0x70, 0x0b, 0x65, 0xaf, 0x96, 0x52, 0xdf, 0xd5, 0xf2, 0x1f, 0x14, 0xfb, 0x3f, 0x15, 0x98,
+	0xbb, 0x13, 0xe1, 0x08, 0xf7, 0xdc, 0xc0, 0xc3, 0xaa, 0xfe, 0x03, 0x98, 0x4d, 0x29, 0x1f, 0xdf,
+	0xf5, 0x71, 0xee, 0x7c, 0x22, 0xdc, 0x0c, 0x58, 0xe9, 0xf6, 0x0e, 0x72, 0x36, 0x7b, 0x2a, 0x33,
+	0xac, 0x57, 0x56, 0x65, 0xb0, 0x30, 0x0c, 0x7e, 0xa2, 0x7b, 0xff, 0x49, 0x81, 0xf9, 0x21, 0xad,
+	0xc9, 0x9b, 0x08, 0xfb, 0x0f, 0x91, 0x53, 0x83, 0x82, 0xe8, 0xe8, 0x93, 0xfa, 0x71, 0x6a, 0xf8,
+	0x29, 0xea, 0x31, 0xaa, 0xf1, 0x42, 0x81, 0x99, 0x6b, 0xb4, 0xed, 0x47, 0x61, 0x9a, 0xdc, 0xe8,
+	0x7a, 0xb6, 0x87, 0x93, 0x15, 0xf0, 0xbc, 0xe4, 0x6a, 0x2f, 0xf0, 0x4d, 0x6d, 0xdc, 0xbf, 0xdb,
+	0xd4, 0x34, 0x9e, 0x2a, 0x30, 0x99, 0xb6, 0xbf, 0xc4, 0x73, 0xd0, 0x67, 0x7d, 0x8d, 0xc1, 0xd9,
+	0x34, 0x49, 0x13, 0xc8, 0xb0, 0x8a, 0xfc, 0x1e, 0xd5, 0xb2, 0xb1, 0x01, 0xa5, 0x1d, 0x6a, 0x8a,
+	0x83, 0x46, 0x55, 0xc8, 0xef, 0x53, 0x33, 0x3e, 0xbf, 0x52, 0xf2, 0xb6, 0xd3, 0xf9, 0x64, 0x4f,
+	0xba, 0xe6, 0x7a, 0xd3, 0xb5, 0x51, 0x85, 0xc2, 0xb6, 0x7d, 0x83, 0x04, 0x21, 0xf7, 0x4c, 0x6c,
+	0x19, 0x81, 0xb2, 0xce, 0x3f, 0x1b, 0x9b, 0x30, 0xa7, 0x63, 0x0f, 0x3f, 0x1e, 0xa5, 0x4b, 0x8f,
+	0xad, 0xe4, 0xba, 0x56, 0xbe, 0x57, 0x00, 0xe9, 0x38, 0x8c, 0x98, 0x37, 0x8a, 0x9d, 0x45, 0x28,
+	0xf0, 0x02, 0x96, 0xbe, 0xba, 0x27, 0xf6, 0xa9, 0xb9, 0x6d, 0xa3, 0x75, 0x98, 0x33, 0x0e, 0x29,
+	0xe9, 0x7d, 0xd0, 0xca, 0x36, 0x7d, 0x51, 0x6c, 0xfa, 0x16, 0xb3, 0x31, 0xc3, 0xf6, 0x6e, 0xc8,
+	0x88, 0xe7, 0xdc, 0x34, 0x7c, 0x7d, 0x46, 0xe0, 0xbb, 0x0f, 0x95, 0xc6, 0xed, 0x34, 0x61, 0x36,
+	0xf9, 0x21, 0xbc, 0xe5, 0x7a, 0xfe, 0xee, 0x0c, 0xbf, 0x02, 0x24, 0xfd, 0x7d, 0x8b, 0x3b, 0xf7,
+	0x79, 0x74, 0x6e, 0x1b, 0x84, 0xbd, 0x6d, 0x24, 0x1b, 0x5b, 0x30, 0xdb, 0xbf, 0x68, 0xb4, 0x0a,
+	0x45, 0xec, 0x85, 0x8c, 0xa4, 0x19, 0xb1, 0x24, 0x1f, 0x83, 0x03, 0x5e, 0xf4, 0x04, 0xb7, 0xf6,
+	0x63, 0x0e, 0x66, 0xd6, 0x1d, 0x87, 0x61, 0x87, 0xbf, 0x3e, 0x45, 0x0a, 0xa2, 0x8b, 0x50, 0x16,
+	0x67, 0xbe, 0x43, 0xcd, 0x00, 0xcd, 0x0d, 0xbc, 0x3b, 0xaa, 0x53, 0x09, 0x4f, 0x24, 0x87, 0x56,
+	0x01, 0xba, 0xf1, 0x46, 0x32, 0x97, 0x07, 0x08, 0x50, 0xad, 0xc8, 0x67, 0xac, 0x24, 0xcd, 0x55,
+	0xa8, 0x64, 0x62, 0x8b, 0x96, 0x62, 0x9d, 0xfe, 0x68, 0x57, 0x4f, 0x0d, 0x94, 0x96, 0x2d, 0xfe,
+	0xc7, 0x06, 0x7d, 0x08, 0x20, 0x4b, 0xc4, 0x26, 0xf5, 0x30, 0xca, 0x9a, 0xee, 0xf5, 0xf3, 0x0d,
+	0x4c, 0x8a, 0x68, 0xc5, 0x91, 0x43, 0x3d, 0x6f, 0xb2, 0x6c, 0x1c, 0x8f, 0xf3, 0xb4, 0x51, 0x7f,
+	0xf5, 0x47, 0x6d, 0xec, 0xe9, 0x51, 0x4d, 0x79, 0x71, 0x54, 0x53, 0x5e, 0x1e, 0xd5, 0x94, 0xdf,
+	0x8f, 0x6a, 0xca, 0xf3, 0xd7, 0xb5, 0xb1, 0x97, 0xaf, 0x6b, 0x63, 0xaf, 0x5e, 0xd7, 0xc6, 0xcc,
+	0x82, 0xd0, 0xf8, 0xf4, 0xaf, 0x01, 0x00, 0xe8, 0xd5, 0x7d, 0x13, 0x21, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1127,6 +1215,7 @@ type AggregatedQueueClient interface {
 	RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*IdList, error)
 	ReturnLease(ctx context.Context, in *ReturnLeaseRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	ReportDone(ctx context.Context, in *IdList, opts ...grpc.CallOption) (*IdList, error)
+	DrainCluster(ctx context.Context, in *ClusterDrainRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type aggregatedQueueClient struct {
@@ -1173,12 +1262,22 @@ func (c *aggregatedQueueClient) ReportDone(ctx context.Context, in *IdList, opts
 	return out, nil
 }
 
+func (c *aggregatedQueueClient) DrainCluster(ctx context.Context, in *ClusterDrainRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.AggregatedQueue/DrainCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AggregatedQueueServer is the server API for AggregatedQueue service.
 type AggregatedQueueServer interface {
 	LeaseJobs(context.Context, *LeaseRequest) (*JobLease, error)
 	RenewLease(context.Context, *RenewLeaseRequest) (*IdList, error)
 	ReturnLease(context.Context, *ReturnLeaseRequest) (*types.Empty, error)
 	ReportDone(context.Context, *IdList) (*IdList, error)
+	DrainCluster(context.Context, *ClusterDrainRequest) (*types.Empty, error)
 }
 
 // UnimplementedAggregatedQueueServer can be embedded to have forward compatible implementations.
@@ -1197,6 +1296,9 @@ func (*UnimplementedAggregatedQueueServer) ReturnLease(ctx context.Context, req
 func (*UnimplementedAggregatedQueueServer) ReportDone(ctx context.Context, req *IdList) (*IdList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportDone not implemented")
 }
+func (*UnimplementedAggregatedQueueServer) DrainCluster(ctx context.Context, req *ClusterDrainRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainCluster not implemented")
+}
 
 func RegisterAggregatedQueueServer(s *grpc.Server, srv AggregatedQueueServer) {
 	s.RegisterService(&_AggregatedQueue_serviceDesc, srv)
@@ -1274,6 +1376,24 @@ func _AggregatedQueue_ReportDone_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AggregatedQueue_DrainCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatedQueueServer).DrainCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AggregatedQueue/DrainCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatedQueueServer).DrainCluster(ctx, req.(*ClusterDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AggregatedQueue_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.AggregatedQueue",
 	HandlerType: (*AggregatedQueueServer)(nil),
@@ -1294,6 +1414,10 @@ var _AggregatedQueue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReportDone",
 			Handler:    _AggregatedQueue_ReportDone_Handler,
 		},
+		{
+			MethodName: "DrainCluster",
+			Handler:    _AggregatedQueue_DrainCluster_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/queue.proto",
@@ -1520,6 +1644,16 @@ func (m *LeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Pool) > 0 {
 		i -= len(m.Pool)
 		copy(dAtA[i:], m.Pool)
@@ -1629,6 +1763,15 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CachedImages) > 0 {
+		for iNdEx := len(m.CachedImages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CachedImages[iNdEx])
+			copy(dAtA[i:], m.CachedImages[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.CachedImages[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.AvailableResources) > 0 {
 		for k := range m.AvailableResources {
 			v := m.AvailableResources[k]
@@ -1820,6 +1963,16 @@ func (m *ClusterSchedulingInfoReport) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Pool) > 0 {
 		i -= len(m.Pool)
 		copy(dAtA[i:], m.Pool)
@@ -2098,6 +2251,16 @@ func (m *JobLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Job) > 0 {
 		for iNdEx := len(m.Job) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2235,6 +2398,46 @@ func (m *ReturnLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterDrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterDrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterDrainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StringKeyValuePair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2450,6 +2653,9 @@ func (m *LeaseRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	if m.Draining {
+		n += 2
+	}
 	return n
 }
 
@@ -2495,6 +2701,12 @@ func (m *NodeInfo) Size() (n int) {
 			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
 		}
 	}
+	if len(m.CachedImages) > 0 {
+		for _, s := range m.CachedImages {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2561,6 +2773,9 @@ func (m *ClusterSchedulingInfoReport) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	if m.Draining {
+		n += 2
+	}
 	return n
 }
 
@@ -2654,6 +2869,9 @@ func (m *JobLease) Size() (n int) {
 			n += 1 + l + sovQueue(uint64(l))
 		}
 	}
+	if m.Draining {
+		n += 2
+	}
 	return n
 }
 
@@ -2712,6 +2930,22 @@ func (m *ReturnLeaseRequest) Size() (n int) {
 	return n
 }
 
+func (m *ClusterDrainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if m.Draining {
+		n += 2
+	}
+	return n
+}
+
 func (m *StringKeyValuePair) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2856,6 +3090,7 @@ func (this *LeaseRequest) String() string {
 		`MinimumJobSize:` + mapStringForMinimumJobSize + `,`,
 		`Nodes:` + repeatedStringForNodes + `,`,
 		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2905,6 +3140,7 @@ func (this *NodeInfo) String() string {
 		`Labels:` + mapStringForLabels + `,`,
 		`AllocatableResources:` + mapStringForAllocatableResources + `,`,
 		`AvailableResources:` + mapStringForAvailableResources + `,`,
+		`CachedImages:` + fmt.Sprintf("%v", this.CachedImages) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2971,6 +3207,7 @@ func (this *ClusterSchedulingInfoReport) String() string {
 		`NodeTypes:` + repeatedStringForNodeTypes + `,`,
 		`MinimumJobSize:` + mapStringForMinimumJobSize + `,`,
 		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3064,6 +3301,7 @@ func (this *JobLease) String() string {
 	repeatedStringForJob += "}"
 	s := strings.Join([]string{`&JobLease{`,
 		`Job:` + repeatedStringForJob + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3101,6 +3339,17 @@ func (this *ReturnLeaseRequest) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterDrainRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterDrainRequest{`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Draining:` + fmt.Sprintf("%v", this.Draining) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StringKeyValuePair) String() string {
 	if this == nil {
 		return "nil"
@@ -4390,6 +4639,26 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -4891,6 +5160,38 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.AvailableResources[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedImages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CachedImages = append(m.CachedImages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -5541,6 +5842,26 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 			}
 			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6341,6 +6662,26 @@ func (m *JobLease) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6708,6 +7049,108 @@ func (m *ReturnLeaseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterDrainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterDrainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterDrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Draining = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *StringKeyValuePair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -670,6 +670,7 @@ type Queue struct {
 	GroupOwners    []string             `protobuf:"bytes,4,rep,name=group_owners,json=groupOwners,proto3" json:"groupOwners,omitempty"`
 	ResourceLimits map[string]float64   `protobuf:"bytes,5,rep,name=resource_limits,json=resourceLimits,proto3" json:"resourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 	Permissions    []*Queue_Permissions `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Webhooks       []*Queue_Webhook     `protobuf:"bytes,7,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 }
 
 func (m *Queue) Reset()      { *m = Queue{} }
@@ -746,6 +747,13 @@ func (m *Queue) GetPermissions() []*Queue_Permissions {
 	return nil
 }
 
+func (m *Queue) GetWebhooks() []*Queue_Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
 type Queue_Permissions struct {
 	Subjects []*Queue_Permissions_Subject `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
 	Verbs    []string                     `protobuf:"bytes,2,rep,name=verbs,proto3" json:"verbs,omitempty"`
@@ -848,6 +856,74 @@ func (m *Queue_Permissions_Subject) GetName() string {
 	return ""
 }
 
+// Webhook receives job lifecycle notifications for the queue as signed JSON POST requests
+type Queue_Webhook struct {
+	Url        string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events     []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	SecretName string   `protobuf:"bytes,3,opt,name=secret_name,json=secretName,proto3" json:"secretName,omitempty"`
+	Format     string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (m *Queue_Webhook) Reset()      { *m = Queue_Webhook{} }
+func (*Queue_Webhook) ProtoMessage() {}
+func (*Queue_Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{9, 1}
+}
+func (m *Queue_Webhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Queue_Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Queue_Webhook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Queue_Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Queue_Webhook.Merge(m, src)
+}
+func (m *Queue_Webhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *Queue_Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Queue_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Queue_Webhook proto.InternalMessageInfo
+
+func (m *Queue_Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Queue_Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Queue_Webhook) GetSecretName() string {
+	if m != nil {
+		return m.SecretName
+	}
+	return ""
+}
+
+func (m *Queue_Webhook) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 // swagger:model
 type CancellationResult struct {
 	CancelledIds []string `protobuf:"bytes,1,rep,name=cancelled_ids,json=cancelledIds,proto3" json:"cancelledIds"`
@@ -1156,6 +1232,7 @@ func init() {
 	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
 	proto.RegisterType((*Queue_Permissions)(nil), "api.Queue.Permissions")
 	proto.RegisterType((*Queue_Permissions_Subject)(nil), "api.Queue.Permissions.Subject")
+	proto.RegisterType((*Queue_Webhook)(nil), "api.Queue.Webhook")
 	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
 	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
 	proto.RegisterType((*QueueInfoRequest)(nil), "api.QueueInfoRequest")
@@ -1167,100 +1244,104 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
 
 var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 1479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0xd7, 0x89, 0xa2, 0x44, 0xce, 0xe9, 0x0f, 0xbd, 0xa6, 0xa4, 0xf3, 0x49, 0xa5, 0xd8, 0x73,
-	0xdd, 0xb2, 0x42, 0x7b, 0x84, 0x54, 0x14, 0x95, 0x0d, 0xb4, 0x80, 0x2d, 0xcb, 0xb2, 0x54, 0xc3,
-	0x55, 0x4f, 0x6e, 0xeb, 0x17, 0x83, 0xb8, 0xe3, 0x8d, 0xd8, 0x93, 0xc8, 0xdb, 0xf3, 0xee, 0x9d,
-	0x0c, 0xb5, 0x28, 0x50, 0xe4, 0x29, 0x2f, 0x01, 0x82, 0xe4, 0xd3, 0x24, 0x9f, 0x20, 0x8f, 0x06,
-	0xf2, 0x62, 0x20, 0x40, 0x90, 0x48, 0x79, 0xca, 0x37, 0xc8, 0x5b, 0xb0, 0xbb, 0x77, 0xe4, 0x51,
-	0xa4, 0x64, 0x28, 0x79, 0xbb, 0x99, 0xfd, 0xcd, 0x6f, 0x67, 0x77, 0x7e, 0x33, 0x4b, 0x42, 0x35,
-	0x3a, 0xe9, 0x34, 0xdd, 0x28, 0x68, 0xf2, 0xc4, 0xeb, 0x05, 0xb1, 0x1d, 0x31, 0x1a, 0x53, 0x52,
-	0x70, 0xa3, 0xc0, 0x5c, 0xe9, 0x50, 0xda, 0xe9, 0x62, 0x53, 0xba, 0xbc, 0xe4, 0xa8, 0x89, 0xbd,
-	0x28, 0x3e, 0x53, 0x08, 0xd3, 0x3a, 0xd9, 0xe2, 0x76, 0x40, 0x65, 0x68, 0x9b, 0x32, 0x6c, 0x9e,
-	0x6e, 0x34, 0x3b, 0x18, 0x22, 0x73, 0x63, 0xf4, 0x53, 0xcc, 0x6a, 0x4a, 0x20, 0x30, 0x6e, 0x18,
-	0xd2, 0xd8, 0x8d, 0x03, 0x1a, 0xf2, 0x74, 0xf5, 0xf7, 0x9d, 0x20, 0xfe, 0x77, 0xe2, 0xd9, 0x6d,
-	0xda, 0x6b, 0x76, 0x68, 0x87, 0x0e, 0xf6, 0x11, 0x96, 0x34, 0xe4, 0x97, 0x82, 0x5b, 0x3f, 0x14,
-	0xa1, 0xba, 0x4f, 0xbd, 0x43, 0x99, 0xa6, 0x83, 0xaf, 0x13, 0xe4, 0xf1, 0x5e, 0x8c, 0x3d, 0x62,
-	0x42, 0x29, 0x62, 0x01, 0x65, 0x41, 0x7c, 0x66, 0x68, 0x75, 0xad, 0xa1, 0x39, 0x7d, 0x9b, 0xac,
-	0x42, 0x39, 0x74, 0x7b, 0xc8, 0x23, 0xb7, 0x8d, 0x46, 0xa1, 0xae, 0x35, 0xca, 0xce, 0xc0, 0x41,
-	0x56, 0xa0, 0xdc, 0xee, 0x06, 0x18, 0xc6, 0xad, 0xc0, 0x37, 0x4a, 0x72, 0xb5, 0xa4, 0x1c, 0x7b,
-	0x3e, 0xf9, 0x33, 0x4c, 0x77, 0x5d, 0x0f, 0xbb, 0xdc, 0x98, 0xaa, 0x17, 0x1a, 0xfa, 0xe6, 0x3d,
-	0xdb, 0x8d, 0x02, 0x7b, 0x5c, 0x06, 0xf6, 0x33, 0x89, 0xdb, 0x09, 0x63, 0x76, 0xe6, 0xa4, 0x41,
-	0xe4, 0x19, 0xe8, 0xb9, 0x23, 0x1b, 0x45, 0xc9, 0xb1, 0x7e, 0x35, 0xc7, 0xc3, 0x01, 0x58, 0x11,
-	0xe5, 0xc3, 0x49, 0x07, 0xaa, 0x0c, 0x5f, 0x27, 0x01, 0x43, 0xbf, 0x15, 0x52, 0x1f, 0x5b, 0x69,
-	0x6a, 0xd3, 0x92, 0x76, 0xe3, 0x6a, 0x5a, 0x27, 0x8d, 0x7a, 0x4e, 0x7d, 0xcc, 0xa5, 0xf9, 0x68,
-	0xd2, 0xd0, 0x1c, 0xc2, 0x46, 0x16, 0xc9, 0x03, 0x28, 0x45, 0xd4, 0x6f, 0xf1, 0x08, 0xdb, 0xc6,
-	0x64, 0x5d, 0x6b, 0xe8, 0x9b, 0x2b, 0xb6, 0xaa, 0xb4, 0xdc, 0x43, 0x54, 0xda, 0x3e, 0xdd, 0xb0,
-	0x0f, 0xa8, 0x7f, 0x18, 0x61, 0x5b, 0xd2, 0xcc, 0x44, 0xca, 0x20, 0x5b, 0x50, 0xce, 0x62, 0xb9,
-	0x31, 0x23, 0x33, 0xbb, 0x2e, 0xd8, 0x29, 0xa5, 0x81, 0x9c, 0xfc, 0x0e, 0x66, 0x82, 0xb0, 0xc3,
-	0x90, 0x73, 0xa3, 0x2c, 0xe3, 0x88, 0x0c, 0xd8, 0x53, 0xbe, 0x6d, 0x1a, 0x1e, 0x05, 0x1d, 0x27,
-	0x83, 0x10, 0x1b, 0x4a, 0x1c, 0xd9, 0x69, 0xd0, 0x46, 0x6e, 0x40, 0x0e, 0x7e, 0xa8, 0x9c, 0x29,
-	0xbc, 0x8f, 0x31, 0xef, 0x83, 0x9e, 0x3b, 0x3a, 0xa9, 0x40, 0xe1, 0x04, 0x95, 0x54, 0xca, 0x8e,
-	0xf8, 0x24, 0x55, 0x28, 0x9e, 0xba, 0xdd, 0x04, 0xe5, 0x89, 0xcb, 0x8e, 0x32, 0x1e, 0x4c, 0x6e,
-	0x69, 0xe6, 0x5f, 0xa0, 0x72, 0xb9, 0x30, 0x37, 0x8a, 0xdf, 0x81, 0xe5, 0x2b, 0x2a, 0x70, 0x13,
-	0x1a, 0xeb, 0xb3, 0x49, 0x98, 0x1b, 0xba, 0x0c, 0xd2, 0x80, 0xa9, 0xf8, 0x2c, 0x42, 0x19, 0x3e,
-	0xbf, 0x59, 0xc9, 0x5f, 0xd7, 0x8b, 0xb3, 0x08, 0x65, 0x61, 0x24, 0x42, 0xb0, 0x46, 0x94, 0xc5,
-	0xdc, 0x98, 0xac, 0x17, 0x1a, 0x73, 0x8e, 0x32, 0xc8, 0xce, 0xb0, 0x3c, 0x0b, 0xf2, 0x1a, 0xef,
-	0x8e, 0xde, 0xfa, 0x7b, 0x74, 0xb9, 0x06, 0x7a, 0xdc, 0xe5, 0x2d, 0x0c, 0x5d, 0xaf, 0x8b, 0xbe,
-	0x31, 0x55, 0xd7, 0x1a, 0x25, 0x07, 0x62, 0x71, 0x46, 0xe9, 0x91, 0x2d, 0x86, 0x2c, 0x6e, 0x89,
-	0xa6, 0x33, 0x8a, 0x69, 0x8b, 0x21, 0x8b, 0x9f, 0xbb, 0x3d, 0x24, 0x77, 0x61, 0x2e, 0xe1, 0xd8,
-	0x6a, 0x77, 0x13, 0x1e, 0x23, 0xdb, 0x3b, 0x30, 0xa6, 0x65, 0xfc, 0x6c, 0xc2, 0x71, 0x3b, 0xf3,
-	0xfd, 0xdc, 0x12, 0x58, 0x7f, 0x85, 0xb9, 0x21, 0x61, 0x90, 0x5f, 0x8d, 0xb9, 0xba, 0x14, 0x21,
-	0xae, 0xee, 0xba, 0x6b, 0xb3, 0x3e, 0xd2, 0xa0, 0x72, 0xb9, 0xcf, 0x04, 0xf4, 0x75, 0x82, 0x09,
-	0xa6, 0xf9, 0x28, 0x83, 0xac, 0x02, 0x1c, 0x53, 0xaf, 0xc5, 0x51, 0x4e, 0x17, 0x95, 0x56, 0xe9,
-	0x98, 0x7a, 0x87, 0x28, 0xa6, 0xcb, 0x0e, 0xdc, 0x12, 0xab, 0x4c, 0x51, 0xb4, 0x82, 0x18, 0x7b,
-	0x59, 0x15, 0xee, 0x5c, 0xd9, 0xcd, 0xce, 0xc2, 0x31, 0xf5, 0x72, 0x36, 0xb7, 0x5e, 0xc9, 0x74,
-	0xb6, 0xdd, 0xb0, 0x8d, 0xdd, 0x2c, 0x9d, 0x45, 0x98, 0x16, 0xd4, 0x81, 0x9f, 0xe5, 0x73, 0x4c,
-	0xbd, 0x3d, 0xff, 0x3d, 0xf9, 0xf4, 0xcf, 0x50, 0xc8, 0x9d, 0xc1, 0xfa, 0x50, 0x83, 0xa5, 0x7d,
-	0xb1, 0x65, 0x3a, 0x50, 0x83, 0xff, 0x60, 0xb6, 0xcb, 0x32, 0xcc, 0xa8, 0x5d, 0xb8, 0xa1, 0xd5,
-	0x0b, 0x8d, 0xb2, 0x33, 0x2d, 0xb7, 0xe1, 0x3f, 0x65, 0x1f, 0xf2, 0x4b, 0x98, 0x0d, 0xf1, 0x4d,
-	0xab, 0x3f, 0xc6, 0xa7, 0xe4, 0x18, 0xd7, 0x43, 0x7c, 0x73, 0x90, 0xba, 0xac, 0xaf, 0x34, 0x58,
-	0x1e, 0x49, 0x85, 0x47, 0x34, 0xe4, 0x48, 0x62, 0x30, 0xd8, 0xc0, 0x2f, 0x85, 0xd2, 0x62, 0xc8,
-	0x93, 0x6e, 0xac, 0x92, 0xd3, 0x37, 0xef, 0x67, 0x77, 0x3a, 0x2e, 0xde, 0x76, 0x2e, 0x05, 0x3b,
-	0x2a, 0x56, 0xe9, 0x7d, 0x99, 0x8d, 0x5f, 0x35, 0xf7, 0x61, 0xf5, 0xba, 0xc0, 0x1b, 0x89, 0xf4,
-	0x31, 0x2c, 0xe6, 0x0a, 0xae, 0xd2, 0x92, 0x8f, 0xdb, 0x15, 0xc5, 0xac, 0x42, 0x11, 0x19, 0xa3,
-	0x2c, 0x63, 0x92, 0x86, 0xf5, 0x0a, 0x6e, 0x8d, 0xb0, 0x90, 0xa7, 0x40, 0x94, 0xd2, 0x94, 0x9d,
-	0x4a, 0x4d, 0x5d, 0x8b, 0x79, 0x59, 0x6a, 0x83, 0x9d, 0x9d, 0x8a, 0xd4, 0xda, 0xc0, 0xc1, 0xad,
-	0x8b, 0x02, 0x14, 0xff, 0x2e, 0xeb, 0x45, 0x60, 0x4a, 0x36, 0xb4, 0xca, 0x49, 0x7e, 0x93, 0xdf,
-	0xc0, 0x42, 0x56, 0xbf, 0xd6, 0x91, 0xdb, 0x8e, 0xd3, 0xe4, 0x34, 0x67, 0x3e, 0x73, 0x3f, 0x91,
-	0x5e, 0x31, 0x33, 0x12, 0x8e, 0xac, 0x45, 0xdf, 0x84, 0xc8, 0x94, 0xe8, 0xcb, 0x0e, 0x08, 0xd7,
-	0xdf, 0xa4, 0x47, 0xa8, 0xa1, 0xc3, 0x68, 0x12, 0x65, 0x88, 0x29, 0x89, 0xd0, 0xa5, 0x2f, 0x85,
-	0xec, 0xc2, 0x02, 0x43, 0x4e, 0x13, 0xd6, 0xc6, 0x56, 0x37, 0xe8, 0x05, 0x71, 0xf6, 0xc2, 0xd6,
-	0xe4, 0x89, 0x64, 0x96, 0xb6, 0x93, 0x22, 0x9e, 0x49, 0x80, 0xaa, 0xe6, 0x3c, 0x1b, 0x72, 0x92,
-	0x2d, 0xd0, 0x23, 0x64, 0xbd, 0x80, 0x73, 0x39, 0x07, 0xd5, 0x7b, 0xba, 0x94, 0x23, 0x39, 0x18,
-	0xac, 0x3a, 0x79, 0xa8, 0xf9, 0x89, 0x06, 0x7a, 0x6e, 0x51, 0xbc, 0x9c, 0x3c, 0xf1, 0x8e, 0xb1,
-	0xdd, 0x17, 0x5d, 0x6d, 0x3c, 0x8d, 0x7d, 0xa8, 0x60, 0x4e, 0x1f, 0x2f, 0x85, 0x81, 0xcc, 0x53,
-	0xc3, 0x46, 0x08, 0x43, 0x18, 0xe6, 0x06, 0xcc, 0xa4, 0x50, 0x71, 0xe1, 0x27, 0x41, 0x98, 0x89,
-	0x40, 0x7e, 0xf7, 0x8b, 0x30, 0x39, 0x28, 0x82, 0xf9, 0x10, 0x6e, 0x8f, 0x39, 0xf5, 0xfb, 0xa4,
-	0xa8, 0x0d, 0xcf, 0x4b, 0xa2, 0xe6, 0x49, 0x37, 0x27, 0x69, 0xf2, 0x47, 0x98, 0x6b, 0x2b, 0x2f,
-	0xfa, 0x83, 0xa6, 0x7f, 0x54, 0xf9, 0xfe, 0xeb, 0xb5, 0xd9, 0xfe, 0xc2, 0x9e, 0xcf, 0x9d, 0x21,
-	0xcb, 0xba, 0x07, 0x0b, 0xf2, 0xfc, 0xbb, 0xd8, 0x9f, 0x96, 0x63, 0xb4, 0x63, 0xfd, 0x1a, 0x2a,
-	0x12, 0xb6, 0x17, 0x1e, 0xd1, 0xeb, 0x70, 0x0d, 0x20, 0x12, 0xf7, 0x18, 0xbb, 0x18, 0xe3, 0x75,
-	0xc8, 0x97, 0x50, 0xee, 0x33, 0x8e, 0x95, 0xeb, 0x9f, 0x60, 0xc1, 0x6d, 0xc7, 0xc1, 0x29, 0xb6,
-	0xd2, 0x69, 0xa5, 0x2e, 0x5f, 0xdf, 0x5c, 0xe8, 0xf7, 0x04, 0xc6, 0x32, 0x9f, 0x39, 0x85, 0x53,
-	0x1e, 0x6e, 0x79, 0x00, 0x83, 0xc5, 0xb1, 0xd4, 0x6b, 0xa0, 0xcb, 0xb1, 0xe6, 0x0b, 0x6a, 0x2e,
-	0x6f, 0xb8, 0xe8, 0x80, 0x72, 0xed, 0x53, 0x4f, 0xbe, 0x9a, 0x5d, 0x74, 0x79, 0x06, 0x28, 0x28,
-	0x80, 0x72, 0x09, 0xc0, 0xba, 0x09, 0x7a, 0xee, 0x31, 0x27, 0x3a, 0xcc, 0xa4, 0x66, 0x65, 0x62,
-	0xfd, 0xb7, 0xa0, 0xe7, 0x5e, 0x2b, 0x32, 0x0b, 0x25, 0xf1, 0xcb, 0xe2, 0x80, 0xb2, 0xb8, 0x32,
-	0x21, 0xac, 0xa7, 0xe8, 0xfa, 0x5d, 0x01, 0xd5, 0x36, 0x3f, 0x2f, 0xc2, 0xb4, 0xea, 0x6c, 0xf2,
-	0x4f, 0x00, 0xf5, 0x25, 0x13, 0x58, 0x1c, 0xfb, 0xc4, 0x98, 0x4b, 0xe3, 0xc7, 0x81, 0x75, 0xe7,
-	0x83, 0x2f, 0xbf, 0xfb, 0x74, 0xf2, 0xb6, 0x35, 0x2f, 0x7e, 0xe4, 0x1f, 0x53, 0x2f, 0xfd, 0xaf,
-	0xf0, 0x40, 0x5b, 0x27, 0xff, 0x02, 0x50, 0x6a, 0x19, 0xe6, 0x1d, 0x7a, 0x91, 0xcc, 0x65, 0xe9,
-	0x1e, 0x55, 0xd5, 0x28, 0xb1, 0x12, 0x8f, 0x20, 0x0e, 0xa1, 0x92, 0x9f, 0xd5, 0x92, 0x7e, 0x65,
-	0xfc, 0x14, 0x57, 0x9b, 0xac, 0x5e, 0x37, 0xe2, 0xad, 0x35, 0xb9, 0xd3, 0x1d, 0xab, 0x9a, 0xed,
-	0x94, 0x9b, 0xea, 0x28, 0xf6, 0xdb, 0x05, 0x7d, 0x9b, 0xa1, 0x1b, 0xa3, 0x9a, 0x70, 0x30, 0xe8,
-	0x5d, 0x73, 0xc9, 0x56, 0xff, 0x63, 0xec, 0xec, 0x0f, 0x8a, 0xbd, 0x23, 0xfe, 0x08, 0x59, 0x55,
-	0xc9, 0x39, 0x6f, 0x95, 0x05, 0xa7, 0x2c, 0xaf, 0x20, 0x7a, 0x0e, 0xfa, 0x3f, 0x22, 0xff, 0x46,
-	0x44, 0x2b, 0x92, 0x68, 0xd1, 0xac, 0xf4, 0x89, 0x9a, 0xff, 0x15, 0x4a, 0xfa, 0x9f, 0xe0, 0x7b,
-	0x09, 0xba, 0x92, 0xbb, 0xe2, 0x5b, 0x1e, 0xf0, 0x0d, 0x75, 0xc1, 0x95, 0xe4, 0x86, 0x24, 0x27,
-	0xeb, 0x23, 0xe4, 0xe4, 0x09, 0x94, 0x76, 0x31, 0x56, 0xb4, 0xd5, 0x01, 0xed, 0xa0, 0x57, 0xcd,
-	0x5c, 0xf2, 0x19, 0x0f, 0x19, 0xe5, 0x79, 0x01, 0xb3, 0x19, 0x8f, 0xec, 0x89, 0xc5, 0x41, 0x54,
-	0xae, 0xa1, 0xcd, 0xf9, 0x61, 0xb7, 0xf5, 0x0b, 0x49, 0xb8, 0x4c, 0x16, 0x2f, 0x13, 0x36, 0x83,
-	0xf0, 0x88, 0x3e, 0xaa, 0xbf, 0xfb, 0xb6, 0x36, 0xf1, 0xff, 0xf3, 0x9a, 0xf6, 0xc5, 0x79, 0x4d,
-	0x7b, 0x7b, 0x5e, 0xd3, 0xbe, 0x39, 0xaf, 0x69, 0x1f, 0x5f, 0xd4, 0x26, 0xde, 0x5e, 0xd4, 0x26,
-	0xde, 0x5d, 0xd4, 0x26, 0xbc, 0x69, 0x79, 0xd2, 0x3f, 0xfc, 0x18, 0x00, 0x00, 0xff, 0xff, 0x5c,
-	0x4b, 0x7f, 0xc3, 0xc3, 0x0e, 0x00, 0x00,
+	// 1547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x5f, 0x6f, 0xe3, 0x58,
+	0x15, 0xaf, 0x9b, 0x26, 0x4d, 0x8e, 0xfb, 0x27, 0x7b, 0x37, 0x6d, 0x3d, 0x6e, 0xc9, 0x04, 0x2f,
+	0x0b, 0xa1, 0x02, 0x47, 0x53, 0x84, 0x98, 0x1d, 0x09, 0xa4, 0x9d, 0xd9, 0xee, 0x6c, 0x87, 0xd1,
+	0x50, 0xdc, 0x85, 0xdd, 0x97, 0x55, 0x64, 0xc7, 0xa7, 0x59, 0xb7, 0x8e, 0xaf, 0xe7, 0xde, 0xeb,
+	0x56, 0x05, 0x21, 0x01, 0x4f, 0xbc, 0x20, 0x21, 0xf8, 0x34, 0xf0, 0x09, 0x78, 0x5c, 0x89, 0x97,
+	0x95, 0x90, 0x10, 0x74, 0x78, 0xe2, 0x1b, 0xf0, 0x86, 0xee, 0xbd, 0x76, 0xec, 0xb4, 0x69, 0xab,
+	0xb2, 0x6f, 0x39, 0xe7, 0xfe, 0xce, 0xef, 0x9c, 0x7b, 0xcf, 0xbf, 0x18, 0x3a, 0xe9, 0xe9, 0x78,
+	0xe0, 0xa7, 0xd1, 0x80, 0x67, 0xc1, 0x24, 0x12, 0x6e, 0xca, 0xa8, 0xa0, 0xa4, 0xe6, 0xa7, 0x91,
+	0xbd, 0x3d, 0xa6, 0x74, 0x1c, 0xe3, 0x40, 0xa9, 0x82, 0xec, 0x78, 0x80, 0x93, 0x54, 0x5c, 0x68,
+	0x84, 0xed, 0x9c, 0x3e, 0xe6, 0x6e, 0x44, 0x95, 0xe9, 0x88, 0x32, 0x1c, 0x9c, 0x3d, 0x1a, 0x8c,
+	0x31, 0x41, 0xe6, 0x0b, 0x0c, 0x73, 0xcc, 0x4e, 0x4e, 0x20, 0x31, 0x7e, 0x92, 0x50, 0xe1, 0x8b,
+	0x88, 0x26, 0x3c, 0x3f, 0xfd, 0xee, 0x38, 0x12, 0x9f, 0x67, 0x81, 0x3b, 0xa2, 0x93, 0xc1, 0x98,
+	0x8e, 0x69, 0xe9, 0x47, 0x4a, 0x4a, 0x50, 0xbf, 0x34, 0xdc, 0xf9, 0x6f, 0x1d, 0x3a, 0x2f, 0x68,
+	0x70, 0xa4, 0xc2, 0xf4, 0xf0, 0x75, 0x86, 0x5c, 0x1c, 0x08, 0x9c, 0x10, 0x1b, 0x9a, 0x29, 0x8b,
+	0x28, 0x8b, 0xc4, 0x85, 0x65, 0xf4, 0x8c, 0xbe, 0xe1, 0x4d, 0x65, 0xb2, 0x03, 0xad, 0xc4, 0x9f,
+	0x20, 0x4f, 0xfd, 0x11, 0x5a, 0xb5, 0x9e, 0xd1, 0x6f, 0x79, 0xa5, 0x82, 0x6c, 0x43, 0x6b, 0x14,
+	0x47, 0x98, 0x88, 0x61, 0x14, 0x5a, 0x4d, 0x75, 0xda, 0xd4, 0x8a, 0x83, 0x90, 0xfc, 0x10, 0x1a,
+	0xb1, 0x1f, 0x60, 0xcc, 0xad, 0xa5, 0x5e, 0xad, 0x6f, 0xee, 0xbd, 0xeb, 0xfa, 0x69, 0xe4, 0xce,
+	0x8b, 0xc0, 0x7d, 0xa9, 0x70, 0xfb, 0x89, 0x60, 0x17, 0x5e, 0x6e, 0x44, 0x5e, 0x82, 0x59, 0xb9,
+	0xb2, 0x55, 0x57, 0x1c, 0xbb, 0x37, 0x73, 0xbc, 0x5f, 0x82, 0x35, 0x51, 0xd5, 0x9c, 0x8c, 0xa1,
+	0xc3, 0xf0, 0x75, 0x16, 0x31, 0x0c, 0x87, 0x09, 0x0d, 0x71, 0x98, 0x87, 0xd6, 0x50, 0xb4, 0x8f,
+	0x6e, 0xa6, 0xf5, 0x72, 0xab, 0x57, 0x34, 0xc4, 0x4a, 0x98, 0x4f, 0x17, 0x2d, 0xc3, 0x23, 0xec,
+	0xda, 0x21, 0x79, 0x02, 0xcd, 0x94, 0x86, 0x43, 0x9e, 0xe2, 0xc8, 0x5a, 0xec, 0x19, 0x7d, 0x73,
+	0x6f, 0xdb, 0xd5, 0x99, 0x56, 0x3e, 0x64, 0xa6, 0xdd, 0xb3, 0x47, 0xee, 0x21, 0x0d, 0x8f, 0x52,
+	0x1c, 0x29, 0x9a, 0xe5, 0x54, 0x0b, 0xe4, 0x31, 0xb4, 0x0a, 0x5b, 0x6e, 0x2d, 0xf7, 0x6a, 0x77,
+	0x18, 0x7b, 0xcd, 0xdc, 0x90, 0x93, 0xef, 0xc0, 0x72, 0x94, 0x8c, 0x19, 0x72, 0x6e, 0xb5, 0x94,
+	0x1d, 0x51, 0x06, 0x07, 0x5a, 0xf7, 0x8c, 0x26, 0xc7, 0xd1, 0xd8, 0x2b, 0x20, 0xc4, 0x85, 0x26,
+	0x47, 0x76, 0x16, 0x8d, 0x90, 0x5b, 0x50, 0x81, 0x1f, 0x69, 0x65, 0x0e, 0x9f, 0x62, 0xec, 0xf7,
+	0xc0, 0xac, 0x5c, 0x9d, 0xb4, 0xa1, 0x76, 0x8a, 0xba, 0x54, 0x5a, 0x9e, 0xfc, 0x49, 0x3a, 0x50,
+	0x3f, 0xf3, 0xe3, 0x0c, 0xd5, 0x8d, 0x5b, 0x9e, 0x16, 0x9e, 0x2c, 0x3e, 0x36, 0xec, 0x1f, 0x41,
+	0xfb, 0x6a, 0x62, 0xee, 0x65, 0xbf, 0x0f, 0x5b, 0x37, 0x64, 0xe0, 0x3e, 0x34, 0xce, 0x9f, 0x17,
+	0x61, 0x75, 0xe6, 0x31, 0x48, 0x1f, 0x96, 0xc4, 0x45, 0x8a, 0xca, 0x7c, 0x6d, 0xaf, 0x5d, 0x7d,
+	0xae, 0x8f, 0x2f, 0x52, 0x54, 0x89, 0x51, 0x08, 0xc9, 0x9a, 0x52, 0x26, 0xb8, 0xb5, 0xd8, 0xab,
+	0xf5, 0x57, 0x3d, 0x2d, 0x90, 0xfd, 0xd9, 0xf2, 0xac, 0xa9, 0x67, 0x7c, 0xe7, 0xfa, 0xab, 0xdf,
+	0x51, 0x97, 0x0f, 0xc1, 0x14, 0x31, 0x1f, 0x62, 0xe2, 0x07, 0x31, 0x86, 0xd6, 0x52, 0xcf, 0xe8,
+	0x37, 0x3d, 0x10, 0xf2, 0x8e, 0x4a, 0xa3, 0x5a, 0x0c, 0x99, 0x18, 0xca, 0xa6, 0xb3, 0xea, 0x79,
+	0x8b, 0x21, 0x13, 0xaf, 0xfc, 0x09, 0x92, 0x77, 0x60, 0x35, 0xe3, 0x38, 0x1c, 0xc5, 0x19, 0x17,
+	0xc8, 0x0e, 0x0e, 0xad, 0x86, 0xb2, 0x5f, 0xc9, 0x38, 0x3e, 0x2b, 0x74, 0x5f, 0x35, 0x05, 0xce,
+	0x8f, 0x61, 0x75, 0xa6, 0x30, 0xc8, 0x37, 0xe6, 0x3c, 0x5d, 0x8e, 0x90, 0x4f, 0x77, 0xdb, 0xb3,
+	0x39, 0xbf, 0x37, 0xa0, 0x7d, 0xb5, 0xcf, 0x24, 0xf4, 0x75, 0x86, 0x19, 0xe6, 0xf1, 0x68, 0x81,
+	0xec, 0x00, 0x9c, 0xd0, 0x60, 0xc8, 0x51, 0x4d, 0x17, 0x1d, 0x56, 0xf3, 0x84, 0x06, 0x47, 0x28,
+	0xa7, 0xcb, 0x3e, 0xbc, 0x25, 0x4f, 0x99, 0xa6, 0x18, 0x46, 0x02, 0x27, 0x45, 0x16, 0x1e, 0xdc,
+	0xd8, 0xcd, 0xde, 0xfa, 0x09, 0x0d, 0x2a, 0x32, 0x77, 0x3e, 0x53, 0xe1, 0x3c, 0xf3, 0x93, 0x11,
+	0xc6, 0x45, 0x38, 0x1b, 0xd0, 0x90, 0xd4, 0x51, 0x58, 0xc4, 0x73, 0x42, 0x83, 0x83, 0xf0, 0x8e,
+	0x78, 0xa6, 0x77, 0xa8, 0x55, 0xee, 0xe0, 0xfc, 0xce, 0x80, 0xcd, 0x17, 0xd2, 0x65, 0x3e, 0x50,
+	0xa3, 0x5f, 0x60, 0xe1, 0x65, 0x0b, 0x96, 0xb5, 0x17, 0x6e, 0x19, 0xbd, 0x5a, 0xbf, 0xe5, 0x35,
+	0x94, 0x1b, 0xfe, 0xff, 0xf8, 0x21, 0x5f, 0x87, 0x95, 0x04, 0xcf, 0x87, 0xd3, 0x31, 0xbe, 0xa4,
+	0xc6, 0xb8, 0x99, 0xe0, 0xf9, 0x61, 0xae, 0x72, 0xfe, 0x6e, 0xc0, 0xd6, 0xb5, 0x50, 0x78, 0x4a,
+	0x13, 0x8e, 0x44, 0x80, 0xc5, 0x4a, 0xbd, 0x2a, 0x94, 0x21, 0x43, 0x9e, 0xc5, 0x42, 0x07, 0x67,
+	0xee, 0xbd, 0x57, 0xbc, 0xe9, 0x3c, 0x7b, 0xd7, 0xbb, 0x62, 0xec, 0x69, 0x5b, 0x5d, 0xef, 0x5b,
+	0x6c, 0xfe, 0xa9, 0xfd, 0x02, 0x76, 0x6e, 0x33, 0xbc, 0x57, 0x91, 0x7e, 0x00, 0x1b, 0x95, 0x84,
+	0xeb, 0xb0, 0xd4, 0x72, 0xbb, 0x21, 0x99, 0x1d, 0xa8, 0x23, 0x63, 0x94, 0x15, 0x4c, 0x4a, 0x70,
+	0x3e, 0x83, 0xb7, 0xae, 0xb1, 0x90, 0x8f, 0x80, 0xe8, 0x4a, 0xd3, 0x72, 0x5e, 0x6a, 0xfa, 0x59,
+	0xec, 0xab, 0xa5, 0x56, 0x7a, 0xf6, 0xda, 0xaa, 0xd6, 0x4a, 0x05, 0x77, 0x7e, 0x53, 0x87, 0xfa,
+	0x4f, 0x55, 0xbe, 0x08, 0x2c, 0xa9, 0x86, 0xd6, 0x31, 0xa9, 0xdf, 0xe4, 0x5b, 0xb0, 0x5e, 0xe4,
+	0x6f, 0x78, 0xec, 0x8f, 0x44, 0x1e, 0x9c, 0xe1, 0xad, 0x15, 0xea, 0x0f, 0x95, 0x56, 0xce, 0x8c,
+	0x8c, 0x23, 0x1b, 0xd2, 0xf3, 0x04, 0x99, 0x2e, 0xfa, 0x96, 0x07, 0x52, 0xf5, 0x13, 0xa5, 0x91,
+	0xd5, 0x30, 0x66, 0x34, 0x4b, 0x0b, 0xc4, 0x92, 0x42, 0x98, 0x4a, 0x97, 0x43, 0x9e, 0xc3, 0x3a,
+	0x43, 0x4e, 0x33, 0x36, 0xc2, 0x61, 0x1c, 0x4d, 0x22, 0x51, 0x6c, 0xd8, 0xae, 0xba, 0x91, 0x8a,
+	0xd2, 0xf5, 0x72, 0xc4, 0x4b, 0x05, 0xd0, 0xd9, 0x5c, 0x63, 0x33, 0x4a, 0xf2, 0x18, 0xcc, 0x14,
+	0xd9, 0x24, 0xe2, 0x5c, 0xcd, 0x41, 0xbd, 0x4f, 0x37, 0x2b, 0x24, 0x87, 0xe5, 0xa9, 0x57, 0x85,
+	0xca, 0x2d, 0x74, 0x8e, 0xc1, 0xe7, 0x94, 0x9e, 0x16, 0xcb, 0x8e, 0x54, 0xcc, 0x3e, 0xd1, 0x47,
+	0xde, 0x14, 0x63, 0xff, 0xd1, 0x00, 0xb3, 0x42, 0x26, 0x37, 0x2d, 0xcf, 0x82, 0x13, 0x1c, 0x4d,
+	0x8b, 0xb4, 0x3b, 0xdf, 0xad, 0x7b, 0xa4, 0x61, 0xde, 0x14, 0xaf, 0x0a, 0x09, 0x59, 0xa0, 0x87,
+	0x53, 0xcb, 0xd3, 0x82, 0xfd, 0x08, 0x96, 0x73, 0xa8, 0x4c, 0xd0, 0x69, 0x94, 0x14, 0x45, 0xa3,
+	0x7e, 0x4f, 0x93, 0xb6, 0x58, 0x26, 0xcd, 0x8e, 0x61, 0x39, 0x8f, 0x54, 0x96, 0x6b, 0xc6, 0xe2,
+	0xa2, 0x5c, 0x33, 0x16, 0x93, 0x4d, 0x68, 0xe0, 0x19, 0x26, 0xa2, 0x70, 0x93, 0x4b, 0x32, 0x81,
+	0x1c, 0x47, 0x0c, 0xf3, 0xa9, 0xae, 0x3b, 0x19, 0xb4, 0x4a, 0xcd, 0xf5, 0x4d, 0x68, 0x1c, 0x53,
+	0x36, 0xf1, 0x85, 0x6a, 0xe4, 0x96, 0x97, 0x4b, 0xf6, 0xfb, 0xf0, 0xf6, 0x9c, 0x9c, 0xdc, 0xd5,
+	0x28, 0xc6, 0xec, 0x34, 0x27, 0x7a, 0xda, 0xc5, 0x95, 0x86, 0x23, 0xdf, 0x87, 0xd5, 0x91, 0xd6,
+	0x62, 0x58, 0x8e, 0xa4, 0xa7, 0xed, 0xff, 0xfc, 0xe3, 0xe1, 0xca, 0xf4, 0xe0, 0x20, 0xe4, 0xde,
+	0x8c, 0xe4, 0xbc, 0x0b, 0xeb, 0xea, 0xb5, 0x9f, 0xe3, 0x74, 0x96, 0xcf, 0xa9, 0x6c, 0xe7, 0x9b,
+	0xd0, 0x56, 0xb0, 0x83, 0xe4, 0x98, 0xde, 0x86, 0xeb, 0x03, 0x51, 0xb8, 0x0f, 0x30, 0x46, 0x81,
+	0xb7, 0x21, 0x3f, 0x85, 0xd6, 0x94, 0x71, 0x6e, 0x33, 0xfd, 0x00, 0xd6, 0xfd, 0x91, 0x88, 0xce,
+	0x70, 0x98, 0xcf, 0x52, 0x9d, 0x03, 0x73, 0x6f, 0x7d, 0xda, 0xb1, 0x28, 0x54, 0x3c, 0xab, 0x1a,
+	0xa7, 0x35, 0xdc, 0x09, 0x00, 0xca, 0xc3, 0xb9, 0xd4, 0x0f, 0xc1, 0x54, 0x43, 0x37, 0x94, 0xd4,
+	0x5c, 0xbd, 0x70, 0xdd, 0x03, 0xad, 0x7a, 0x41, 0x03, 0x95, 0xde, 0x18, 0x7d, 0x5e, 0x00, 0x6a,
+	0x1a, 0xa0, 0x55, 0x12, 0xb0, 0x6b, 0x83, 0x59, 0xf9, 0xab, 0x41, 0x4c, 0x58, 0xce, 0xc5, 0xf6,
+	0xc2, 0xee, 0xb7, 0xc1, 0xac, 0xec, 0x52, 0xb2, 0x02, 0x4d, 0xf9, 0xbf, 0xe7, 0x90, 0x32, 0xd1,
+	0x5e, 0x90, 0xd2, 0x47, 0xe8, 0x87, 0xb1, 0x84, 0x1a, 0x7b, 0x7f, 0xa9, 0x43, 0x43, 0xcf, 0x1d,
+	0xf2, 0x73, 0x00, 0xfd, 0x4b, 0x05, 0xb0, 0x31, 0x77, 0x01, 0xda, 0x9b, 0xf3, 0x87, 0x95, 0xf3,
+	0xe0, 0xb7, 0x7f, 0xfb, 0xf7, 0x9f, 0x16, 0xdf, 0x76, 0xd6, 0xe4, 0x27, 0xc8, 0x09, 0x0d, 0xf2,
+	0x2f, 0x99, 0x27, 0xc6, 0x2e, 0xf9, 0x04, 0x40, 0x57, 0xcb, 0x2c, 0xef, 0xcc, 0xbe, 0xb4, 0xb7,
+	0x94, 0xfa, 0x7a, 0x55, 0x15, 0xc4, 0x4f, 0x8c, 0xdd, 0x92, 0x5b, 0xd7, 0x0f, 0x49, 0xa0, 0x5d,
+	0xdd, 0x24, 0x8a, 0x7e, 0x7b, 0xfe, 0x8e, 0xd1, 0x4e, 0x76, 0x6e, 0x5b, 0x40, 0xce, 0x43, 0xe5,
+	0xe9, 0x81, 0xd3, 0x29, 0xdc, 0x54, 0x76, 0x0e, 0xca, 0x8b, 0x3c, 0x07, 0xf3, 0x19, 0x43, 0x5f,
+	0xa0, 0x9e, 0xbf, 0x50, 0x4e, 0x0a, 0x7b, 0xd3, 0xd5, 0x5f, 0x59, 0x6e, 0xf1, 0xf9, 0xe4, 0xee,
+	0xcb, 0xcf, 0x34, 0xa7, 0xa3, 0x38, 0xd7, 0x9c, 0x96, 0xe4, 0x54, 0xe9, 0x95, 0x44, 0xaf, 0xc0,
+	0xfc, 0x59, 0x1a, 0xde, 0x8b, 0x68, 0x5b, 0x11, 0x6d, 0xd8, 0xed, 0x29, 0xd1, 0xe0, 0x97, 0xb2,
+	0x92, 0x7e, 0x25, 0xf9, 0x3e, 0x05, 0x53, 0x97, 0xbb, 0xe6, 0xdb, 0x2a, 0xf9, 0x66, 0xba, 0xe0,
+	0x46, 0x72, 0x4b, 0x91, 0x93, 0xdd, 0x6b, 0xe4, 0xe4, 0x43, 0x68, 0x3e, 0x47, 0xa1, 0x69, 0x3b,
+	0x25, 0x6d, 0xd9, 0xab, 0x76, 0x25, 0xf8, 0x82, 0x87, 0x5c, 0xe7, 0xf9, 0x18, 0x56, 0x0a, 0x1e,
+	0xd5, 0x13, 0x1b, 0xa5, 0x55, 0xa5, 0xa1, 0xed, 0xb5, 0x59, 0xb5, 0xf3, 0x35, 0x45, 0xb8, 0x45,
+	0x36, 0xae, 0x12, 0x0e, 0xa2, 0xe4, 0x98, 0x3e, 0xed, 0x7d, 0xf9, 0xaf, 0xee, 0xc2, 0xaf, 0x2f,
+	0xbb, 0xc6, 0x5f, 0x2f, 0xbb, 0xc6, 0x17, 0x97, 0x5d, 0xe3, 0x9f, 0x97, 0x5d, 0xe3, 0x0f, 0x6f,
+	0xba, 0x0b, 0x5f, 0xbc, 0xe9, 0x2e, 0x7c, 0xf9, 0xa6, 0xbb, 0x10, 0x34, 0xd4, 0x4d, 0xbf, 0xf7,
+	0xbf, 0x01, 0x00, 0x07, 0x2e, 0x60, 0xac, 0x61, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2170,6 +2251,20 @@ func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Webhooks) > 0 {
+		for iNdEx := len(m.Webhooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Webhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Permissions) > 0 {
 		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2319,6 +2414,59 @@ func (m *Queue_Permissions_Subject) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *Queue_Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue_Webhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Queue_Webhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SecretName) > 0 {
+		i -= len(m.SecretName)
+		copy(dAtA[i:], m.SecretName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.SecretName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Events[iNdEx])
+			copy(dAtA[i:], m.Events[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Events[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2816,6 +2964,12 @@ func (m *Queue) Size() (n int) {
 			n += 1 + l + sovSubmit(uint64(l))
 		}
 	}
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2857,6 +3011,33 @@ func (m *Queue_Permissions_Subject) Size() (n int) {
 	return n
 }
 
+func (m *Queue_Webhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.SecretName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
 func (m *CancellationResult) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3152,6 +3333,11 @@ func (this *Queue) String() string {
 		repeatedStringForPermissions += strings.Replace(fmt.Sprintf("%v", f), "Queue_Permissions", "Queue_Permissions", 1) + ","
 	}
 	repeatedStringForPermissions += "}"
+	repeatedStringForWebhooks := "[]*Queue_Webhook{"
+	for _, f := range this.Webhooks {
+		repeatedStringForWebhooks += strings.Replace(fmt.Sprintf("%v", f), "Queue_Webhook", "Queue_Webhook", 1) + ","
+	}
+	repeatedStringForWebhooks += "}"
 	keysForResourceLimits := make([]string, 0, len(this.ResourceLimits))
 	for k, _ := range this.ResourceLimits {
 		keysForResourceLimits = append(keysForResourceLimits, k)
@@ -3169,6 +3355,7 @@ func (this *Queue) String() string {
 		`GroupOwners:` + fmt.Sprintf("%v", this.GroupOwners) + `,`,
 		`ResourceLimits:` + mapStringForResourceLimits + `,`,
 		`Permissions:` + repeatedStringForPermissions + `,`,
+		`Webhooks:` + repeatedStringForWebhooks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3200,6 +3387,19 @@ func (this *Queue_Permissions_Subject) String() string {
 	}, "")
 	return s
 }
+func (this *Queue_Webhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Queue_Webhook{`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
+		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CancellationResult) String() string {
 	if this == nil {
 		return "nil"
@@ -5511,6 +5711,40 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &Queue_Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -5762,6 +5996,184 @@ func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Queue_Webhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
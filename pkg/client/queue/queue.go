@@ -11,6 +11,7 @@ type Queue struct {
 	Permissions    []Permissions  `json:"permissions"`
 	PriorityFactor PriorityFactor `json:"priorityFactor"`
 	ResourceLimits ResourceLimits `json:"resourceLimits"`
+	Webhooks       []Webhook      `json:"webhooks"`
 }
 
 // NewQueue returnes new Queue using the in parameter. Error is returned if
@@ -43,12 +44,22 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		permissions = append(permissions, perm)
 	}
 
+	webhooks := []Webhook{}
+	for index, webhook := range in.Webhooks {
+		hook, err := NewWebhook(webhook)
+		if err != nil {
+			return Queue{}, fmt.Errorf("failed to map webhook with index: %d. %s", index, err)
+		}
+		webhooks = append(webhooks, hook)
+	}
+
 	return Queue{
 		Name: in.Name,
 		// Kind:           "Queue",
 		PriorityFactor: priorityFactor,
 		ResourceLimits: resourceLimits,
 		Permissions:    permissions,
+		Webhooks:       webhooks,
 	}, nil
 }
 
@@ -69,6 +80,10 @@ func (q Queue) ToAPI() *api.Queue {
 		result.Permissions = append(result.Permissions, permission.ToAPI())
 	}
 
+	for _, webhook := range q.Webhooks {
+		result.Webhooks = append(result.Webhooks, webhook.ToAPI())
+	}
+
 	return result
 }
 
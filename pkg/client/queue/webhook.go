@@ -0,0 +1,175 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"reflect"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventFailed          WebhookEvent = "failed"
+	WebhookEventSucceeded       WebhookEvent = "succeeded"
+	WebhookEventCancelled       WebhookEvent = "cancelled"
+	WebhookEventJobSetCompleted WebhookEvent = "jobSetCompleted"
+)
+
+// NewWebhookEvent returns WebhookEvent from input string. If input string doesn't match
+// one of allowed event values ["failed", "succeeded", "cancelled", "jobSetCompleted"], and error is returned.
+func NewWebhookEvent(in string) (WebhookEvent, error) {
+	switch event := WebhookEvent(in); event {
+	case WebhookEventFailed, WebhookEventSucceeded, WebhookEventCancelled, WebhookEventJobSetCompleted:
+		return event, nil
+	default:
+		return "", fmt.Errorf("invalid queue webhook event: %s", in)
+	}
+}
+
+// UnmarshalJSON is implementation of https://pkg.go.dev/encoding/json#Unmarshaler interface.
+func (event *WebhookEvent) UnmarshalJSON(data []byte) error {
+	webhookEvent := ""
+	if err := json.Unmarshal(data, &webhookEvent); err != nil {
+		return err
+	}
+
+	out, err := NewWebhookEvent(webhookEvent)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal queue webhook event: %s", err)
+	}
+
+	*event = out
+	return nil
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests using https://pkg.go.dev/testing/quick package
+func (event WebhookEvent) Generate(rand *rand.Rand, size int) reflect.Value {
+	values := AllWebhookEvents()
+	return reflect.ValueOf(values[rand.Intn(len(values))])
+}
+
+// AllWebhookEvents returns a slice containing all WebhookEvent values
+func AllWebhookEvents() []WebhookEvent {
+	return []WebhookEvent{
+		WebhookEventFailed,
+		WebhookEventSucceeded,
+		WebhookEventCancelled,
+		WebhookEventJobSetCompleted,
+	}
+}
+
+type WebhookFormat string
+
+const (
+	WebhookFormatJSON        WebhookFormat = "json"
+	WebhookFormatCloudEvents WebhookFormat = "cloudevents"
+)
+
+// NewWebhookFormat returns WebhookFormat from input string. An empty string maps to the default "json" format.
+// If input string doesn't match one of allowed format values ["json", "cloudevents"], and error is returned.
+func NewWebhookFormat(in string) (WebhookFormat, error) {
+	switch format := WebhookFormat(in); format {
+	case "":
+		return WebhookFormatJSON, nil
+	case WebhookFormatJSON, WebhookFormatCloudEvents:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid queue webhook format: %s", in)
+	}
+}
+
+// Webhook specifies an HTTP endpoint that is notified about the selected job lifecycle events
+// of a queue. If SecretName is set, requests are signed with the server-side secret of that name.
+// Format selects whether the body is an Armada notification or a CloudEvent.
+type Webhook struct {
+	URL        string         `json:"url"`
+	Events     []WebhookEvent `json:"events"`
+	SecretName string         `json:"secretName"`
+	Format     WebhookFormat  `json:"format"`
+}
+
+// NewWebhook returns Webhook from *api.Queue_Webhook. An error is returned if the url
+// is not an absolute http(s) url or if any of the events is not a valid WebhookEvent.
+func NewWebhook(in *api.Queue_Webhook) (Webhook, error) {
+	if in == nil {
+		return Webhook{}, fmt.Errorf("webhook is nil")
+	}
+
+	parsed, err := url.Parse(in.Url)
+	if err != nil {
+		return Webhook{}, fmt.Errorf("failed to parse webhook url %q. %s", in.Url, err)
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return Webhook{}, fmt.Errorf("webhook url %q must be an absolute http or https url", in.Url)
+	}
+
+	if len(in.Events) == 0 {
+		return Webhook{}, fmt.Errorf("webhook %q must specify at least one event", in.Url)
+	}
+	events := make([]WebhookEvent, len(in.Events))
+	for index, event := range in.Events {
+		validEvent, err := NewWebhookEvent(event)
+		if err != nil {
+			return Webhook{}, fmt.Errorf("failed to map event with index: %d. %s", index, err)
+		}
+		events[index] = validEvent
+	}
+
+	format, err := NewWebhookFormat(in.Format)
+	if err != nil {
+		return Webhook{}, err
+	}
+
+	return Webhook{
+		URL:        in.Url,
+		Events:     events,
+		SecretName: in.SecretName,
+		Format:     format,
+	}, nil
+}
+
+// ToAPI converts Webhook to *api.Queue_Webhook.
+func (w Webhook) ToAPI() *api.Queue_Webhook {
+	events := make([]string, len(w.Events))
+	for index, event := range w.Events {
+		events[index] = string(event)
+	}
+
+	return &api.Queue_Webhook{
+		Url:        w.URL,
+		Events:     events,
+		SecretName: w.SecretName,
+		Format:     string(w.Format),
+	}
+}
+
+// Subscribes returns true if the webhook should be notified about event.
+func (w Webhook) Subscribes(event WebhookEvent) bool {
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests using https://pkg.go.dev/testing/quick package
+func (w Webhook) Generate(rand *rand.Rand, size int) reflect.Value {
+	events := make([]WebhookEvent, rand.Intn(len(AllWebhookEvents()))+1)
+	for i := range events {
+		events[i] = WebhookEvent("").Generate(rand, size).Interface().(WebhookEvent)
+	}
+
+	return reflect.ValueOf(Webhook{
+		URL:        fmt.Sprintf("https://hooks.example.com/%d", rand.Int()),
+		Events:     events,
+		SecretName: fmt.Sprintf("secret-%d", rand.Intn(size+1)),
+		Format:     []WebhookFormat{WebhookFormatJSON, WebhookFormatCloudEvents}[rand.Intn(2)],
+	})
+}
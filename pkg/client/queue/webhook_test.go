@@ -0,0 +1,92 @@
+package queue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/G-Research/armada/pkg/api"
+)
+
+func TestWebhookEventUnmarshal(t *testing.T) {
+	tests := map[string]struct {
+		Events []WebhookEvent
+		Fail   bool
+	}{
+		"ValidEvents": {
+			Events: AllWebhookEvents(),
+			Fail:   false,
+		},
+		"InvalidEvents": {
+			Events: []WebhookEvent{"", "running", "JobFailedEvent"},
+			Fail:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(subT *testing.T) {
+			for _, event := range test.Events {
+				data, err := json.Marshal(event)
+				if err != nil {
+					t.Errorf("failed to marshal event: %s to json: %s", event, err)
+				}
+				result := WebhookEvent("")
+
+				err = json.Unmarshal(data, &result)
+				if test.Fail && err == nil {
+					t.Fatalf("failed to throw an error on invalid event string %s", event)
+				}
+				if !test.Fail && err != nil {
+					t.Fatalf("failed to unmarshal valid event %s. %s", event, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewWebhook(t *testing.T) {
+	tests := map[string]struct {
+		Webhook *api.Queue_Webhook
+		Fail    bool
+	}{
+		"Valid": {
+			Webhook: &api.Queue_Webhook{Url: "https://hooks.slack.com/services/abc", Events: []string{"failed", "jobSetCompleted"}},
+			Fail:    false,
+		},
+		"RelativeUrl": {
+			Webhook: &api.Queue_Webhook{Url: "/services/abc", Events: []string{"failed"}},
+			Fail:    true,
+		},
+		"UnsupportedScheme": {
+			Webhook: &api.Queue_Webhook{Url: "ftp://example.com/upload", Events: []string{"failed"}},
+			Fail:    true,
+		},
+		"NoEvents": {
+			Webhook: &api.Queue_Webhook{Url: "https://example.com/hook"},
+			Fail:    true,
+		},
+		"CloudEventsFormat": {
+			Webhook: &api.Queue_Webhook{Url: "https://example.com/hook", Events: []string{"failed"}, Format: "cloudevents"},
+			Fail:    false,
+		},
+		"InvalidFormat": {
+			Webhook: &api.Queue_Webhook{Url: "https://example.com/hook", Events: []string{"failed"}, Format: "xml"},
+			Fail:    true,
+		},
+		"InvalidEvent": {
+			Webhook: &api.Queue_Webhook{Url: "https://example.com/hook", Events: []string{"leased"}},
+			Fail:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(subT *testing.T) {
+			_, err := NewWebhook(test.Webhook)
+			if test.Fail && err == nil {
+				t.Fatalf("failed to throw an error on invalid webhook %v", test.Webhook)
+			}
+			if !test.Fail && err != nil {
+				t.Fatalf("failed to map valid webhook %v. %s", test.Webhook, err)
+			}
+		})
+	}
+}